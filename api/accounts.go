@@ -0,0 +1,80 @@
+package api
+
+import "fmt"
+
+// ListAccounts returns every account the API key can access
+func (c *Client) ListAccounts() ([]Account, error) {
+	query := `
+	{
+		actor {
+			accounts {
+				id
+				name
+			}
+		}
+	}`
+
+	result, err := c.NerdGraphQuery(query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	accountsData, ok := safeSlice(actor["accounts"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing accounts", RawResponse: rawResponse(result)}
+	}
+
+	accounts := make([]Account, 0, len(accountsData))
+	for _, a := range accountsData {
+		account, ok := safeMap(a)
+		if !ok {
+			continue
+		}
+		accounts = append(accounts, Account{
+			ID:   safeInt(account["id"]),
+			Name: safeString(account["name"]),
+		})
+	}
+
+	return accounts, nil
+}
+
+// GetAccount returns an account by ID
+func (c *Client) GetAccount(id int) (*Account, error) {
+	query := `
+	query($accountId: Int!) {
+		actor {
+			account(id: $accountId) {
+				id
+				name
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"accountId": id,
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	account, ok := safeMap(actor["account"])
+	if !ok || account == nil {
+		return nil, fmt.Errorf("account not found: %d", id)
+	}
+
+	return &Account{
+		ID:   safeInt(account["id"]),
+		Name: safeString(account["name"]),
+	}, nil
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAccounts(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"accounts": [
+		{"id": 12345, "name": "Production"},
+		{"id": 67890, "name": "Staging"}
+	]}}}`)
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+
+	accounts, err := client.ListAccounts()
+
+	require.NoError(t, err)
+	assert.Equal(t, []Account{
+		{ID: 12345, Name: "Production"},
+		{ID: 67890, Name: "Staging"},
+	}, accounts)
+	server.AssertLastPath(t, "/graphql")
+}
+
+func TestListAccounts_Empty(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"accounts": []}}}`)
+
+	client := NewTestClient(server)
+	accounts, err := client.ListAccounts()
+
+	require.NoError(t, err)
+	assert.Empty(t, accounts)
+}
+
+func TestListAccounts_MissingAccounts(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.ListAccounts()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing accounts")
+}
+
+func TestGetAccount(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"id": 67890, "name": "Staging"}}}}`)
+
+	client := NewTestClient(server)
+	account, err := client.GetAccount(67890)
+
+	require.NoError(t, err)
+	assert.Equal(t, &Account{ID: 67890, Name: "Staging"}, account)
+
+	var req NerdGraphRequest
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &req))
+	assert.Equal(t, float64(67890), req.Variables["accountId"])
+}
+
+func TestGetAccount_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": null}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.GetAccount(1)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "account not found: 1")
+}
+
+func TestGetAccount_GraphQLError(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "graphql_error.json"))
+
+	client := NewTestClient(server)
+	_, err := client.GetAccount(1)
+
+	var gqlErr *GraphQLError
+	assert.ErrorAs(t, err, &gqlErr)
+}
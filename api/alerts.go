@@ -3,6 +3,7 @@ package api
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // ListAlertPolicies returns all alert policies
@@ -55,15 +56,15 @@ func (c *Client) GetAlertPolicy(policyID string) (*AlertPolicy, error) {
 	// Navigate the nested response safely
 	actor, ok := safeMap(result["actor"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing actor"}
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
 	}
 	account, ok := safeMap(actor["account"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing account"}
+		return nil, &ResponseError{Message: "unexpected response format: missing account", RawResponse: rawResponse(result)}
 	}
 	alerts, ok := safeMap(account["alerts"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing alerts"}
+		return nil, &ResponseError{Message: "unexpected response format: missing alerts", RawResponse: rawResponse(result)}
 	}
 	policy, ok := safeMap(alerts["policy"])
 	if !ok || policy == nil {
@@ -76,3 +77,411 @@ func (c *Client) GetAlertPolicy(policyID string) (*AlertPolicy, error) {
 		IncidentPreference: safeString(policy["incidentPreference"]),
 	}, nil
 }
+
+// GetAlertCondition returns a specific NRQL alert condition by ID
+func (c *Client) GetAlertCondition(conditionID string) (*AlertCondition, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+	query($accountId: Int!, $conditionId: ID!) {
+		actor {
+			account(id: $accountId) {
+				alerts {
+					nrqlCondition(id: $conditionId) {
+						%s
+					}
+				}
+			}
+		}
+	}`, alertConditionFields)
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId":   accountID,
+		"conditionId": conditionID,
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	account, ok := safeMap(actor["account"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing account", RawResponse: rawResponse(result)}
+	}
+	alerts, ok := safeMap(account["alerts"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing alerts", RawResponse: rawResponse(result)}
+	}
+	condition, ok := safeMap(alerts["nrqlCondition"])
+	if !ok || condition == nil {
+		return nil, fmt.Errorf("condition not found")
+	}
+
+	return parseAlertCondition(condition), nil
+}
+
+// GetAlertConditionsForPolicy returns every NRQL alert condition in a policy
+func (c *Client) GetAlertConditionsForPolicy(policyID string) ([]AlertCondition, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	var all []AlertCondition
+	cursor := ""
+	for {
+		conditions, nextCursor, err := c.getAlertConditionsPage(policyID, cursor)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, conditions...)
+		if nextCursor == "" {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if all == nil {
+		all = []AlertCondition{}
+	}
+	return all, nil
+}
+
+// getAlertConditionsPage returns one page of a policy's NRQL conditions and
+// the cursor for the next page
+func (c *Client) getAlertConditionsPage(policyID, cursor string) ([]AlertCondition, string, error) {
+	query := fmt.Sprintf(`
+	query($accountId: Int!, $policyId: ID!, $cursor: String) {
+		actor {
+			account(id: $accountId) {
+				alerts {
+					nrqlConditionsSearch(searchCriteria: {policyId: $policyId}, cursor: $cursor) {
+						nextCursor
+						nrqlConditions {
+							%s
+						}
+					}
+				}
+			}
+		}
+	}`, alertConditionFields)
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+		"policyId":  policyID,
+	}
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, "", err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, "", &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	account, ok := safeMap(actor["account"])
+	if !ok {
+		return nil, "", &ResponseError{Message: "unexpected response format: missing account", RawResponse: rawResponse(result)}
+	}
+	alerts, ok := safeMap(account["alerts"])
+	if !ok {
+		return nil, "", &ResponseError{Message: "unexpected response format: missing alerts", RawResponse: rawResponse(result)}
+	}
+	search, ok := safeMap(alerts["nrqlConditionsSearch"])
+	if !ok {
+		return nil, "", &ResponseError{Message: "unexpected response format: missing nrqlConditionsSearch", RawResponse: rawResponse(result)}
+	}
+
+	items, _ := safeSlice(search["nrqlConditions"])
+	conditions := make([]AlertCondition, 0, len(items))
+	for _, item := range items {
+		condition, ok := safeMap(item)
+		if !ok {
+			continue
+		}
+		conditions = append(conditions, *parseAlertCondition(condition))
+	}
+
+	return conditions, safeString(search["nextCursor"]), nil
+}
+
+// alertConditionFields is the common set of GraphQL fields for NRQL conditions
+const alertConditionFields = `
+	id
+	name
+	enabled
+	policyId
+	nrql { query }
+	signal { aggregationWindow }
+	terms {
+		operator
+		priority
+		threshold
+		thresholdDuration
+		thresholdOccurrences
+	}
+`
+
+// AlertConditionInput represents the input for creating a static NRQL condition
+type AlertConditionInput struct {
+	Name                      string                     `json:"name"`
+	Description               string                     `json:"description,omitempty"`
+	Enabled                   *bool                      `json:"enabled,omitempty"`
+	NRQL                      AlertConditionNRQLInput    `json:"nrql"`
+	Terms                     []AlertConditionTermInput  `json:"terms"`
+	Signal                    *AlertConditionSignalInput `json:"signal,omitempty"`
+	Expiration                *AlertConditionExpiryInput `json:"expiration,omitempty"`
+	ViolationTimeLimitSeconds int                        `json:"violationTimeLimitSeconds,omitempty"`
+	RunbookURL                string                     `json:"runbookUrl,omitempty"`
+}
+
+// AlertConditionNRQLInput holds the query a condition evaluates
+type AlertConditionNRQLInput struct {
+	Query string `json:"query"`
+}
+
+// AlertConditionTermInput represents a threshold term in condition input
+type AlertConditionTermInput struct {
+	Operator             string  `json:"operator"`
+	Priority             string  `json:"priority"`
+	Threshold            float64 `json:"threshold"`
+	ThresholdDuration    int     `json:"thresholdDuration"`
+	ThresholdOccurrences string  `json:"thresholdOccurrences"`
+}
+
+// AlertConditionSignalInput controls how the condition's signal is aggregated
+// and how gaps are filled
+type AlertConditionSignalInput struct {
+	AggregationWindow int      `json:"aggregationWindow,omitempty"`
+	AggregationMethod string   `json:"aggregationMethod,omitempty"`
+	AggregationDelay  int      `json:"aggregationDelay,omitempty"`
+	FillOption        string   `json:"fillOption,omitempty"`
+	FillValue         *float64 `json:"fillValue,omitempty"`
+}
+
+// AlertConditionExpiryInput controls loss-of-signal behaviour
+type AlertConditionExpiryInput struct {
+	ExpirationDuration          int  `json:"expirationDuration,omitempty"`
+	OpenViolationOnExpiration   bool `json:"openViolationOnExpiration"`
+	CloseViolationsOnExpiration bool `json:"closeViolationsOnExpiration"`
+}
+
+// CreateAlertCondition creates a static NRQL alert condition in a policy
+func (c *Client) CreateAlertCondition(policyID string, input *AlertConditionInput) (*AlertCondition, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	mutation := fmt.Sprintf(`
+	mutation($accountId: Int!, $policyId: ID!, $condition: AlertsNrqlConditionStaticInput!) {
+		alertsNrqlConditionStaticCreate(accountId: $accountId, policyId: $policyId, condition: $condition) {
+			%s
+		}
+	}`, alertConditionFields)
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+		"policyId":  policyID,
+		"condition": input,
+	}
+
+	result, err := c.NerdGraphQuery(mutation, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	condition, ok := safeMap(result["alertsNrqlConditionStaticCreate"])
+	if !ok || condition == nil {
+		return nil, &ResponseError{Message: "unexpected response format: missing alertsNrqlConditionStaticCreate", RawResponse: rawResponse(result)}
+	}
+
+	return parseAlertCondition(condition), nil
+}
+
+// DeleteAlertCondition deletes an alert condition by ID
+func (c *Client) DeleteAlertCondition(conditionID string) error {
+	if err := c.RequireAccountID(); err != nil {
+		return err
+	}
+
+	mutation := `
+	mutation($accountId: Int!, $id: ID!) {
+		alertsConditionDelete(accountId: $accountId, id: $id) {
+			id
+		}
+	}`
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+		"id":        conditionID,
+	}
+
+	result, err := c.NerdGraphQuery(mutation, variables)
+	if err != nil {
+		return err
+	}
+
+	deleted, ok := safeMap(result["alertsConditionDelete"])
+	if !ok || safeString(deleted["id"]) == "" {
+		return fmt.Errorf("failed to delete condition: %s", conditionID)
+	}
+
+	return nil
+}
+
+// parseAlertCondition converts a NerdGraph NRQL condition to an AlertCondition
+func parseAlertCondition(condition map[string]interface{}) *AlertCondition {
+	ac := &AlertCondition{
+		ID:       safeString(condition["id"]),
+		Name:     safeString(condition["name"]),
+		PolicyID: safeString(condition["policyId"]),
+		Enabled:  condition["enabled"] == true,
+	}
+	if nrql, ok := safeMap(condition["nrql"]); ok {
+		ac.NRQL = safeString(nrql["query"])
+	}
+	if signal, ok := safeMap(condition["signal"]); ok {
+		ac.AggregationWindow = safeInt(signal["aggregationWindow"])
+	}
+	if terms, ok := safeSlice(condition["terms"]); ok {
+		for _, t := range terms {
+			term, ok := safeMap(t)
+			if !ok {
+				continue
+			}
+			threshold, _ := term["threshold"].(float64)
+			ac.Terms = append(ac.Terms, AlertConditionTerm{
+				Operator:             safeString(term["operator"]),
+				Priority:             safeString(term["priority"]),
+				Threshold:            threshold,
+				ThresholdDuration:    safeInt(term["thresholdDuration"]),
+				ThresholdOccurrences: safeString(term["thresholdOccurrences"]),
+			})
+		}
+	}
+	return ac
+}
+
+// Term returns the condition term for the given priority (CRITICAL or WARNING)
+func (ac *AlertCondition) Term(priority string) (*AlertConditionTerm, bool) {
+	for i := range ac.Terms {
+		if strings.EqualFold(ac.Terms[i].Priority, priority) {
+			return &ac.Terms[i], true
+		}
+	}
+	return nil, false
+}
+
+// IsBreached reports whether a value would breach the term's threshold
+func (t AlertConditionTerm) IsBreached(value float64) (bool, error) {
+	switch strings.ToUpper(t.Operator) {
+	case "ABOVE":
+		return value > t.Threshold, nil
+	case "ABOVE_OR_EQUALS":
+		return value >= t.Threshold, nil
+	case "BELOW":
+		return value < t.Threshold, nil
+	case "BELOW_OR_EQUALS":
+		return value <= t.Threshold, nil
+	case "EQUALS":
+		return value == t.Threshold, nil
+	case "NOT_EQUALS":
+		return value != t.Threshold, nil
+	default:
+		return false, fmt.Errorf("unsupported threshold operator: %s", t.Operator)
+	}
+}
+
+// ListAlertIncidents returns the open alert incidents in the account. An
+// empty policyID returns open incidents across every policy.
+func (c *Client) ListAlertIncidents(policyID string) ([]AlertIncident, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+	}
+
+	params := "$accountId: Int!"
+	searchCriteria := ""
+	if policyID != "" {
+		params += ", $policyId: ID!"
+		searchCriteria = "(searchCriteria: {policyId: $policyId})"
+		variables["policyId"] = policyID
+	}
+
+	query := fmt.Sprintf(`
+	query(%s) {
+		actor {
+			account(id: $accountId) {
+				alerts {
+					openIncidents%s {
+						incidents {
+							id
+							incidentId
+							title
+							priority
+							description
+						}
+					}
+				}
+			}
+		}
+	}`, params, searchCriteria)
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	account, ok := safeMap(actor["account"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing account", RawResponse: rawResponse(result)}
+	}
+	alerts, ok := safeMap(account["alerts"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing alerts", RawResponse: rawResponse(result)}
+	}
+	openIncidents, ok := safeMap(alerts["openIncidents"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing openIncidents", RawResponse: rawResponse(result)}
+	}
+
+	items, _ := safeSlice(openIncidents["incidents"])
+	incidents := make([]AlertIncident, 0, len(items))
+	for _, item := range items {
+		incident, ok := safeMap(item)
+		if !ok {
+			continue
+		}
+		incidents = append(incidents, AlertIncident{
+			ID:          safeString(incident["id"]),
+			IncidentID:  safeString(incident["incidentId"]),
+			Title:       safeString(incident["title"]),
+			Priority:    safeString(incident["priority"]),
+			Description: safeString(incident["description"]),
+		})
+	}
+
+	return incidents, nil
+}
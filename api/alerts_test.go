@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -129,3 +130,508 @@ func TestGetAlertPolicy_NoAccountID(t *testing.T) {
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrAccountIDRequired)
 }
+
+func TestGetAlertCondition(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "alert_condition_nrql.json"))
+
+	client := NewTestClient(server)
+	condition, err := client.GetAlertCondition("1001")
+
+	require.NoError(t, err)
+	require.NotNil(t, condition)
+
+	assert.Equal(t, "1001", condition.ID)
+	assert.Equal(t, "High Error Rate", condition.Name)
+	assert.Equal(t, "111", condition.PolicyID)
+	assert.True(t, condition.Enabled)
+	assert.Contains(t, condition.NRQL, "FROM Transaction")
+	require.Len(t, condition.Terms, 2)
+	assert.Equal(t, "ABOVE", condition.Terms[0].Operator)
+	assert.Equal(t, float64(5), condition.Terms[0].Threshold)
+	assert.Equal(t, 300, condition.Terms[0].ThresholdDuration)
+
+	warning, ok := condition.Term("warning")
+	require.True(t, ok)
+	assert.Equal(t, 2.5, warning.Threshold)
+
+	server.AssertLastPath(t, "/graphql")
+	req := server.LastRequest()
+	require.NotNil(t, req)
+	assert.Contains(t, string(req.Body), "nrqlCondition")
+}
+
+func TestGetAlertCondition_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	response := `{
+		"data": {
+			"actor": {
+				"account": {
+					"alerts": {
+						"nrqlCondition": null
+					}
+				}
+			}
+		}
+	}`
+	server.SetResponse(http.StatusOK, response)
+
+	client := NewTestClient(server)
+	_, err := client.GetAlertCondition("99999")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "condition not found")
+}
+
+func TestGetAlertCondition_NoAccountID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+
+	_, err := client.GetAlertCondition("1001")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrAccountIDRequired)
+}
+
+func TestGetAlertConditionsForPolicy(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "alert_conditions_search.json"))
+
+	client := NewTestClient(server)
+	conditions, err := client.GetAlertConditionsForPolicy("111")
+
+	require.NoError(t, err)
+	require.Len(t, conditions, 2)
+
+	assert.Equal(t, "1001", conditions[0].ID)
+	assert.Equal(t, "High Error Rate", conditions[0].Name)
+	assert.Equal(t, "111", conditions[0].PolicyID)
+	assert.True(t, conditions[0].Enabled)
+	assert.Contains(t, conditions[0].NRQL, "FROM Transaction")
+	assert.Equal(t, 60, conditions[0].AggregationWindow)
+	require.Len(t, conditions[0].Terms, 1)
+	assert.Equal(t, "ABOVE", conditions[0].Terms[0].Operator)
+	assert.Equal(t, float64(5), conditions[0].Terms[0].Threshold)
+	assert.Equal(t, 300, conditions[0].Terms[0].ThresholdDuration)
+
+	assert.Equal(t, "Slow Responses", conditions[1].Name)
+	assert.False(t, conditions[1].Enabled)
+	assert.Equal(t, 120, conditions[1].AggregationWindow)
+	assert.Equal(t, 1.5, conditions[1].Terms[0].Threshold)
+
+	server.AssertLastPath(t, "/graphql")
+	req := server.LastRequest()
+	require.NotNil(t, req)
+	assert.Contains(t, string(req.Body), "nrqlConditionsSearch")
+	assert.Contains(t, string(req.Body), `"policyId":"111"`)
+}
+
+func TestGetAlertConditionsForPolicy_Empty(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	response := `{
+		"data": {
+			"actor": {
+				"account": {
+					"alerts": {
+						"nrqlConditionsSearch": {
+							"nextCursor": null,
+							"nrqlConditions": []
+						}
+					}
+				}
+			}
+		}
+	}`
+	server.SetResponse(http.StatusOK, response)
+
+	client := NewTestClient(server)
+	conditions, err := client.GetAlertConditionsForPolicy("111")
+
+	require.NoError(t, err)
+	assert.NotNil(t, conditions)
+	assert.Empty(t, conditions)
+}
+
+func TestGetAlertConditionsForPolicy_FollowsCursor(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	page := func(id, nextCursor string) string {
+		cursor := "null"
+		if nextCursor != "" {
+			cursor = `"` + nextCursor + `"`
+		}
+		return `{"data": {"actor": {"account": {"alerts": {"nrqlConditionsSearch": {
+			"nextCursor": ` + cursor + `,
+			"nrqlConditions": [{"id": "` + id + `", "name": "Condition ` + id + `", "policyId": "111"}]
+		}}}}}}`
+	}
+
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req NerdGraphRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		if req.Variables["cursor"] == "cursor-2" {
+			_, _ = w.Write([]byte(page("1002", "")))
+			return
+		}
+		_, _ = w.Write([]byte(page("1001", "cursor-2")))
+	})
+
+	client := NewTestClient(server)
+	conditions, err := client.GetAlertConditionsForPolicy("111")
+
+	require.NoError(t, err)
+	require.Len(t, conditions, 2)
+	assert.Equal(t, "1001", conditions[0].ID)
+	assert.Equal(t, "1002", conditions[1].ID)
+	server.AssertRequestCount(t, 2)
+}
+
+func TestGetAlertConditionsForPolicy_Error(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{
+		"errors": [{"message": "Policy not found"}]
+	}`)
+
+	client := NewTestClient(server)
+	_, err := client.GetAlertConditionsForPolicy("99999")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Policy not found")
+}
+
+func TestGetAlertConditionsForPolicy_MissingSearch(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"alerts": {}}}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.GetAlertConditionsForPolicy("111")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing nrqlConditionsSearch")
+}
+
+func TestGetAlertConditionsForPolicy_NoAccountID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+
+	_, err := client.GetAlertConditionsForPolicy("111")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrAccountIDRequired)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestListAlertIncidents(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "alert_incidents_open.json"))
+
+	client := NewTestClient(server)
+	incidents, err := client.ListAlertIncidents("")
+
+	require.NoError(t, err)
+	require.Len(t, incidents, 2)
+
+	assert.Equal(t, "a1b2c3", incidents[0].ID)
+	assert.Equal(t, "5001", incidents[0].IncidentID)
+	assert.Equal(t, "High Error Rate on checkout-service", incidents[0].Title)
+	assert.Equal(t, "CRITICAL", incidents[0].Priority)
+	assert.Contains(t, incidents[0].Description, "Error percentage")
+	assert.Equal(t, "HIGH", incidents[1].Priority)
+
+	server.AssertLastPath(t, "/graphql")
+	req := server.LastRequest()
+	require.NotNil(t, req)
+	assert.Contains(t, string(req.Body), "openIncidents")
+	assert.NotContains(t, string(req.Body), "searchCriteria")
+	assert.NotContains(t, string(req.Body), `"policyId"`)
+}
+
+func TestListAlertIncidents_ByPolicy(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "alert_incidents_open.json"))
+
+	client := NewTestClient(server)
+	_, err := client.ListAlertIncidents("111")
+
+	require.NoError(t, err)
+	req := server.LastRequest()
+	require.NotNil(t, req)
+	assert.Contains(t, string(req.Body), "searchCriteria: {policyId: $policyId}")
+	assert.Contains(t, string(req.Body), `"policyId":"111"`)
+}
+
+func TestListAlertIncidents_Empty(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"alerts": {"openIncidents": {"incidents": []}}}}}}`)
+
+	client := NewTestClient(server)
+	incidents, err := client.ListAlertIncidents("")
+
+	require.NoError(t, err)
+	assert.NotNil(t, incidents)
+	assert.Empty(t, incidents)
+}
+
+func TestListAlertIncidents_Error(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "graphql_error.json"))
+
+	client := NewTestClient(server)
+	_, err := client.ListAlertIncidents("")
+
+	require.Error(t, err)
+}
+
+func TestListAlertIncidents_MissingOpenIncidents(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"alerts": {}}}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.ListAlertIncidents("")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing openIncidents")
+}
+
+func TestListAlertIncidents_NoAccountID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+
+	_, err := client.ListAlertIncidents("")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrAccountIDRequired)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestAlertCondition_Term(t *testing.T) {
+	condition := &AlertCondition{
+		Terms: []AlertConditionTerm{
+			{Priority: "CRITICAL", Threshold: 10},
+		},
+	}
+
+	critical, ok := condition.Term("CRITICAL")
+	require.True(t, ok)
+	assert.Equal(t, float64(10), critical.Threshold)
+
+	_, ok = condition.Term("WARNING")
+	assert.False(t, ok)
+}
+
+func TestAlertConditionTerm_IsBreached(t *testing.T) {
+	tests := []struct {
+		name      string
+		priority  string
+		operator  string
+		threshold float64
+		value     float64
+		expected  bool
+	}{
+		{"critical above breaching", "CRITICAL", "ABOVE", 5, 6, true},
+		{"critical above at threshold", "CRITICAL", "ABOVE", 5, 5, false},
+		{"critical above ok", "CRITICAL", "ABOVE", 5, 4, false},
+		{"critical above or equals at threshold", "CRITICAL", "ABOVE_OR_EQUALS", 5, 5, true},
+		{"critical below breaching", "CRITICAL", "BELOW", 1, 0.5, true},
+		{"critical below ok", "CRITICAL", "BELOW", 1, 2, false},
+		{"critical below or equals at threshold", "CRITICAL", "BELOW_OR_EQUALS", 1, 1, true},
+		{"critical equals breaching", "CRITICAL", "EQUALS", 0, 0, true},
+		{"critical equals ok", "CRITICAL", "EQUALS", 0, 1, false},
+		{"critical not equals breaching", "CRITICAL", "NOT_EQUALS", 0, 1, true},
+		{"warning above breaching", "WARNING", "ABOVE", 2.5, 3, true},
+		{"warning above ok", "WARNING", "ABOVE", 2.5, 2, false},
+		{"warning below breaching", "WARNING", "BELOW", 10, 9.9, true},
+		{"warning below ok", "WARNING", "BELOW", 10, 10, false},
+		{"warning equals breaching", "WARNING", "EQUALS", 3, 3, true},
+		{"warning equals ok", "WARNING", "EQUALS", 3, 4, false},
+		{"lowercase operator", "WARNING", "above", 1, 2, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			term := AlertConditionTerm{Priority: tt.priority, Operator: tt.operator, Threshold: tt.threshold}
+			breached, err := term.IsBreached(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, breached)
+		})
+	}
+}
+
+func TestAlertConditionTerm_IsBreached_UnknownOperator(t *testing.T) {
+	term := AlertConditionTerm{Operator: "SIDEWAYS", Threshold: 1}
+	_, err := term.IsBreached(2)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported threshold operator")
+}
+
+func TestCreateAlertCondition(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	response := `{
+		"data": {
+			"alertsNrqlConditionStaticCreate": {
+				"id": "2002",
+				"name": "High Error Rate",
+				"enabled": true,
+				"policyId": "111",
+				"nrql": {"query": "SELECT count(*) FROM TransactionError"},
+				"terms": [
+					{"operator": "ABOVE", "priority": "CRITICAL", "threshold": 10, "thresholdDuration": 300, "thresholdOccurrences": "ALL"}
+				]
+			}
+		}
+	}`
+	server.SetResponse(http.StatusOK, response)
+
+	enabled := true
+	fillValue := 0.0
+	input := &AlertConditionInput{
+		Name:    "High Error Rate",
+		Enabled: &enabled,
+		NRQL:    AlertConditionNRQLInput{Query: "SELECT count(*) FROM TransactionError"},
+		Terms: []AlertConditionTermInput{
+			{Operator: "ABOVE", Priority: "CRITICAL", Threshold: 10, ThresholdDuration: 300, ThresholdOccurrences: "ALL"},
+		},
+		Signal: &AlertConditionSignalInput{AggregationWindow: 60, FillOption: "STATIC", FillValue: &fillValue},
+	}
+
+	client := NewTestClient(server)
+	condition, err := client.CreateAlertCondition("111", input)
+
+	require.NoError(t, err)
+	assert.Equal(t, "2002", condition.ID)
+	assert.Equal(t, "High Error Rate", condition.Name)
+	assert.Equal(t, "111", condition.PolicyID)
+	require.Len(t, condition.Terms, 1)
+	assert.Equal(t, float64(10), condition.Terms[0].Threshold)
+
+	server.AssertLastPath(t, "/graphql")
+	req := server.LastRequest()
+	require.NotNil(t, req)
+
+	var body struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+	require.NoError(t, json.Unmarshal(req.Body, &body))
+	assert.Contains(t, body.Query, "alertsNrqlConditionStaticCreate")
+	assert.Equal(t, float64(12345), body.Variables["accountId"])
+	assert.Equal(t, "111", body.Variables["policyId"])
+
+	sent, ok := body.Variables["condition"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "High Error Rate", sent["name"])
+	assert.Equal(t, true, sent["enabled"])
+	signal, ok := sent["signal"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "STATIC", signal["fillOption"])
+	assert.Equal(t, float64(0), signal["fillValue"])
+}
+
+func TestCreateAlertCondition_GraphQLError(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{
+		"data": {"alertsNrqlConditionStaticCreate": null},
+		"errors": [{"message": "Validation Error: thresholdDuration must be a multiple of aggregationWindow"}]
+	}`)
+
+	client := NewTestClient(server)
+	_, err := client.CreateAlertCondition("111", &AlertConditionInput{Name: "Bad"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "thresholdDuration must be a multiple")
+}
+
+func TestCreateAlertCondition_NoAccountID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+
+	_, err := client.CreateAlertCondition("111", &AlertConditionInput{Name: "Test"})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrAccountIDRequired)
+}
+
+func TestDeleteAlertCondition(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"alertsConditionDelete": {"id": "999"}}}`)
+
+	client := NewTestClient(server)
+	err := client.DeleteAlertCondition("999")
+
+	require.NoError(t, err)
+	server.AssertLastPath(t, "/graphql")
+	req := server.LastRequest()
+	require.NotNil(t, req)
+	assert.Contains(t, string(req.Body), "alertsConditionDelete")
+	assert.Contains(t, string(req.Body), `"id":"999"`)
+}
+
+func TestDeleteAlertCondition_NotDeleted(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"alertsConditionDelete": null}}`)
+
+	client := NewTestClient(server)
+	err := client.DeleteAlertCondition("999")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to delete condition")
+}
+
+func TestDeleteAlertCondition_NoAccountID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+
+	err := client.DeleteAlertCondition("999")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrAccountIDRequired)
+	server.AssertRequestCount(t, 0)
+}
@@ -1,6 +1,13 @@
 package api
 
-import "encoding/json"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
 
 // ListApplications returns all APM applications
 func (c *Client) ListApplications() ([]Application, error) {
@@ -17,6 +24,36 @@ func (c *Client) ListApplications() ([]Application, error) {
 	return resp.Applications, nil
 }
 
+// SearchApplications returns the APM applications matching params. Each key
+// is sent as a filter[key] query parameter; the REST API supports name
+// (partial, case-insensitive match), host, ids, and language. Empty values
+// are skipped.
+func (c *Client) SearchApplications(params map[string]string) ([]Application, error) {
+	query := url.Values{}
+	for key, value := range params {
+		if value != "" {
+			query.Set("filter["+key+"]", value)
+		}
+	}
+
+	endpoint := c.BaseURL + "/applications.json"
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	data, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ApplicationsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, &ResponseError{Message: "failed to parse response", Err: err}
+	}
+
+	return resp.Applications, nil
+}
+
 // GetApplication returns a specific application by ID
 func (c *Client) GetApplication(appID string) (*Application, error) {
 	data, err := c.doRequest("GET", c.BaseURL+"/applications/"+appID+".json", nil)
@@ -32,6 +69,81 @@ func (c *Client) GetApplication(appID string) (*Application, error) {
 	return &resp.Application, nil
 }
 
+// GetApplicationHealthStatus returns an application's alert severity and
+// golden metrics from NerdGraph. The application must belong to the
+// configured account.
+func (c *Client) GetApplicationHealthStatus(appID string) (*AppHealthStatus, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+	if !isNumeric(appID) {
+		return nil, fmt.Errorf("invalid application ID: %s", appID)
+	}
+
+	query := `
+	query($guid: EntityGuid!) {
+		actor {
+			entity(guid: $guid) {
+				guid
+				name
+				reporting
+				... on ApmApplicationEntity {
+					alertSeverity
+					apmSummary {
+						errorRate
+						responseTimeAverage
+						throughput
+					}
+				}
+			}
+		}
+	}`
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"guid": appEntityGUID(accountID, appID).String(),
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	entity, ok := safeMap(actor["entity"])
+	if !ok || entity == nil {
+		return nil, fmt.Errorf("application not found: %s", appID)
+	}
+
+	reporting, _ := entity["reporting"].(bool)
+	status := &AppHealthStatus{
+		GUID:          EntityGUID(safeString(entity["guid"])),
+		Name:          safeString(entity["name"]),
+		AlertSeverity: safeString(entity["alertSeverity"]),
+		Reporting:     reporting,
+	}
+
+	// apmSummary is null for applications that haven't reported recently
+	if summary, ok := safeMap(entity["apmSummary"]); ok {
+		status.ErrorRate, _ = summary["errorRate"].(float64)
+		status.ResponseTime, _ = summary["responseTimeAverage"].(float64)
+		status.Throughput, _ = summary["throughput"].(float64)
+	}
+
+	return status, nil
+}
+
+// appEntityGUID returns the entity GUID of an APM application. The first
+// part of a GUID, which EntityGUID.Parse calls the version, is the ID of
+// the account the entity belongs to.
+func appEntityGUID(accountID int, appID string) EntityGUID {
+	raw := fmt.Sprintf("%d|APM|APPLICATION|%s", accountID, appID)
+	return EntityGUID(base64.StdEncoding.EncodeToString([]byte(raw)))
+}
+
 // ListApplicationMetrics returns available metrics for an application
 func (c *Client) ListApplicationMetrics(appID string) ([]Metric, error) {
 	data, err := c.doRequest("GET", c.BaseURL+"/applications/"+appID+"/metrics.json", nil)
@@ -46,3 +158,136 @@ func (c *Client) ListApplicationMetrics(appID string) ([]Metric, error) {
 
 	return resp.Metrics, nil
 }
+
+// GetMetricData returns metric timeslices for an application
+func (c *Client) GetMetricData(appID string, query MetricDataQuery) (*MetricData, error) {
+	if len(query.Names) == 0 {
+		return nil, fmt.Errorf("at least one metric name is required")
+	}
+
+	params := url.Values{}
+	for _, name := range query.Names {
+		params.Add("names[]", name)
+	}
+	for _, value := range query.Values {
+		params.Add("values[]", value)
+	}
+	if !query.From.IsZero() {
+		params.Set("from", query.From.UTC().Format(time.RFC3339))
+	}
+	if !query.To.IsZero() {
+		params.Set("to", query.To.UTC().Format(time.RFC3339))
+	}
+
+	data, err := c.doRequest("GET", c.BaseURL+"/applications/"+appID+"/metrics/data.json?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp MetricDataResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, &ResponseError{Message: "failed to parse response", Err: err}
+	}
+
+	return &resp.MetricData, nil
+}
+
+// ListApplicationHosts returns the hosts an application runs on
+func (c *Client) ListApplicationHosts(appID string) ([]ApplicationHost, error) {
+	data, err := c.doRequest("GET", c.BaseURL+"/applications/"+appID+"/hosts.json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ApplicationHostsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, &ResponseError{Message: "failed to parse response", Err: err}
+	}
+
+	return resp.ApplicationHosts, nil
+}
+
+// GetApplicationHost returns a specific host of an application
+func (c *Client) GetApplicationHost(appID, hostID string) (*ApplicationHost, error) {
+	data, err := c.doRequest("GET", c.BaseURL+"/applications/"+appID+"/hosts/"+hostID+".json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ApplicationHostResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, &ResponseError{Message: "failed to parse response", Err: err}
+	}
+
+	return &resp.ApplicationHost, nil
+}
+
+// ListAppLabels returns the labels applied to an application
+func (c *Client) ListAppLabels(appID string) ([]AppLabel, error) {
+	data, err := c.doRequest("GET", c.BaseURL+"/applications/"+appID+"/labels.json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp AppLabelsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, &ResponseError{Message: "failed to parse response", Err: err}
+	}
+
+	return resp.Labels, nil
+}
+
+// AddAppLabel applies the label key:value to an application
+func (c *Client) AddAppLabel(appID, key, value string) (*AppLabel, error) {
+	if key == "" || value == "" {
+		return nil, fmt.Errorf("label key and value are required")
+	}
+
+	body := map[string]interface{}{
+		"label": AppLabel{Category: key, Name: value},
+	}
+
+	data, err := c.doRequest("POST", c.BaseURL+"/applications/"+appID+"/labels.json", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp AppLabelResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, &ResponseError{Message: "failed to parse response", Err: err}
+	}
+
+	return &resp.Label, nil
+}
+
+// RemoveAppLabel removes the label with the given key from an application
+func (c *Client) RemoveAppLabel(appID, key string) error {
+	if key == "" {
+		return fmt.Errorf("label key is required")
+	}
+
+	_, err := c.doRequest("DELETE", c.BaseURL+"/applications/"+appID+"/labels/"+url.PathEscape(key)+".json", nil)
+	return err
+}
+
+// FilterApplicationHosts returns hosts whose hostname contains search and whose
+// health status equals healthStatus. Empty filters match everything; both
+// comparisons are case-insensitive.
+func FilterApplicationHosts(hosts []ApplicationHost, search, healthStatus string) []ApplicationHost {
+	if search == "" && healthStatus == "" {
+		return hosts
+	}
+
+	search = strings.ToLower(search)
+	filtered := make([]ApplicationHost, 0, len(hosts))
+	for _, h := range hosts {
+		if search != "" && !strings.Contains(strings.ToLower(h.Host), search) {
+			continue
+		}
+		if healthStatus != "" && !strings.EqualFold(h.HealthStatus, healthStatus) {
+			continue
+		}
+		filtered = append(filtered, h)
+	}
+	return filtered
+}
@@ -1,8 +1,11 @@
 package api
 
 import (
+	"encoding/json"
 	"net/http"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -62,6 +65,56 @@ func TestListApplications_Error(t *testing.T) {
 	assert.True(t, IsUnauthorized(err))
 }
 
+func TestSearchApplications(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "applications_list.json"))
+
+	client := NewTestClient(server)
+	apps, err := client.SearchApplications(map[string]string{
+		"name":     "prod",
+		"language": "java",
+	})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, apps)
+
+	server.AssertLastMethod(t, "GET")
+	server.AssertLastPath(t, "/applications.json")
+	query, err := url.ParseQuery(server.LastRequest().Query)
+	require.NoError(t, err)
+	assert.Equal(t, "prod", query.Get("filter[name]"))
+	assert.Equal(t, "java", query.Get("filter[language]"))
+}
+
+func TestSearchApplications_SkipsEmptyParams(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"applications": []}`)
+
+	client := NewTestClient(server)
+	apps, err := client.SearchApplications(map[string]string{"name": "", "language": ""})
+
+	require.NoError(t, err)
+	assert.Empty(t, apps)
+	assert.Empty(t, server.LastRequest().Query)
+}
+
+func TestSearchApplications_Error(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusUnauthorized, `{"error": {"title": "invalid api key"}}`)
+
+	client := NewTestClient(server)
+	_, err := client.SearchApplications(map[string]string{"name": "prod"})
+
+	require.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+}
+
 func TestGetApplication(t *testing.T) {
 	server := NewMockServer()
 	defer server.Close()
@@ -129,3 +182,331 @@ func TestListApplicationMetrics_Error(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsNotFound(err))
 }
+
+func TestGetMetricData(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "application_metric_data.json"))
+
+	client := NewTestClient(server)
+	data, err := client.GetMetricData("12345678", MetricDataQuery{
+		Names:  []string{"HttpDispatcher"},
+		Values: []string{"call_count", "average_response_time"},
+		From:   time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC),
+		To:     time.Date(2025, 1, 15, 11, 0, 0, 0, time.UTC),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, data.Metrics, 1)
+	assert.Equal(t, "HttpDispatcher", data.Metrics[0].Name)
+	require.Len(t, data.Metrics[0].Timeslices, 2)
+	assert.Equal(t, "2025-01-15T10:00:00+00:00", data.Metrics[0].Timeslices[0].From)
+	assert.Equal(t, 120.0, data.Metrics[0].Timeslices[0].Values["call_count"])
+	assert.Equal(t, 0.098, data.Metrics[0].Timeslices[1].Values["average_response_time"])
+
+	server.AssertLastPath(t, "/applications/12345678/metrics/data.json")
+	query, err := url.ParseQuery(server.LastRequest().Query)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"HttpDispatcher"}, query["names[]"])
+	assert.Equal(t, []string{"call_count", "average_response_time"}, query["values[]"])
+	assert.Equal(t, "2025-01-15T10:00:00Z", query.Get("from"))
+	assert.Equal(t, "2025-01-15T11:00:00Z", query.Get("to"))
+}
+
+func TestGetMetricData_DefaultRange(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "application_metric_data.json"))
+
+	client := NewTestClient(server)
+	_, err := client.GetMetricData("12345678", MetricDataQuery{Names: []string{"HttpDispatcher"}})
+
+	require.NoError(t, err)
+	query, err := url.ParseQuery(server.LastRequest().Query)
+	require.NoError(t, err)
+	assert.NotContains(t, query, "from")
+	assert.NotContains(t, query, "to")
+	assert.NotContains(t, query, "values[]")
+}
+
+func TestGetMetricData_RequiresName(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	_, err := client.GetMetricData("12345678", MetricDataQuery{})
+
+	require.Error(t, err)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestListApplicationHosts(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "application_hosts.json"))
+
+	client := NewTestClient(server)
+	hosts, err := client.ListApplicationHosts("12345")
+
+	require.NoError(t, err)
+	require.Len(t, hosts, 3)
+
+	assert.Equal(t, 5001, hosts[0].ID)
+	assert.Equal(t, "My Application", hosts[0].ApplicationName)
+	assert.Equal(t, "web-prod-01", hosts[0].Host)
+	assert.Equal(t, 8080, hosts[0].Port)
+	assert.Equal(t, "green", hosts[0].HealthStatus)
+	assert.Equal(t, "java", hosts[0].Language)
+	assert.Equal(t, "8.10.0", hosts[0].AgentVersion)
+
+	server.AssertLastPath(t, "/applications/12345/hosts.json")
+}
+
+func TestListApplicationHosts_Error(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusNotFound, `{"error": {"title": "Application not found"}}`)
+
+	client := NewTestClient(server)
+	_, err := client.ListApplicationHosts("99999")
+
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestGetApplicationHost(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	response := `{
+		"application_host": {
+			"id": 5002,
+			"application_name": "My Application",
+			"host": "web-prod-02",
+			"port": 8080,
+			"language": "java",
+			"health_status": "red",
+			"agent_version": "8.10.0"
+		}
+	}`
+	server.SetResponse(http.StatusOK, response)
+
+	client := NewTestClient(server)
+	host, err := client.GetApplicationHost("12345", "5002")
+
+	require.NoError(t, err)
+	assert.Equal(t, 5002, host.ID)
+	assert.Equal(t, "web-prod-02", host.Host)
+	assert.Equal(t, "red", host.HealthStatus)
+
+	server.AssertLastPath(t, "/applications/12345/hosts/5002.json")
+}
+
+func TestListAppLabels(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{
+		"labels": [
+			{"key": "env:production", "category": "env", "name": "production"},
+			{"key": "team:backend", "category": "team", "name": "backend"}
+		]
+	}`)
+
+	client := NewTestClient(server)
+	labels, err := client.ListAppLabels("12345")
+
+	require.NoError(t, err)
+	require.Len(t, labels, 2)
+	assert.Equal(t, "env", labels[0].Category)
+	assert.Equal(t, "production", labels[0].Name)
+	assert.Equal(t, "team:backend", labels[1].Key)
+
+	server.AssertLastPath(t, "/applications/12345/labels.json")
+}
+
+func TestAddAppLabel(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusCreated, `{"label": {"key": "env:production", "category": "env", "name": "production"}}`)
+
+	client := NewTestClient(server)
+	label, err := client.AddAppLabel("12345", "env", "production")
+
+	require.NoError(t, err)
+	assert.Equal(t, "env:production", label.Key)
+
+	req := server.LastRequest()
+	assert.Equal(t, "POST", req.Method)
+	assert.Equal(t, "/applications/12345/labels.json", req.Path)
+
+	var body map[string]map[string]string
+	require.NoError(t, json.Unmarshal(req.Body, &body))
+	assert.Equal(t, "env", body["label"]["category"])
+	assert.Equal(t, "production", body["label"]["name"])
+}
+
+func TestAddAppLabel_RequiresKeyAndValue(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	_, err := client.AddAppLabel("12345", "env", "")
+
+	require.Error(t, err)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestRemoveAppLabel(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusNoContent, "")
+
+	client := NewTestClient(server)
+	err := client.RemoveAppLabel("12345", "env")
+
+	require.NoError(t, err)
+	req := server.LastRequest()
+	assert.Equal(t, "DELETE", req.Method)
+	assert.Equal(t, "/applications/12345/labels/env.json", req.Path)
+}
+
+func TestRemoveAppLabel_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusNotFound, `{"error": {"title": "Label not found"}}`)
+
+	client := NewTestClient(server)
+	err := client.RemoveAppLabel("12345", "missing")
+
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestFilterApplicationHosts(t *testing.T) {
+	hosts := []ApplicationHost{
+		{ID: 1, Host: "web-prod-01", HealthStatus: "green"},
+		{ID: 2, Host: "web-prod-02", HealthStatus: "red"},
+		{ID: 3, Host: "worker-prod-01", HealthStatus: "green"},
+	}
+
+	tests := []struct {
+		name         string
+		search       string
+		healthStatus string
+		expectedIDs  []int
+	}{
+		{"no filters", "", "", []int{1, 2, 3}},
+		{"search", "web", "", []int{1, 2}},
+		{"search case-insensitive", "WORKER", "", []int{3}},
+		{"health status", "", "GREEN", []int{1, 3}},
+		{"both", "web", "red", []int{2}},
+		{"no match", "db", "", []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := []int{}
+			for _, h := range FilterApplicationHosts(hosts, tt.search, tt.healthStatus) {
+				ids = append(ids, h.ID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
+
+func TestGetApplicationHealthStatus(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"entity": {
+		"guid": "MTIzNDV8QVBNfEFQUExJQ0FUSU9OfDEyMzQ1Njc4",
+		"name": "checkout-service",
+		"reporting": true,
+		"alertSeverity": "WARNING",
+		"apmSummary": {"errorRate": 0.012, "responseTimeAverage": 0.245, "throughput": 1520.5}
+	}}}}`)
+
+	client := NewTestClient(server)
+	status, err := client.GetApplicationHealthStatus("12345678")
+
+	require.NoError(t, err)
+	assert.Equal(t, "checkout-service", status.Name)
+	assert.Equal(t, "WARNING", status.AlertSeverity)
+	assert.True(t, status.Reporting)
+	assert.InDelta(t, 0.012, status.ErrorRate, 0.0001)
+	assert.InDelta(t, 0.245, status.ResponseTime, 0.0001)
+	assert.InDelta(t, 1520.5, status.Throughput, 0.0001)
+
+	// The GUID is built from the configured account and the app ID
+	var req NerdGraphRequest
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &req))
+	guid := EntityGUID(req.Variables["guid"].(string))
+	appID, err := guid.AppID()
+	require.NoError(t, err)
+	assert.Equal(t, "12345678", appID)
+	assert.Contains(t, req.Query, "... on ApmApplicationEntity")
+}
+
+func TestGetApplicationHealthStatus_NotReporting(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"entity": {
+		"guid": "MTIzNDV8QVBNfEFQUExJQ0FUSU9OfDEyMzQ1Njc4",
+		"name": "old-service",
+		"reporting": false,
+		"alertSeverity": "NOT_CONFIGURED",
+		"apmSummary": null
+	}}}}`)
+
+	client := NewTestClient(server)
+	status, err := client.GetApplicationHealthStatus("12345678")
+
+	require.NoError(t, err)
+	assert.False(t, status.Reporting)
+	assert.Zero(t, status.Throughput)
+}
+
+func TestGetApplicationHealthStatus_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"entity": null}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.GetApplicationHealthStatus("99999")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "application not found: 99999")
+}
+
+func TestGetApplicationHealthStatus_RequiresAccountID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+	_, err := client.GetApplicationHealthStatus("12345678")
+
+	assert.ErrorIs(t, err, ErrAccountIDRequired)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestGetApplicationHealthStatus_InvalidID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	_, err := client.GetApplicationHealthStatus("checkout")
+
+	require.Error(t, err)
+	server.AssertRequestCount(t, 0)
+}
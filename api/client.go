@@ -2,10 +2,18 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"net"
 	"net/http"
+	"net/http/httputil"
+	"strconv"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/open-cli-collective/newrelic-cli/internal/config"
@@ -19,6 +27,19 @@ const (
 	RegionEU Region = "EU"
 )
 
+const (
+	// DefaultMaxRetries is the number of retries used by New
+	DefaultMaxRetries = 3
+	// DefaultRetryDelay is the first backoff delay when retries are enabled
+	DefaultRetryDelay = time.Second
+	// maxRetryAfter caps how long a Retry-After header can make us wait
+	maxRetryAfter = time.Minute
+	// DefaultMaxIdleConns is the idle connection pool size used by New
+	DefaultMaxIdleConns = 10
+	// DefaultIdleConnTimeout is how long New keeps idle connections open
+	DefaultIdleConnTimeout = 90 * time.Second
+)
+
 // Client is the New Relic API client
 type Client struct {
 	APIKey        APIKey
@@ -28,35 +49,66 @@ type Client struct {
 	NerdGraphURL  string
 	SyntheticsURL string
 	HTTPClient    *http.Client
-	Verbose       bool
-	Stderr        io.Writer
+	Logger        *slog.Logger
+	DumpOut       io.Writer     // Receives HTTP request/response dumps; nil disables them
+	MaxRetries    int           // Retries after a transient failure; 0 disables retries
+	RetryDelay    time.Duration // Backoff before the first retry, doubled for each retry after it
+
+	// Context is used by requests made without an explicit context, so that
+	// cancelling it (e.g. on Ctrl+C) aborts them. nil means
+	// context.Background().
+	Context context.Context
+
+	sleep func(time.Duration) // Waits between retries; replaced in tests
+
+	entityCacheMu sync.Mutex
+	entityCache   map[EntityGUID]entityCacheEntry
 }
 
 // ClientConfig holds configuration for creating a new client
 type ClientConfig struct {
-	APIKey    string
-	AccountID string
-	Region    string
-	Timeout   time.Duration
-	Verbose   bool
-	Stderr    io.Writer
+	APIKey     string
+	AccountID  string
+	Region     string
+	Timeout    time.Duration
+	MaxRetries int           // Retries on network errors and 429/502/503/504 responses (429/503 and refused connections for POST)
+	RetryDelay time.Duration // Defaults to DefaultRetryDelay when MaxRetries is set
+	Verbose    bool
+	Stderr     io.Writer
+	Logger     *slog.Logger // Overrides the logger derived from Verbose/Stderr
+	RateLimit  float64      // Maximum requests per second; 0 means no limit
+
+	// Context is used by requests made without an explicit context; see
+	// Client.Context
+	Context context.Context
+
+	// Connection pool settings. If any is set, the client uses its own
+	// transport with these settings; zero values keep Go's defaults.
+	MaxIdleConns    int
+	MaxConnsPerHost int
+	IdleConnTimeout time.Duration
 }
 
-// New creates a new New Relic client using credentials from config/environment
+// New creates a new New Relic client using credentials from the active
+// config profile or the environment
 func New() (*Client, error) {
-	apiKey, err := config.GetAPIKey()
+	apiKey, err := config.GetAPIKey("")
 	if err != nil {
 		return nil, err
 	}
 
-	accountID, _ := config.GetAccountID() // Optional
-	region := config.GetRegion()
+	accountID, _ := config.GetAccountID("") // Optional
+	region := config.GetRegion("")
 
 	return NewWithConfig(ClientConfig{
-		APIKey:    apiKey,
-		AccountID: accountID,
-		Region:    region,
-		Timeout:   30 * time.Second,
+		APIKey:     apiKey,
+		AccountID:  accountID,
+		Region:     region,
+		Timeout:    30 * time.Second,
+		MaxRetries: DefaultMaxRetries,
+
+		MaxIdleConns:    DefaultMaxIdleConns,
+		IdleConnTimeout: DefaultIdleConnTimeout,
 	}), nil
 }
 
@@ -65,6 +117,9 @@ func NewWithConfig(cfg ClientConfig) *Client {
 	if cfg.Timeout == 0 {
 		cfg.Timeout = 30 * time.Second
 	}
+	if cfg.MaxRetries > 0 && cfg.RetryDelay == 0 {
+		cfg.RetryDelay = DefaultRetryDelay
+	}
 
 	c := &Client{
 		APIKey:    APIKey(cfg.APIKey),
@@ -73,8 +128,24 @@ func NewWithConfig(cfg ClientConfig) *Client {
 		HTTPClient: &http.Client{
 			Timeout: cfg.Timeout,
 		},
-		Verbose: cfg.Verbose,
-		Stderr:  cfg.Stderr,
+		Logger:     cfg.Logger,
+		MaxRetries: cfg.MaxRetries,
+		RetryDelay: cfg.RetryDelay,
+		Context:    cfg.Context,
+	}
+
+	if cfg.MaxIdleConns > 0 || cfg.MaxConnsPerHost > 0 || cfg.IdleConnTimeout > 0 {
+		c.HTTPClient.Transport = newTransport(cfg)
+	}
+	if cfg.RateLimit > 0 {
+		c.HTTPClient.Transport = NewRateLimitingTransport(c.HTTPClient.Transport, cfg.RateLimit)
+	}
+
+	if c.Logger == nil {
+		c.Logger = newLogger(cfg.Verbose, cfg.Stderr)
+	}
+	if cfg.Verbose {
+		c.DumpOut = cfg.Stderr
 	}
 
 	// Set URLs based on region
@@ -91,74 +162,278 @@ func NewWithConfig(cfg ClientConfig) *Client {
 	return c
 }
 
-// doRequest performs an HTTP request with authentication
-func (c *Client) doRequest(method, url string, body interface{}) ([]byte, error) {
-	start := time.Now()
+// newTransport returns a copy of http.DefaultTransport with the connection
+// pool settings from cfg applied
+func newTransport(cfg ClientConfig) *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.MaxIdleConns > 0 {
+		t.MaxIdleConns = cfg.MaxIdleConns
+		// Requests go to a handful of hosts, so let each keep the whole pool
+		t.MaxIdleConnsPerHost = cfg.MaxIdleConns
+	}
+	if cfg.MaxConnsPerHost > 0 {
+		t.MaxConnsPerHost = cfg.MaxConnsPerHost
+	}
+	if cfg.IdleConnTimeout > 0 {
+		t.IdleConnTimeout = cfg.IdleConnTimeout
+	}
+	return t
+}
 
-	if c.Verbose && c.Stderr != nil {
-		fmt.Fprintf(c.Stderr, "[DEBUG] %s %s\n", method, url)
+// newLogger returns a debug-level text logger writing to w when verbose,
+// or a logger that discards everything otherwise
+func newLogger(verbose bool, w io.Writer) *slog.Logger {
+	if !verbose || w == nil {
+		w = io.Discard
 	}
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
 
-	var reqBody io.Reader
+// logger returns the client's logger, falling back to a discarding logger
+func (c *Client) logger() *slog.Logger {
+	if c.Logger == nil {
+		return newLogger(false, nil)
+	}
+	return c.Logger
+}
+
+// retryAttemptKey is the context key holding the attempt number of a request
+type retryAttemptKey struct{}
+
+// RetryAttempt returns the attempt number (starting at 1) stored in the
+// context of a request sent by the client, or 0 if there is none
+func RetryAttempt(ctx context.Context) int {
+	attempt, _ := ctx.Value(retryAttemptKey{}).(int)
+	return attempt
+}
+
+// context returns the context for requests made without an explicit one
+func (c *Client) context() context.Context {
+	if c.Context == nil {
+		return context.Background()
+	}
+	return c.Context
+}
+
+// doRequest performs an HTTP request using the client's context
+func (c *Client) doRequest(method, url string, body interface{}) ([]byte, error) {
+	return c.doRequestWithContext(c.context(), method, url, body)
+}
+
+// doRequestWithContext performs an HTTP request with authentication,
+// retrying transient failures up to MaxRetries times with exponential
+// backoff. Cancelling ctx aborts the request and any further retries.
+func (c *Client) doRequestWithContext(ctx context.Context, method, url string, body interface{}) ([]byte, error) {
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, &ResponseError{Message: "failed to marshal request body", Err: err}
 		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		respBody, retryAfter, retryable, err := c.doAttempt(ctx, method, url, jsonBody, attempt)
+		if err == nil || !retryable || attempt > c.MaxRetries || ctx.Err() != nil {
+			return respBody, err
+		}
+
+		delay := c.RetryDelay << (attempt - 1)
+		if retryAfter > 0 {
+			delay = retryAfter
+		}
+		c.logger().Debug("retrying", "method", method, "url", url, "attempt", attempt, "delay_ms", delay.Milliseconds())
+		if err := c.wait(ctx, delay); err != nil {
+			return nil, &ResponseError{Message: "request failed", Err: err}
+		}
+	}
+}
+
+// doAttempt sends a request once. retryable reports whether a failure is
+// transient, and retryAfter is the server-requested wait for a 429 response.
+func (c *Client) doAttempt(ctx context.Context, method, url string, jsonBody []byte, attempt int) (respBody []byte, retryAfter time.Duration, retryable bool, err error) {
+	start := time.Now()
+	log := c.logger().With("method", method, "url", url, "attempt", attempt)
+
+	log.Debug("request")
+
+	var reqBody io.Reader
+	if jsonBody != nil {
 		reqBody = bytes.NewReader(jsonBody)
 	}
 
-	req, err := http.NewRequest(method, url, reqBody)
+	ctx = context.WithValue(ctx, retryAttemptKey{}, attempt)
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		return nil, &ResponseError{Message: "failed to create request", Err: err}
+		return nil, 0, false, &ResponseError{Message: "failed to create request", Err: err}
 	}
 
 	req.Header.Set("Api-Key", c.APIKey.String())
 	req.Header.Set("Content-Type", "application/json")
 
+	if c.DumpOut != nil {
+		if dump, err := httputil.DumpRequestOut(req, true); err == nil {
+			c.writeDump(dump)
+		}
+	}
+
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		if c.Verbose && c.Stderr != nil {
-			fmt.Fprintf(c.Stderr, "[DEBUG] Request failed: %v (%s)\n", err, time.Since(start))
-		}
-		return nil, &ResponseError{Message: "request failed", Err: err}
+		log.Debug("request failed", "error", err, "duration_ms", time.Since(start).Milliseconds())
+		return nil, 0, isRetryableError(method, err), &ResponseError{Message: "request failed", Err: err}
 	}
 	defer resp.Body.Close()
 
-	if c.Verbose && c.Stderr != nil {
-		fmt.Fprintf(c.Stderr, "[DEBUG] %d %s (%s)\n", resp.StatusCode, resp.Status, time.Since(start))
+	log.Debug("response", "status", resp.StatusCode, "duration_ms", time.Since(start).Milliseconds())
+
+	if c.DumpOut != nil {
+		if dump, err := httputil.DumpResponse(resp, true); err == nil {
+			c.writeDump(dump)
+		}
 	}
 
-	respBody, err := io.ReadAll(resp.Body)
+	respBody, err = io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, &ResponseError{Message: "failed to read response", Err: err}
+		return nil, 0, false, &ResponseError{Message: "failed to read response", Err: err}
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, &APIError{
+		if resp.StatusCode == http.StatusTooManyRequests {
+			retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+		}
+		return nil, retryAfter, isRetryableStatus(method, resp.StatusCode), &APIError{
 			StatusCode: resp.StatusCode,
 			Body:       string(respBody),
 		}
 	}
 
-	return respBody, nil
+	return respBody, 0, false, nil
+}
+
+// writeDump writes an HTTP dump to DumpOut with the API key masked
+func (c *Client) writeDump(dump []byte) {
+	if key := c.APIKey.String(); key != "" {
+		dump = bytes.ReplaceAll(dump, []byte(key), []byte(maskedAPIKey(c.APIKey)))
+	}
+	fmt.Fprintf(c.DumpOut, "%s\n\n", bytes.TrimRight(dump, "\r\n"))
+}
+
+// maskedAPIKey returns a placeholder for an API key that keeps only its prefix
+func maskedAPIKey(key APIKey) string {
+	if key.HasNRAKPrefix() {
+		return "NRAK-****"
+	}
+	return "****"
+}
+
+// wait sleeps between retries, returning early with ctx's error if it is
+// cancelled first
+func (c *Client) wait(ctx context.Context, d time.Duration) error {
+	if c.sleep != nil {
+		c.sleep(d)
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isIdempotent reports whether repeating a request has the same effect as
+// sending it once. POST is not: REST creates and NerdGraph mutations (and
+// queries, which share the endpoint) may have been applied even though the
+// response was lost.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRetryableStatus reports whether a response status is worth retrying.
+// Non-idempotent requests are only retried when the server says it did not
+// process them (429 and 503).
+func isRetryableStatus(method string, status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	}
+	return false
+}
+
+// isRetryableError reports whether a transport error is worth retrying.
+// Timeouts are never retried: the caller's deadline or --timeout has already
+// been spent. Non-idempotent requests are only retried when the connection
+// was refused, so the request cannot have reached the server.
+func isRetryableError(method string, err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return false
+	}
+	if isIdempotent(method) {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED)
 }
 
-// NerdGraphQuery executes a GraphQL query against NerdGraph
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP
+// date, capped at maxRetryAfter. It returns 0 if the header is missing or
+// invalid.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	var d time.Duration
+	if secs, err := strconv.Atoi(value); err == nil {
+		d = time.Duration(secs) * time.Second
+	} else if t, err := http.ParseTime(value); err == nil {
+		d = time.Until(t)
+	}
+
+	if d < 0 {
+		return 0
+	}
+	if d > maxRetryAfter {
+		return maxRetryAfter
+	}
+	return d
+}
+
+// NerdGraphQuery executes a GraphQL query against NerdGraph using the
+// client's context
 func (c *Client) NerdGraphQuery(query string, variables map[string]interface{}) (map[string]interface{}, error) {
+	return c.NerdGraphQueryWithContext(c.context(), query, variables)
+}
+
+// NerdGraphQueryWithContext executes a GraphQL query against NerdGraph,
+// aborting it when ctx is cancelled
+func (c *Client) NerdGraphQueryWithContext(ctx context.Context, query string, variables map[string]interface{}) (map[string]interface{}, error) {
 	reqBody := NerdGraphRequest{
 		Query:     query,
 		Variables: variables,
 	}
 
-	data, err := c.doRequest("POST", c.NerdGraphURL, reqBody)
+	data, err := c.doRequestWithContext(ctx, "POST", c.NerdGraphURL, reqBody)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp NerdGraphResponse
 	if err := json.Unmarshal(data, &resp); err != nil {
-		return nil, &ResponseError{Message: "failed to parse response", Err: err}
+		return nil, &ResponseError{Message: "failed to parse response", Err: err, RawResponse: string(data)}
 	}
 
 	if len(resp.Errors) > 0 {
@@ -1,9 +1,17 @@
 package api
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"log/slog"
+	"net"
 	"net/http"
+	"net/url"
+	"sync/atomic"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -40,6 +48,58 @@ func TestNewWithConfig(t *testing.T) {
 	})
 }
 
+func TestNewWithConfig_Timeout(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		client := NewWithConfig(ClientConfig{})
+		assert.Equal(t, 30*time.Second, client.HTTPClient.Timeout)
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		client := NewWithConfig(ClientConfig{Timeout: 120 * time.Second})
+		assert.Equal(t, 120*time.Second, client.HTTPClient.Timeout)
+	})
+}
+
+func TestNewWithConfig_ConnectionPool(t *testing.T) {
+	t.Run("default transport", func(t *testing.T) {
+		client := NewWithConfig(ClientConfig{})
+		assert.Nil(t, client.HTTPClient.Transport)
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		client := NewWithConfig(ClientConfig{
+			MaxIdleConns:    10,
+			MaxConnsPerHost: 4,
+			IdleConnTimeout: 90 * time.Second,
+		})
+
+		transport, ok := client.HTTPClient.Transport.(*http.Transport)
+		require.True(t, ok)
+		assert.Equal(t, 10, transport.MaxIdleConns)
+		assert.Equal(t, 10, transport.MaxIdleConnsPerHost)
+		assert.Equal(t, 4, transport.MaxConnsPerHost)
+		assert.Equal(t, 90*time.Second, transport.IdleConnTimeout)
+		assert.NotNil(t, transport.Proxy, "other defaults are kept")
+	})
+
+	t.Run("partial", func(t *testing.T) {
+		client := NewWithConfig(ClientConfig{MaxConnsPerHost: 2})
+
+		transport, ok := client.HTTPClient.Transport.(*http.Transport)
+		require.True(t, ok)
+		assert.Equal(t, 2, transport.MaxConnsPerHost)
+		assert.Equal(t, http.DefaultTransport.(*http.Transport).MaxIdleConns, transport.MaxIdleConns)
+	})
+
+	t.Run("with rate limit", func(t *testing.T) {
+		client := NewWithConfig(ClientConfig{MaxIdleConns: 10, RateLimit: 5})
+
+		limited, ok := client.HTTPClient.Transport.(*RateLimitingTransport)
+		require.True(t, ok)
+		assert.IsType(t, &http.Transport{}, limited.Base)
+	})
+}
+
 func TestClient_RequireAccountID(t *testing.T) {
 	t.Run("with account ID", func(t *testing.T) {
 		client := &Client{AccountID: "12345"}
@@ -154,6 +214,110 @@ func TestSafeSlice(t *testing.T) {
 	})
 }
 
+// --- Logging Tests ---
+
+func TestDoRequest_VerboseLogging(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{}`)
+
+	var buf bytes.Buffer
+	client := NewTestClient(server)
+	client.Logger = newLogger(true, &buf)
+
+	_, err := client.doRequest("GET", server.URL+"/test", nil)
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "level=DEBUG")
+	assert.Contains(t, out, "method=GET")
+	assert.Contains(t, out, "url="+server.URL+"/test")
+	assert.Contains(t, out, "attempt=1")
+	assert.Contains(t, out, "status=200")
+	assert.Contains(t, out, "duration_ms=")
+}
+
+func TestDoRequest_NotVerbose(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := NewWithConfig(ClientConfig{Verbose: false, Stderr: &buf})
+	client.BaseURL = server.URL
+	client.HTTPClient = server.Client()
+
+	_, err := client.doRequest("GET", server.URL+"/test", nil)
+	require.NoError(t, err)
+	assert.Empty(t, buf.String())
+}
+
+func TestDoRequest_InjectedLogger(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusNotFound, `{"error": "not found"}`)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := NewWithConfig(ClientConfig{Logger: logger})
+	client.HTTPClient = server.Client()
+
+	_, err := client.doRequest("POST", server.URL+"/missing", nil)
+	require.Error(t, err)
+
+	var last map[string]interface{}
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	require.NoError(t, json.Unmarshal(lines[1], &last))
+	assert.Equal(t, "POST", last["method"])
+	assert.Equal(t, float64(404), last["status"])
+	assert.Equal(t, float64(1), last["attempt"])
+	assert.Contains(t, last, "duration_ms")
+}
+
+func TestDoRequest_VerboseDumpsHTTP(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"message": "success"}`)
+
+	var stderr bytes.Buffer
+	client := NewWithConfig(ClientConfig{
+		APIKey:  "NRAK-ABCDEFGHIJKLMNOPQRSTUVWXYZ1",
+		Verbose: true,
+		Stderr:  &stderr,
+	})
+	client.HTTPClient = server.Client()
+
+	data, err := client.doRequest("POST", server.URL+"/create", map[string]string{"name": "test"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"message": "success"}`, string(data))
+
+	out := stderr.String()
+	assert.Contains(t, out, "POST /create HTTP/1.1")
+	assert.Contains(t, out, "Api-Key: NRAK-****")
+	assert.Contains(t, out, `{"name":"test"}`)
+	assert.Contains(t, out, "HTTP/1.1 200 OK")
+	assert.Contains(t, out, `{"message": "success"}`)
+	assert.NotContains(t, out, "NRAK-ABCDEFGHIJKLMNOPQRSTUVWXYZ1")
+}
+
+func TestDoRequest_VerboseDumpMasksNonNRAKKey(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	var stderr bytes.Buffer
+	client := NewWithConfig(ClientConfig{APIKey: "legacy-key", Verbose: true, Stderr: &stderr})
+	client.HTTPClient = server.Client()
+
+	_, err := client.doRequest("GET", server.URL+"/test", nil)
+	require.NoError(t, err)
+
+	assert.Contains(t, stderr.String(), "Api-Key: ****")
+	assert.NotContains(t, stderr.String(), "legacy-key")
+}
+
 // --- HTTP Request Tests ---
 
 func TestDoRequest_Success(t *testing.T) {
@@ -250,6 +414,256 @@ func TestDoRequest_Error500(t *testing.T) {
 	assert.Equal(t, 500, apiErr.StatusCode)
 }
 
+// --- Retry Tests ---
+
+// attemptRecorder is a RoundTripper that records the RetryAttempt of each request
+type attemptRecorder struct {
+	next     http.RoundTripper
+	attempts []int
+}
+
+func (r *attemptRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.attempts = append(r.attempts, RetryAttempt(req.Context()))
+	return r.next.RoundTrip(req)
+}
+
+// newRetryClient returns a test client that retries without sleeping and
+// records the delays it would have waited
+func newRetryClient(server *MockServer, maxRetries int) (*Client, *attemptRecorder, *[]time.Duration) {
+	client := NewTestClient(server)
+	client.MaxRetries = maxRetries
+	client.RetryDelay = 100 * time.Millisecond
+
+	recorder := &attemptRecorder{next: client.HTTPClient.Transport}
+	client.HTTPClient.Transport = recorder
+
+	var delays []time.Duration
+	client.sleep = func(d time.Duration) { delays = append(delays, d) }
+
+	return client, recorder, &delays
+}
+
+// failNTimes responds with status n times, then 200 OK
+func failNTimes(n int32, status int) http.HandlerFunc {
+	var calls int32
+	return func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= n {
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok": true}`))
+	}
+}
+
+func TestDoRequest_RetriesTransientStatus(t *testing.T) {
+	for _, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout, http.StatusTooManyRequests} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			server := NewMockServer()
+			defer server.Close()
+			server.SetHandler(failNTimes(2, status))
+
+			client, recorder, delays := newRetryClient(server, 3)
+			data, err := client.doRequest("GET", server.URL+"/test", nil)
+
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"ok": true}`, string(data))
+			server.AssertRequestCount(t, 3)
+			assert.Equal(t, []int{1, 2, 3}, recorder.attempts)
+			assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}, *delays)
+		})
+	}
+}
+
+func TestDoRequest_RetriesExhausted(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetResponse(http.StatusServiceUnavailable, `{"error": "unavailable"}`)
+
+	client, recorder, delays := newRetryClient(server, 3)
+	_, err := client.doRequest("GET", server.URL+"/test", nil)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 503, apiErr.StatusCode)
+	assert.Equal(t, []int{1, 2, 3, 4}, recorder.attempts)
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond}, *delays)
+}
+
+func TestDoRequest_NoRetryOnClientError(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetResponse(http.StatusInternalServerError, `{"error": "server error"}`)
+
+	client, recorder, _ := newRetryClient(server, 3)
+	_, err := client.doRequest("GET", server.URL+"/test", nil)
+
+	require.Error(t, err)
+	assert.Equal(t, []int{1}, recorder.attempts)
+}
+
+func TestDoRequest_NoRetryByDefault(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetResponse(http.StatusServiceUnavailable, `{}`)
+
+	client := NewTestClient(server)
+	_, err := client.doRequest("GET", server.URL+"/test", nil)
+
+	require.Error(t, err)
+	server.AssertRequestCount(t, 1)
+}
+
+func TestDoRequest_RetryResendsBody(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetHandler(failNTimes(1, http.StatusServiceUnavailable))
+
+	client, _, _ := newRetryClient(server, 3)
+	_, err := client.doRequest("POST", server.URL+"/create", map[string]string{"name": "test"})
+
+	require.NoError(t, err)
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, requests[0].Body, requests[1].Body)
+	assert.Contains(t, string(requests[1].Body), `"name":"test"`)
+}
+
+func TestDoRequest_RetryAfterHeader(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	var calls int32
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "5")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	client, _, delays := newRetryClient(server, 3)
+	_, err := client.doRequest("GET", server.URL+"/test", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{5 * time.Second}, *delays)
+}
+
+func TestDoRequest_RetriesNetworkError(t *testing.T) {
+	server := NewMockServer()
+	client, recorder, _ := newRetryClient(server, 2)
+	server.Close()
+
+	_, err := client.doRequest("GET", server.URL+"/test", nil)
+
+	var respErr *ResponseError
+	require.ErrorAs(t, err, &respErr)
+	assert.Equal(t, "request failed", respErr.Message)
+	assert.Equal(t, []int{1, 2, 3}, recorder.attempts)
+}
+
+func TestDoRequest_PostNoRetryOnGatewayError(t *testing.T) {
+	for _, status := range []int{http.StatusBadGateway, http.StatusGatewayTimeout} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			server := NewMockServer()
+			defer server.Close()
+			server.SetHandler(failNTimes(1, status))
+
+			client, recorder, _ := newRetryClient(server, 3)
+			_, err := client.doRequest("POST", server.URL+"/create", map[string]string{"name": "test"})
+
+			var apiErr *APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, status, apiErr.StatusCode)
+			assert.Equal(t, []int{1}, recorder.attempts)
+		})
+	}
+}
+
+func TestDoRequest_PostRetriesRateLimit(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetHandler(failNTimes(1, http.StatusTooManyRequests))
+
+	client, recorder, _ := newRetryClient(server, 3)
+	_, err := client.doRequest("POST", server.URL+"/create", map[string]string{"name": "test"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, recorder.attempts)
+}
+
+func TestDoRequest_PostRetriesConnectionRefused(t *testing.T) {
+	server := NewMockServer()
+	client, recorder, _ := newRetryClient(server, 2)
+	server.Close()
+
+	_, err := client.doRequest("POST", server.URL+"/create", map[string]string{"name": "test"})
+
+	require.Error(t, err)
+	assert.Equal(t, []int{1, 2, 3}, recorder.attempts)
+}
+
+func TestNerdGraphQuery_NoRetryOnGatewayError(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetHandler(failNTimes(1, http.StatusBadGateway))
+
+	client, recorder, _ := newRetryClient(server, 3)
+	_, err := client.NerdGraphQuery(`mutation { noop }`, nil)
+
+	require.Error(t, err)
+	assert.Equal(t, []int{1}, recorder.attempts)
+}
+
+func TestDoRequest_NoRetryOnTimeout(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	client, recorder, _ := newRetryClient(server, 3)
+	client.HTTPClient.Timeout = 20 * time.Millisecond
+	_, err := client.doRequest("GET", server.URL+"/test", nil)
+
+	require.Error(t, err)
+	assert.Equal(t, []int{1}, recorder.attempts)
+}
+
+func TestIsRetryableError(t *testing.T) {
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	reset := &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	deadline := &url.Error{Op: "Get", URL: "https://api.newrelic.com", Err: context.DeadlineExceeded}
+
+	assert.True(t, isRetryableError("GET", reset))
+	assert.True(t, isRetryableError("GET", refused))
+	assert.True(t, isRetryableError("POST", refused))
+	assert.False(t, isRetryableError("POST", reset))
+	assert.False(t, isRetryableError("GET", deadline))
+	assert.False(t, isRetryableError("POST", deadline))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert.Equal(t, time.Duration(0), parseRetryAfter(""))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon"))
+	assert.Equal(t, 2*time.Second, parseRetryAfter("2"))
+	assert.Equal(t, maxRetryAfter, parseRetryAfter("3600"))
+
+	date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	d := parseRetryAfter(date)
+	assert.Greater(t, d, 8*time.Second)
+	assert.LessOrEqual(t, d, 10*time.Second)
+}
+
+func TestNewWithConfig_RetryDefaults(t *testing.T) {
+	client := NewWithConfig(ClientConfig{MaxRetries: 3})
+	assert.Equal(t, 3, client.MaxRetries)
+	assert.Equal(t, DefaultRetryDelay, client.RetryDelay)
+
+	client = NewWithConfig(ClientConfig{})
+	assert.Equal(t, 0, client.MaxRetries)
+}
+
 func TestNerdGraphQuery_Success(t *testing.T) {
 	server := NewMockServer()
 	defer server.Close()
@@ -325,3 +739,113 @@ func TestNerdGraphQuery_HTTPError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsUnauthorized(err))
 }
+
+func TestNerdGraphQuery_InvalidJSONKeepsRawResponse(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `<html>Service Unavailable</html>`)
+
+	client := NewTestClient(server)
+	_, err := client.NerdGraphQuery("{ actor { name } }", nil)
+
+	var respErr *ResponseError
+	require.ErrorAs(t, err, &respErr)
+	assert.Equal(t, "failed to parse response", respErr.Message)
+	assert.Equal(t, `<html>Service Unavailable</html>`, respErr.RawResponse)
+}
+
+func TestNerdGraphQuery_VerboseMasksAPIKey(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {}}}`)
+
+	var stderr bytes.Buffer
+	client := NewWithConfig(ClientConfig{
+		APIKey:  "NRAK-FROMAPIKEYFLAG0123456789",
+		Verbose: true,
+		Stderr:  &stderr,
+	})
+	client.HTTPClient = server.Client()
+	client.NerdGraphURL = server.URL + "/graphql"
+
+	_, err := client.NerdGraphQuery(`query { actor { user { id } } }`, nil)
+	require.NoError(t, err)
+
+	assert.Contains(t, stderr.String(), "Api-Key: NRAK-****")
+	assert.NotContains(t, stderr.String(), "NRAK-FROMAPIKEYFLAG0123456789")
+}
+
+func TestDoRequestWithContext_Cancelled(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetResponse(http.StatusOK, `{}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := NewTestClient(server)
+	_, err := client.doRequestWithContext(ctx, "GET", server.URL+"/test", nil)
+
+	require.ErrorIs(t, err, context.Canceled)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestDoRequestWithContext_CancelStopsRetries(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetResponse(http.StatusServiceUnavailable, `{}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	client, recorder, _ := newRetryClient(server, 3)
+	client.sleep = func(time.Duration) { cancel() }
+	_, err := client.doRequestWithContext(ctx, "GET", server.URL+"/test", nil)
+
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []int{1}, recorder.attempts)
+}
+
+func TestWait_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := &Client{}
+	start := time.Now()
+	err := client.wait(ctx, time.Minute)
+
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestNerdGraphQueryWithContext_Cancelled(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {}}}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := NewTestClient(server)
+	_, err := client.NerdGraphQueryWithContext(ctx, "{ actor { name } }", nil)
+
+	require.ErrorIs(t, err, context.Canceled)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestNerdGraphQuery_UsesClientContext(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {}}}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := NewTestClient(server)
+	client.Context = ctx
+	_, err := client.NerdGraphQuery("{ actor { name } }", nil)
+
+	require.ErrorIs(t, err, context.Canceled)
+	server.AssertRequestCount(t, 0)
+}
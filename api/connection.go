@@ -2,18 +2,28 @@ package api
 
 import "fmt"
 
+// maxAccessibleAccounts caps the accounts reported by TestConnection
+const maxAccessibleAccounts = 20
+
+// AccessibleAccount identifies an account the API key can access
+type AccessibleAccount struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
 // ConnectionTestResult holds the result of a connection test
 type ConnectionTestResult struct {
-	APIKeyValid   bool
-	AccountAccess bool
-	AccountID     int
-	AccountName   string
-	UserID        string
-	UserEmail     string
-	Region        string
-	NerdGraphURL  string
-	Error         error
-	ErrorMessage  string
+	APIKeyValid        bool
+	AccountAccess      bool
+	AccountID          int
+	AccountName        string
+	UserID             string
+	UserEmail          string
+	AccessibleAccounts []AccessibleAccount // First maxAccessibleAccounts accounts the key can access
+	Region             string
+	NerdGraphURL       string
+	Error              error
+	ErrorMessage       string
 }
 
 // TestConnection verifies the API key and optionally account access
@@ -24,7 +34,7 @@ func (c *Client) TestConnection() (*ConnectionTestResult, error) {
 	}
 
 	// First, test API key with a simple actor query
-	query := `query { actor { user { id email } } }`
+	query := `query { actor { user { id email } accounts { id name } } }`
 
 	data, err := c.NerdGraphQuery(query, nil)
 	if err != nil {
@@ -42,6 +52,19 @@ func (c *Client) TestConnection() (*ConnectionTestResult, error) {
 			result.UserID = safeString(user["id"])
 			result.UserEmail = safeString(user["email"])
 		}
+		if accounts, ok := safeSlice(actor["accounts"]); ok {
+			for _, a := range accounts {
+				if len(result.AccessibleAccounts) == maxAccessibleAccounts {
+					break
+				}
+				if account, ok := safeMap(a); ok {
+					result.AccessibleAccounts = append(result.AccessibleAccounts, AccessibleAccount{
+						ID:   safeInt(account["id"]),
+						Name: safeString(account["name"]),
+					})
+				}
+			}
+		}
 	}
 
 	// If account ID is configured, test account access
@@ -77,3 +100,73 @@ func (c *Client) TestConnection() (*ConnectionTestResult, error) {
 
 	return result, nil
 }
+
+// WhoAmIResult identifies the user and account the client is acting as.
+// The account fields are empty when no account ID is configured.
+type WhoAmIResult struct {
+	UserID      int    `json:"userId"`
+	UserName    string `json:"userName"`
+	UserEmail   string `json:"userEmail"`
+	AccountID   int    `json:"accountId,omitempty"`
+	AccountName string `json:"accountName,omitempty"`
+	Region      string `json:"region"`
+}
+
+// WhoAmI returns the user the API key belongs to and, if an account ID is
+// configured, that account, in a single NerdGraph call
+func (c *Client) WhoAmI() (*WhoAmIResult, error) {
+	query := `
+	query {
+		actor {
+			user { id name email }
+		}
+	}`
+	var variables map[string]interface{}
+
+	if !c.AccountID.IsEmpty() {
+		accountID, err := c.GetAccountIDInt()
+		if err != nil {
+			return nil, err
+		}
+		query = `
+	query($accountId: Int!) {
+		actor {
+			user { id name email }
+			account(id: $accountId) { id name }
+		}
+	}`
+		variables = map[string]interface{}{"accountId": accountID}
+	}
+
+	data, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(data["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(data)}
+	}
+	user, ok := safeMap(actor["user"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing user", RawResponse: rawResponse(data)}
+	}
+
+	region := c.Region
+	if region == "" {
+		region = string(RegionUS)
+	}
+
+	result := &WhoAmIResult{
+		UserID:    safeInt(user["id"]),
+		UserName:  safeString(user["name"]),
+		UserEmail: safeString(user["email"]),
+		Region:    region,
+	}
+	if account, ok := safeMap(actor["account"]); ok {
+		result.AccountID = safeInt(account["id"])
+		result.AccountName = safeString(account["name"])
+	}
+
+	return result, nil
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestConnection_AccessibleAccounts(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{
+		"data": {
+			"actor": {
+				"user": {"id": "1001", "email": "jane@example.com"},
+				"accounts": [
+					{"id": 12345, "name": "Production"},
+					{"id": 67890, "name": "Staging"}
+				]
+			}
+		}
+	}`)
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+	result, err := client.TestConnection()
+
+	require.NoError(t, err)
+	assert.True(t, result.APIKeyValid)
+	assert.Equal(t, "jane@example.com", result.UserEmail)
+	assert.Equal(t, []AccessibleAccount{
+		{ID: 12345, Name: "Production"},
+		{ID: 67890, Name: "Staging"},
+	}, result.AccessibleAccounts)
+	assert.Contains(t, string(server.LastRequest().Body), "accounts { id name }")
+}
+
+func TestTestConnection_AccessibleAccountsLimited(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	accounts := make([]string, 25)
+	for i := range accounts {
+		accounts[i] = fmt.Sprintf(`{"id": %d, "name": "Account %d"}`, i+1, i+1)
+	}
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"user": {"id": "1001"}, "accounts": [`+
+		strings.Join(accounts, ",")+`]}}}`)
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+	result, err := client.TestConnection()
+
+	require.NoError(t, err)
+	require.Len(t, result.AccessibleAccounts, maxAccessibleAccounts)
+	assert.Equal(t, 1, result.AccessibleAccounts[0].ID)
+	assert.Equal(t, 20, result.AccessibleAccounts[19].ID)
+}
+
+func TestTestConnection_InvalidKey(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"errors": [{"message": "Invalid API key"}]}`)
+
+	client := NewTestClient(server)
+	result, err := client.TestConnection()
+
+	require.NoError(t, err)
+	assert.False(t, result.APIKeyValid)
+	assert.Empty(t, result.AccessibleAccounts)
+	assert.Contains(t, result.ErrorMessage, "Invalid API key")
+}
+
+func TestWhoAmI(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{
+		"data": {
+			"actor": {
+				"user": {"id": 1001, "name": "Jane Doe", "email": "jane@example.com"},
+				"account": {"id": 12345, "name": "Production"}
+			}
+		}
+	}`)
+
+	client := NewTestClient(server)
+	result, err := client.WhoAmI()
+
+	require.NoError(t, err)
+	assert.Equal(t, &WhoAmIResult{
+		UserID:      1001,
+		UserName:    "Jane Doe",
+		UserEmail:   "jane@example.com",
+		AccountID:   12345,
+		AccountName: "Production",
+		Region:      "US",
+	}, result)
+
+	server.AssertRequestCount(t, 1)
+	body := string(server.LastRequest().Body)
+	assert.Contains(t, body, "account(id: $accountId) { id name }")
+	assert.Contains(t, body, `"accountId":12345`)
+}
+
+func TestWhoAmI_NoAccountID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{
+		"data": {"actor": {"user": {"id": 1001, "name": "Jane Doe", "email": "jane@example.com"}}}
+	}`)
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+	result, err := client.WhoAmI()
+
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", result.UserEmail)
+	assert.Zero(t, result.AccountID)
+	assert.NotContains(t, string(server.LastRequest().Body), "account(id:")
+}
+
+func TestWhoAmI_Error(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusUnauthorized, `{"error": "unauthorized"}`)
+
+	client := NewTestClient(server)
+	_, err := client.WhoAmI()
+
+	require.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+}
+
+func TestWhoAmI_MissingUser(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.WhoAmI()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing user")
+}
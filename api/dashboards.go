@@ -4,15 +4,69 @@ import "fmt"
 
 // ListDashboards returns all dashboards for the account
 func (c *Client) ListDashboards() ([]Dashboard, error) {
+	return c.ListDashboardsAll()
+}
+
+// ListDashboardsAll returns all dashboards for the account, following
+// entitySearch cursors until every page has been fetched
+func (c *Client) ListDashboardsAll() ([]Dashboard, error) {
+	return c.searchDashboardsAll(c.dashboardSearchQuery())
+}
+
+// SearchDashboards returns the dashboards in the account whose names contain
+// pattern, following entitySearch cursors until every page has been fetched
+func (c *Client) SearchDashboards(pattern string) ([]Dashboard, error) {
+	searchQuery := fmt.Sprintf("%s AND name LIKE '%%%s%%'", c.dashboardSearchQuery(), EscapeSearchValue(pattern))
+	return c.searchDashboardsAll(searchQuery)
+}
+
+// ListDashboardsPage returns one page of dashboards and the cursor for the
+// next page. An empty cursor fetches the first page, and an empty next
+// cursor marks the last.
+func (c *Client) ListDashboardsPage(cursor string) ([]Dashboard, string, error) {
+	return c.searchDashboardsPage(c.dashboardSearchQuery(), cursor)
+}
+
+// dashboardSearchQuery is the entity search query matching the account's
+// dashboards
+func (c *Client) dashboardSearchQuery() string {
+	return fmt.Sprintf("type = 'DASHBOARD' AND accountId = %s", c.AccountID)
+}
+
+// searchDashboardsAll fetches every page of an entity search for dashboards
+func (c *Client) searchDashboardsAll(searchQuery string) ([]Dashboard, error) {
+	var all []Dashboard
+	cursor := ""
+	for {
+		dashboards, nextCursor, err := c.searchDashboardsPage(searchQuery, cursor)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, dashboards...)
+		if nextCursor == "" {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if all == nil {
+		all = []Dashboard{}
+	}
+	return all, nil
+}
+
+// searchDashboardsPage fetches one page of an entity search for dashboards
+func (c *Client) searchDashboardsPage(searchQuery, cursor string) ([]Dashboard, string, error) {
 	if err := c.RequireAccountID(); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	query := `
-	query($query: String!) {
+	query($query: String!, $cursor: String) {
 		actor {
 			entitySearch(query: $query) {
-				results {
+				results(cursor: $cursor) {
+					nextCursor
 					entities {
 						guid
 						name
@@ -27,30 +81,33 @@ func (c *Client) ListDashboards() ([]Dashboard, error) {
 	}`
 
 	variables := map[string]interface{}{
-		"query": fmt.Sprintf("type = 'DASHBOARD' AND accountId = %s", c.AccountID),
+		"query": searchQuery,
+	}
+	if cursor != "" {
+		variables["cursor"] = cursor
 	}
 
 	result, err := c.NerdGraphQuery(query, variables)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Navigate the nested response safely
 	actor, ok := safeMap(result["actor"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing actor"}
+		return nil, "", &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
 	}
 	entitySearch, ok := safeMap(actor["entitySearch"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing entitySearch"}
+		return nil, "", &ResponseError{Message: "unexpected response format: missing entitySearch", RawResponse: rawResponse(result)}
 	}
 	results, ok := safeMap(entitySearch["results"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing results"}
+		return nil, "", &ResponseError{Message: "unexpected response format: missing results", RawResponse: rawResponse(result)}
 	}
 	entities, ok := safeSlice(results["entities"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing entities"}
+		return nil, "", &ResponseError{Message: "unexpected response format: missing entities", RawResponse: rawResponse(result)}
 	}
 
 	dashboards := make([]Dashboard, 0, len(entities))
@@ -66,34 +123,21 @@ func (c *Client) ListDashboards() ([]Dashboard, error) {
 		})
 	}
 
-	return dashboards, nil
+	return dashboards, safeString(results["nextCursor"]), nil
 }
 
 // GetDashboard returns detailed information for a specific dashboard
 func (c *Client) GetDashboard(guid EntityGUID) (*DashboardDetail, error) {
-	query := `
+	query := fmt.Sprintf(`
 	query($guid: EntityGuid!) {
 		actor {
 			entity(guid: $guid) {
 				... on DashboardEntity {
-					guid
-					name
-					description
-					permissions
-					pages {
-						guid
-						name
-						widgets {
-							id
-							title
-							visualization { id }
-							rawConfiguration
-						}
-					}
+					%s
 				}
 			}
 		}
-	}`
+	}`, dashboardFields)
 
 	variables := map[string]interface{}{
 		"guid": guid.String(),
@@ -106,129 +150,107 @@ func (c *Client) GetDashboard(guid EntityGUID) (*DashboardDetail, error) {
 
 	actor, ok := safeMap(result["actor"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing actor"}
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
 	}
 	entity, ok := safeMap(actor["entity"])
 	if !ok || entity == nil {
 		return nil, fmt.Errorf("dashboard not found")
 	}
 
-	dashboard := &DashboardDetail{
-		GUID:        EntityGUID(safeString(entity["guid"])),
-		Name:        safeString(entity["name"]),
-		Description: safeString(entity["description"]),
-		Permissions: safeString(entity["permissions"]),
-	}
-
-	// Parse pages
-	if pages, ok := safeSlice(entity["pages"]); ok {
-		for _, p := range pages {
-			page, ok := safeMap(p)
-			if !ok {
-				continue
-			}
-			dp := DashboardPage{
-				GUID: EntityGUID(safeString(page["guid"])),
-				Name: safeString(page["name"]),
-			}
-
-			if widgets, ok := safeSlice(page["widgets"]); ok {
-				for _, w := range widgets {
-					widget, ok := safeMap(w)
-					if !ok {
-						continue
-					}
-					dw := DashboardWidget{
-						ID:    safeString(widget["id"]),
-						Title: safeString(widget["title"]),
-					}
-					if viz, ok := safeMap(widget["visualization"]); ok {
-						dw.Visualization = viz
-					}
-					if conf, ok := safeMap(widget["rawConfiguration"]); ok {
-						dw.Configuration = conf
-					}
-					dp.Widgets = append(dp.Widgets, dw)
-				}
-			}
-			dashboard.Pages = append(dashboard.Pages, dp)
-		}
-	}
-
-	return dashboard, nil
+	return parseDashboardEntity(entity), nil
 }
 
 // DashboardInput represents the input for creating or updating a dashboard
 type DashboardInput struct {
-	Name        string               `json:"name"`
-	Description string               `json:"description,omitempty"`
-	Permissions string               `json:"permissions,omitempty"`
-	Pages       []DashboardPageInput `json:"pages"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+	Permissions string                   `json:"permissions,omitempty"`
+	Variables   []map[string]interface{} `json:"variables,omitempty"`
+	Pages       []DashboardPageInput     `json:"pages"`
 }
 
 // DashboardPageInput represents a page in dashboard input
 type DashboardPageInput struct {
-	Name    string                 `json:"name"`
-	Widgets []DashboardWidgetInput `json:"widgets,omitempty"`
+	GUID        EntityGUID             `json:"guid,omitempty"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Widgets     []DashboardWidgetInput `json:"widgets,omitempty"`
 }
 
 // DashboardWidgetInput represents a widget in dashboard page input
 type DashboardWidgetInput struct {
-	Title         string                 `json:"title"`
-	Visualization map[string]interface{} `json:"visualization"`
-	Layout        map[string]interface{} `json:"layout,omitempty"`
-	Configuration map[string]interface{} `json:"rawConfiguration"`
+	ID                string                 `json:"id,omitempty"`
+	Title             string                 `json:"title"`
+	Visualization     map[string]interface{} `json:"visualization"`
+	Layout            map[string]interface{} `json:"layout,omitempty"`
+	LinkedEntityGUIDs []EntityGUID           `json:"linkedEntityGuids,omitempty"`
+	Configuration     map[string]interface{} `json:"rawConfiguration"`
 }
 
-// CreateDashboard creates a new dashboard from the provided input
-func (c *Client) CreateDashboard(input *DashboardInput) (*DashboardDetail, error) {
-	if err := c.RequireAccountID(); err != nil {
-		return nil, err
-	}
-
-	mutation := `
-	mutation($accountId: Int!, $dashboard: DashboardInput!) {
-		dashboardCreate(accountId: $accountId, dashboard: $dashboard) {
-			entityResult {
-				guid
+// dashboardFields is the set of GraphQL fields fetched for a dashboard. It
+// includes everything ToInput needs, so that updating a dashboard from
+// GetDashboard keeps its variables, page descriptions and widget links.
+const dashboardFields = `guid
 				name
 				description
 				permissions
+				variables {
+					name
+					title
+					type
+					isMultiSelection
+					replacementStrategy
+					nrqlQuery { accountIds query }
+					items { title value { string } }
+					defaultValues { value { string } }
+					options { ignoreTimeRange excluded }
+				}
 				pages {
 					guid
 					name
+					description
 					widgets {
 						id
 						title
 						visualization { id }
+						layout { column row width height }
+						linkedEntityGuids
 						rawConfiguration
 					}
-				}
-			}
-			errors {
-				description
-				type
-			}
-		}
-	}`
+				}`
 
-	// Convert input to the format expected by NerdGraph
+// toGraphQL converts the input to a NerdGraph DashboardInput. withIDs
+// keeps page GUIDs and widget IDs, which updates need to change existing
+// pages and widgets in place.
+func (in *DashboardInput) toGraphQL(withIDs bool) map[string]interface{} {
 	dashboardMap := map[string]interface{}{
-		"name":        input.Name,
-		"permissions": input.Permissions,
+		"name": in.Name,
 	}
-	if input.Description != "" {
-		dashboardMap["description"] = input.Description
+	if in.Description != "" {
+		dashboardMap["description"] = in.Description
 	}
-	if input.Permissions == "" {
-		dashboardMap["permissions"] = "PUBLIC_READ_WRITE"
+	if in.Permissions != "" {
+		dashboardMap["permissions"] = in.Permissions
+	}
+	if len(in.Variables) > 0 {
+		variables := make([]map[string]interface{}, len(in.Variables))
+		for i, v := range in.Variables {
+			variables[i] = withoutNulls(v)
+		}
+		dashboardMap["variables"] = variables
 	}
 
-	pages := make([]map[string]interface{}, len(input.Pages))
-	for i, p := range input.Pages {
+	pages := make([]map[string]interface{}, len(in.Pages))
+	for i, p := range in.Pages {
 		pageMap := map[string]interface{}{
 			"name": p.Name,
 		}
+		if p.Description != "" {
+			pageMap["description"] = p.Description
+		}
+		if withIDs && p.GUID != "" {
+			pageMap["guid"] = p.GUID.String()
+		}
 		widgets := make([]map[string]interface{}, len(p.Widgets))
 		for j, w := range p.Widgets {
 			widgetMap := map[string]interface{}{
@@ -239,6 +261,12 @@ func (c *Client) CreateDashboard(input *DashboardInput) (*DashboardDetail, error
 			if w.Layout != nil {
 				widgetMap["layout"] = w.Layout
 			}
+			if len(w.LinkedEntityGUIDs) > 0 {
+				widgetMap["linkedEntityGuids"] = w.LinkedEntityGUIDs
+			}
+			if withIDs && w.ID != "" {
+				widgetMap["id"] = w.ID
+			}
 			widgets[j] = widgetMap
 		}
 		pageMap["widgets"] = widgets
@@ -246,6 +274,46 @@ func (c *Client) CreateDashboard(input *DashboardInput) (*DashboardDetail, error
 	}
 	dashboardMap["pages"] = pages
 
+	return dashboardMap
+}
+
+// withoutNulls returns a copy of m without its null values, which
+// NerdGraph returns for unset variable settings but rejects in some inputs
+func withoutNulls(m map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if v != nil {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// CreateDashboard creates a new dashboard from the provided input
+func (c *Client) CreateDashboard(input *DashboardInput) (*DashboardDetail, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	mutation := fmt.Sprintf(`
+	mutation($accountId: Int!, $dashboard: DashboardInput!) {
+		dashboardCreate(accountId: $accountId, dashboard: $dashboard) {
+			entityResult {
+				%s
+			}
+			errors {
+				description
+				type
+			}
+		}
+	}`, dashboardFields)
+
+	// New dashboards get new page GUIDs and widget IDs
+	dashboardMap := input.toGraphQL(false)
+	if input.Permissions == "" {
+		dashboardMap["permissions"] = "PUBLIC_READ_WRITE"
+	}
+
 	variables := map[string]interface{}{
 		"accountId": c.AccountID.Int(),
 		"dashboard": dashboardMap,
@@ -258,7 +326,7 @@ func (c *Client) CreateDashboard(input *DashboardInput) (*DashboardDetail, error
 
 	dashboardCreate, ok := safeMap(result["dashboardCreate"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing dashboardCreate"}
+		return nil, &ResponseError{Message: "unexpected response format: missing dashboardCreate", RawResponse: rawResponse(result)}
 	}
 
 	// Check for errors
@@ -270,7 +338,7 @@ func (c *Client) CreateDashboard(input *DashboardInput) (*DashboardDetail, error
 
 	entityResult, ok := safeMap(dashboardCreate["entityResult"])
 	if !ok || entityResult == nil {
-		return nil, &ResponseError{Message: "unexpected response format: missing entityResult"}
+		return nil, &ResponseError{Message: "unexpected response format: missing entityResult", RawResponse: rawResponse(result)}
 	}
 
 	return parseDashboardEntity(entityResult), nil
@@ -278,64 +346,20 @@ func (c *Client) CreateDashboard(input *DashboardInput) (*DashboardDetail, error
 
 // UpdateDashboard updates an existing dashboard
 func (c *Client) UpdateDashboard(guid EntityGUID, input *DashboardInput) (*DashboardDetail, error) {
-	mutation := `
+	mutation := fmt.Sprintf(`
 	mutation($guid: EntityGuid!, $dashboard: DashboardInput!) {
 		dashboardUpdate(guid: $guid, dashboard: $dashboard) {
 			entityResult {
-				guid
-				name
-				description
-				permissions
-				pages {
-					guid
-					name
-					widgets {
-						id
-						title
-						visualization { id }
-						rawConfiguration
-					}
-				}
+				%s
 			}
 			errors {
 				description
 				type
 			}
 		}
-	}`
+	}`, dashboardFields)
 
-	// Convert input to the format expected by NerdGraph
-	dashboardMap := map[string]interface{}{
-		"name": input.Name,
-	}
-	if input.Description != "" {
-		dashboardMap["description"] = input.Description
-	}
-	if input.Permissions != "" {
-		dashboardMap["permissions"] = input.Permissions
-	}
-
-	pages := make([]map[string]interface{}, len(input.Pages))
-	for i, p := range input.Pages {
-		pageMap := map[string]interface{}{
-			"name": p.Name,
-		}
-		widgets := make([]map[string]interface{}, len(p.Widgets))
-		for j, w := range p.Widgets {
-			widgetMap := map[string]interface{}{
-				"title":            w.Title,
-				"visualization":    w.Visualization,
-				"rawConfiguration": w.Configuration,
-			}
-			if w.Layout != nil {
-				widgetMap["layout"] = w.Layout
-			}
-			widgets[j] = widgetMap
-		}
-		pageMap["widgets"] = widgets
-		pages[i] = pageMap
-	}
-	dashboardMap["pages"] = pages
+	dashboardMap := input.toGraphQL(true)
 
 	variables := map[string]interface{}{
 		"guid":      guid.String(),
@@ -349,7 +373,7 @@ func (c *Client) UpdateDashboard(guid EntityGUID, input *DashboardInput) (*Dashb
 
 	dashboardUpdate, ok := safeMap(result["dashboardUpdate"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing dashboardUpdate"}
+		return nil, &ResponseError{Message: "unexpected response format: missing dashboardUpdate", RawResponse: rawResponse(result)}
 	}
 
 	// Check for errors
@@ -361,7 +385,7 @@ func (c *Client) UpdateDashboard(guid EntityGUID, input *DashboardInput) (*Dashb
 
 	entityResult, ok := safeMap(dashboardUpdate["entityResult"])
 	if !ok || entityResult == nil {
-		return nil, &ResponseError{Message: "unexpected response format: missing entityResult"}
+		return nil, &ResponseError{Message: "unexpected response format: missing entityResult", RawResponse: rawResponse(result)}
 	}
 
 	return parseDashboardEntity(entityResult), nil
@@ -376,6 +400,14 @@ func parseDashboardEntity(entity map[string]interface{}) *DashboardDetail {
 		Permissions: safeString(entity["permissions"]),
 	}
 
+	if variables, ok := safeSlice(entity["variables"]); ok {
+		for _, v := range variables {
+			if variable, ok := safeMap(v); ok {
+				dashboard.Variables = append(dashboard.Variables, variable)
+			}
+		}
+	}
+
 	if pages, ok := safeSlice(entity["pages"]); ok {
 		for _, p := range pages {
 			page, ok := safeMap(p)
@@ -383,8 +415,9 @@ func parseDashboardEntity(entity map[string]interface{}) *DashboardDetail {
 				continue
 			}
 			dp := DashboardPage{
-				GUID: EntityGUID(safeString(page["guid"])),
-				Name: safeString(page["name"]),
+				GUID:        EntityGUID(safeString(page["guid"])),
+				Name:        safeString(page["name"]),
+				Description: safeString(page["description"]),
 			}
 
 			if widgets, ok := safeSlice(page["widgets"]); ok {
@@ -403,6 +436,14 @@ func parseDashboardEntity(entity map[string]interface{}) *DashboardDetail {
 					if conf, ok := safeMap(widget["rawConfiguration"]); ok {
 						dw.Configuration = conf
 					}
+					if layout, ok := safeMap(widget["layout"]); ok {
+						dw.Layout = layout
+					}
+					if linked, ok := safeSlice(widget["linkedEntityGuids"]); ok {
+						for _, g := range linked {
+							dw.LinkedEntityGUIDs = append(dw.LinkedEntityGUIDs, EntityGUID(safeString(g)))
+						}
+					}
 					dp.Widgets = append(dp.Widgets, dw)
 				}
 			}
@@ -413,6 +454,121 @@ func parseDashboardEntity(entity map[string]interface{}) *DashboardDetail {
 	return dashboard
 }
 
+// PageByGUID returns the page with the given GUID
+func (d *DashboardDetail) PageByGUID(guid EntityGUID) (*DashboardPage, bool) {
+	for i := range d.Pages {
+		if d.Pages[i].GUID == guid {
+			return &d.Pages[i], true
+		}
+	}
+	return nil, false
+}
+
+// PageByName returns the first page with the given name
+func (d *DashboardDetail) PageByName(name string) (*DashboardPage, bool) {
+	for i := range d.Pages {
+		if d.Pages[i].Name == name {
+			return &d.Pages[i], true
+		}
+	}
+	return nil, false
+}
+
+// ToInput converts a dashboard into update input, keeping variables, page
+// GUIDs, widget IDs, layouts and linked entities so an update leaves
+// existing content in place
+func (d *DashboardDetail) ToInput() *DashboardInput {
+	input := &DashboardInput{
+		Name:        d.Name,
+		Description: d.Description,
+		Permissions: d.Permissions,
+		Variables:   d.Variables,
+		Pages:       make([]DashboardPageInput, len(d.Pages)),
+	}
+
+	for i, p := range d.Pages {
+		page := DashboardPageInput{
+			GUID:        p.GUID,
+			Name:        p.Name,
+			Description: p.Description,
+			Widgets:     make([]DashboardWidgetInput, len(p.Widgets)),
+		}
+		for j, w := range p.Widgets {
+			page.Widgets[j] = DashboardWidgetInput{
+				ID:                w.ID,
+				Title:             w.Title,
+				Visualization:     w.Visualization,
+				Layout:            w.Layout,
+				LinkedEntityGUIDs: w.LinkedEntityGUIDs,
+				Configuration:     w.Configuration,
+			}
+		}
+		input.Pages[i] = page
+	}
+
+	return input
+}
+
+// ToCreateInput converts a dashboard into input for creating a new
+// dashboard, dropping the page GUIDs and widget IDs of the original
+func (d *DashboardDetail) ToCreateInput() *DashboardInput {
+	input := d.ToInput()
+	for i := range input.Pages {
+		input.Pages[i].GUID = ""
+		for j := range input.Pages[i].Widgets {
+			input.Pages[i].Widgets[j].ID = ""
+		}
+	}
+	return input
+}
+
+// SetQueryAccountID replaces the account IDs of all widget and variable
+// NRQL queries. Widget configurations and variables are modified in place.
+func (in *DashboardInput) SetQueryAccountID(accountID int) {
+	for _, variable := range in.Variables {
+		if query, ok := safeMap(variable["nrqlQuery"]); ok {
+			query["accountIds"] = []interface{}{accountID}
+		}
+	}
+	for _, page := range in.Pages {
+		for _, widget := range page.Widgets {
+			queries, ok := safeSlice(widget.Configuration["nrqlQueries"])
+			if !ok {
+				continue
+			}
+			for _, q := range queries {
+				query, ok := safeMap(q)
+				if !ok {
+					continue
+				}
+				if _, ok := query["accountId"]; ok {
+					query["accountId"] = accountID
+				}
+				if _, ok := query["accountIds"]; ok {
+					query["accountIds"] = []interface{}{accountID}
+				}
+			}
+		}
+	}
+}
+
+// RenameDashboardPage renames a single page of a dashboard, leaving its
+// widgets and the other pages unchanged
+func (c *Client) RenameDashboardPage(dashboard *DashboardDetail, pageGUID EntityGUID, name string) (*DashboardDetail, error) {
+	if _, ok := dashboard.PageByGUID(pageGUID); !ok {
+		return nil, fmt.Errorf("page not found in dashboard: %s", pageGUID)
+	}
+
+	input := dashboard.ToInput()
+	for i := range input.Pages {
+		if input.Pages[i].GUID == pageGUID {
+			input.Pages[i].Name = name
+		}
+	}
+
+	return c.UpdateDashboard(dashboard.GUID, input)
+}
+
 // DeleteDashboard deletes a dashboard by GUID
 func (c *Client) DeleteDashboard(guid EntityGUID) error {
 	mutation := `
@@ -438,7 +594,7 @@ func (c *Client) DeleteDashboard(guid EntityGUID) error {
 	// Check for deletion errors
 	dashboardDelete, ok := safeMap(result["dashboardDelete"])
 	if !ok {
-		return &ResponseError{Message: "unexpected response format: missing dashboardDelete"}
+		return &ResponseError{Message: "unexpected response format: missing dashboardDelete", RawResponse: rawResponse(result)}
 	}
 
 	status := safeString(dashboardDelete["status"])
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -86,6 +87,121 @@ func TestListDashboards_Error(t *testing.T) {
 	assert.True(t, IsUnauthorized(err))
 }
 
+// dashboardPage builds an entitySearch response with one dashboard
+func dashboardPage(guid, name, nextCursor string) string {
+	cursor := "null"
+	if nextCursor != "" {
+		cursor = `"` + nextCursor + `"`
+	}
+	return `{"data": {"actor": {"entitySearch": {"results": {
+		"nextCursor": ` + cursor + `,
+		"entities": [{"guid": "` + guid + `", "name": "` + name + `", "accountId": 12345}]
+	}}}}}`
+}
+
+func TestListDashboardsAll_FollowsCursor(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req NerdGraphRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		switch req.Variables["cursor"] {
+		case nil:
+			_, _ = w.Write([]byte(dashboardPage("guid-1", "First", "cursor-2")))
+		case "cursor-2":
+			_, _ = w.Write([]byte(dashboardPage("guid-2", "Second", "cursor-3")))
+		default:
+			_, _ = w.Write([]byte(dashboardPage("guid-3", "Third", "")))
+		}
+	})
+
+	client := NewTestClient(server)
+	dashboards, err := client.ListDashboardsAll()
+
+	require.NoError(t, err)
+	require.Len(t, dashboards, 3)
+	assert.Equal(t, "First", dashboards[0].Name)
+	assert.Equal(t, "Second", dashboards[1].Name)
+	assert.Equal(t, "Third", dashboards[2].Name)
+	server.AssertRequestCount(t, 3)
+
+	requests := server.Requests()
+	assert.NotContains(t, string(requests[0].Body), `"cursor"`)
+	assert.Contains(t, string(requests[1].Body), `"cursor":"cursor-2"`)
+	assert.Contains(t, string(requests[2].Body), `"cursor":"cursor-3"`)
+	assert.Contains(t, string(requests[0].Body), "nextCursor")
+}
+
+func TestListDashboardsPage(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, dashboardPage("guid-1", "First", "cursor-2"))
+
+	client := NewTestClient(server)
+	dashboards, nextCursor, err := client.ListDashboardsPage("")
+
+	require.NoError(t, err)
+	require.Len(t, dashboards, 1)
+	assert.Equal(t, "cursor-2", nextCursor)
+	server.AssertRequestCount(t, 1)
+}
+
+func TestListDashboardsAll_ErrorOnLaterPage(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	calls := 0
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			_, _ = w.Write([]byte(dashboardPage("guid-1", "First", "cursor-2")))
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	client := NewTestClient(server)
+	_, err := client.ListDashboardsAll()
+
+	require.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+}
+
+func TestSearchDashboards(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "dashboards_list.json"))
+
+	client := NewTestClient(server)
+	dashboards, err := client.SearchDashboards("Production")
+
+	require.NoError(t, err)
+	require.Len(t, dashboards, 2)
+
+	var req NerdGraphRequest
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &req))
+	assert.Equal(t, "type = 'DASHBOARD' AND accountId = 12345 AND name LIKE '%Production%'", req.Variables["query"])
+}
+
+func TestSearchDashboards_EscapesPattern(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "dashboards_list.json"))
+
+	client := NewTestClient(server)
+	_, err := client.SearchDashboards(`Bob's \ board' OR name LIKE '`)
+	require.NoError(t, err)
+
+	var req NerdGraphRequest
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &req))
+	assert.Equal(t, `type = 'DASHBOARD' AND accountId = 12345 AND name LIKE '%Bob\'s \\ board\' OR name LIKE \'%'`, req.Variables["query"])
+}
+
 func TestGetDashboard(t *testing.T) {
 	server := NewMockServer()
 	defer server.Close()
@@ -112,6 +228,12 @@ func TestGetDashboard(t *testing.T) {
 	require.Len(t, dashboard.Pages[0].Widgets, 2)
 	assert.Equal(t, "Error Rate", dashboard.Pages[0].Widgets[0].Title)
 	assert.Equal(t, "Throughput", dashboard.Pages[0].Widgets[1].Title)
+
+	// Variables, page descriptions and linked entities are kept
+	require.Len(t, dashboard.Variables, 1)
+	assert.Equal(t, "appName", dashboard.Variables[0]["name"])
+	assert.Equal(t, "Latency breakdown", dashboard.Pages[1].Description)
+	assert.Equal(t, []EntityGUID{"MXxWSVp8REFTSEJPQVJEfDk5"}, dashboard.Pages[1].Widgets[0].LinkedEntityGUIDs)
 }
 
 func TestGetDashboard_WithWidgets(t *testing.T) {
@@ -130,6 +252,7 @@ func TestGetDashboard_WithWidgets(t *testing.T) {
 	assert.Equal(t, "widget-1", widget.ID)
 	require.NotNil(t, widget.Visualization)
 	assert.Equal(t, "viz.line", widget.Visualization["id"])
+	assert.Equal(t, float64(4), widget.Layout["width"])
 }
 
 func TestGetDashboard_NotFound(t *testing.T) {
@@ -163,3 +286,211 @@ func TestGetDashboard_GraphQLError(t *testing.T) {
 
 	require.Error(t, err)
 }
+
+func TestDashboardDetail_PageLookup(t *testing.T) {
+	dashboard := &DashboardDetail{
+		Pages: []DashboardPage{
+			{GUID: "page-001", Name: "Overview"},
+			{GUID: "page-002", Name: "Details"},
+		},
+	}
+
+	page, ok := dashboard.PageByGUID("page-002")
+	require.True(t, ok)
+	assert.Equal(t, "Details", page.Name)
+
+	page, ok = dashboard.PageByName("Overview")
+	require.True(t, ok)
+	assert.Equal(t, EntityGUID("page-001"), page.GUID)
+
+	_, ok = dashboard.PageByGUID("page-999")
+	assert.False(t, ok)
+	_, ok = dashboard.PageByName("Missing")
+	assert.False(t, ok)
+}
+
+func TestDashboardDetail_ToCreateInput(t *testing.T) {
+	dashboard := &DashboardDetail{
+		GUID:        "dash-001",
+		Name:        "Production Overview",
+		Description: "Key metrics",
+		Permissions: "PUBLIC_READ_WRITE",
+		Variables:   []map[string]interface{}{{"name": "appName", "type": "STRING"}},
+		Pages: []DashboardPage{
+			{
+				GUID: "page-001",
+				Name: "Overview",
+				Widgets: []DashboardWidget{
+					{
+						ID:            "widget-001",
+						Title:         "Throughput",
+						Visualization: map[string]interface{}{"id": "viz.line"},
+						Layout:        map[string]interface{}{"column": 1, "row": 1},
+					},
+				},
+			},
+		},
+	}
+
+	input := dashboard.ToCreateInput()
+
+	assert.Equal(t, "Production Overview", input.Name)
+	assert.Equal(t, "Key metrics", input.Description)
+	assert.Equal(t, "PUBLIC_READ_WRITE", input.Permissions)
+	assert.Equal(t, dashboard.Variables, input.Variables)
+	require.Len(t, input.Pages, 1)
+	assert.Empty(t, input.Pages[0].GUID)
+	assert.Equal(t, "Overview", input.Pages[0].Name)
+	require.Len(t, input.Pages[0].Widgets, 1)
+	assert.Empty(t, input.Pages[0].Widgets[0].ID)
+	assert.Equal(t, "Throughput", input.Pages[0].Widgets[0].Title)
+	assert.Equal(t, map[string]interface{}{"column": 1, "row": 1}, input.Pages[0].Widgets[0].Layout)
+
+	data, err := json.Marshal(input)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "guid")
+	assert.NotContains(t, string(data), `"id":"widget-001"`)
+}
+
+func TestDashboardInput_SetQueryAccountID(t *testing.T) {
+	var input DashboardInput
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "Test",
+		"pages": [{
+			"name": "Page",
+			"widgets": [
+				{"title": "A", "rawConfiguration": {"nrqlQueries": [{"accountId": 12345, "query": "SELECT 1"}]}},
+				{"title": "B", "rawConfiguration": {"nrqlQueries": [{"accountIds": [12345, 67890], "query": "SELECT 2"}]}},
+				{"title": "C", "rawConfiguration": {"text": "# Markdown"}}
+			]
+		}]
+	}`), &input))
+
+	input.SetQueryAccountID(0)
+
+	data, err := json.Marshal(input.Pages[0].Widgets)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"accountId":0`)
+	assert.Contains(t, string(data), `"accountIds":[0]`)
+	assert.Contains(t, string(data), `"text":"# Markdown"`)
+	assert.NotContains(t, string(data), "12345")
+}
+
+func TestDashboardInput_SetQueryAccountIDVariables(t *testing.T) {
+	input := DashboardInput{
+		Variables: []map[string]interface{}{
+			{"name": "app", "nrqlQuery": map[string]interface{}{"accountIds": []interface{}{12345}, "query": "SELECT 1"}},
+			{"name": "env", "type": "ENUM"},
+		},
+	}
+
+	input.SetQueryAccountID(67890)
+
+	assert.Equal(t, []interface{}{67890}, input.Variables[0]["nrqlQuery"].(map[string]interface{})["accountIds"])
+	assert.NotContains(t, input.Variables[1], "nrqlQuery")
+}
+
+func TestRenameDashboardPage(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "dashboard_detail.json"))
+
+	client := NewTestClient(server)
+	dashboard, err := client.GetDashboard(EntityGUID("MXxWSVp8REFTSEJPQVJEfDEyMzQ1"))
+	require.NoError(t, err)
+
+	response := `{
+		"data": {
+			"dashboardUpdate": {
+				"entityResult": {
+					"guid": "MXxWSVp8REFTSEJPQVJEfDEyMzQ1",
+					"name": "Production Overview",
+					"pages": [
+						{"guid": "page-001", "name": "Overview", "widgets": []},
+						{"guid": "page-002", "name": "Deep Dive", "widgets": []}
+					]
+				},
+				"errors": []
+			}
+		}
+	}`
+	server.SetResponse(http.StatusOK, response)
+
+	updated, err := client.RenameDashboardPage(dashboard, "page-002", "Deep Dive")
+	require.NoError(t, err)
+	assert.Equal(t, "Deep Dive", updated.Pages[1].Name)
+
+	req := server.LastRequest()
+	require.NotNil(t, req)
+
+	var body struct {
+		Variables struct {
+			GUID      string `json:"guid"`
+			Dashboard struct {
+				Name  string `json:"name"`
+				Pages []struct {
+					GUID    string                   `json:"guid"`
+					Name    string                   `json:"name"`
+					Widgets []map[string]interface{} `json:"widgets"`
+				} `json:"pages"`
+			} `json:"dashboard"`
+		} `json:"variables"`
+	}
+	require.NoError(t, json.Unmarshal(req.Body, &body))
+
+	assert.Equal(t, "MXxWSVp8REFTSEJPQVJEfDEyMzQ1", body.Variables.GUID)
+	assert.Equal(t, "Production Overview", body.Variables.Dashboard.Name)
+	require.Len(t, body.Variables.Dashboard.Pages, 2)
+
+	// Only the target page is renamed; pages keep their GUIDs and widgets
+	assert.Equal(t, "page-001", body.Variables.Dashboard.Pages[0].GUID)
+	assert.Equal(t, "Overview", body.Variables.Dashboard.Pages[0].Name)
+	assert.Equal(t, "page-002", body.Variables.Dashboard.Pages[1].GUID)
+	assert.Equal(t, "Deep Dive", body.Variables.Dashboard.Pages[1].Name)
+
+	widgets := body.Variables.Dashboard.Pages[0].Widgets
+	require.Len(t, widgets, 2)
+	assert.Equal(t, "widget-1", widgets[0]["id"])
+	assert.NotNil(t, widgets[0]["layout"])
+
+	// Variables, page descriptions and linked entities survive the rename
+	var raw struct {
+		Variables struct {
+			Dashboard map[string]interface{} `json:"dashboard"`
+		} `json:"variables"`
+	}
+	require.NoError(t, json.Unmarshal(req.Body, &raw))
+	dashboardVars, ok := raw.Variables.Dashboard["variables"].([]interface{})
+	require.True(t, ok, "variables missing from update")
+	require.Len(t, dashboardVars, 1)
+	variable := dashboardVars[0].(map[string]interface{})
+	assert.Equal(t, "appName", variable["name"])
+	assert.Equal(t, "SELECT uniques(appName) FROM Transaction", variable["nrqlQuery"].(map[string]interface{})["query"])
+	assert.NotContains(t, variable, "items", "null settings are not sent")
+
+	pages := raw.Variables.Dashboard["pages"].([]interface{})
+	details := pages[1].(map[string]interface{})
+	assert.Equal(t, "Latency breakdown", details["description"])
+	linked := details["widgets"].([]interface{})[0].(map[string]interface{})["linkedEntityGuids"]
+	assert.Equal(t, []interface{}{"MXxWSVp8REFTSEJPQVJEfDk5"}, linked)
+}
+
+func TestRenameDashboardPage_PageNotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "dashboard_detail.json"))
+
+	client := NewTestClient(server)
+	dashboard, err := client.GetDashboard(EntityGUID("MXxWSVp8REFTSEJPQVJEfDEyMzQ1"))
+	require.NoError(t, err)
+
+	_, err = client.RenameDashboardPage(dashboard, "page-999", "Renamed")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "page not found")
+
+	// No update was attempted
+	server.AssertRequestCount(t, 1)
+}
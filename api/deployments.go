@@ -1,6 +1,10 @@
 package api
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
 
 // ListDeployments returns all deployments for an application
 func (c *Client) ListDeployments(appID string) ([]Deployment, error) {
@@ -48,3 +52,27 @@ func (c *Client) CreateDeployment(appID string, revision, description, user, cha
 
 	return &resp.Deployment, nil
 }
+
+// GetDeployment returns a deployment of an application. The REST API has no
+// endpoint for a single deployment, so the application's deployments are
+// listed and searched.
+func (c *Client) GetDeployment(appID, deploymentID string) (*Deployment, error) {
+	deployments, err := c.ListDeployments(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range deployments {
+		if strconv.Itoa(d.ID) == deploymentID {
+			return &d, nil
+		}
+	}
+
+	return nil, fmt.Errorf("deployment %s: %w", deploymentID, ErrNotFound)
+}
+
+// DeleteDeployment deletes a deployment marker from an application
+func (c *Client) DeleteDeployment(appID, deploymentID string) error {
+	_, err := c.doRequest("DELETE", c.BaseURL+"/applications/"+appID+"/deployments/"+deploymentID+".json", nil)
+	return err
+}
@@ -113,3 +113,48 @@ func TestCreateDeployment_Error(t *testing.T) {
 
 	require.Error(t, err)
 }
+
+func TestGetDeployment(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "deployments_list.json"))
+
+	client := NewTestClient(server)
+	deployment, err := client.GetDeployment("12345678", "9001")
+
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.3", deployment.Revision)
+
+	_, err = client.GetDeployment("12345678", "1")
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestDeleteDeployment(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusNoContent, "")
+
+	client := NewTestClient(server)
+	err := client.DeleteDeployment("12345678", "9001")
+
+	require.NoError(t, err)
+	req := server.LastRequest()
+	assert.Equal(t, "DELETE", req.Method)
+	assert.Equal(t, "/applications/12345678/deployments/9001.json", req.Path)
+}
+
+func TestDeleteDeployment_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusNotFound, `{"error": {"title": "Deployment not found"}}`)
+
+	client := NewTestClient(server)
+	err := client.DeleteDeployment("12345678", "1")
+
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+}
@@ -1,12 +1,84 @@
 package api
 
-// SearchEntities searches for entities matching the query
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// entityMetadataCacheTTL is how long GetEntityMetadata results are reused
+const entityMetadataCacheTTL = 60 * time.Second
+
+type entityCacheEntry struct {
+	metadata  *EntityMetadata
+	fetchedAt time.Time
+}
+
+// SearchEntities searches for entities matching the query, following
+// entitySearch cursors until every page has been fetched
 func (c *Client) SearchEntities(queryStr string) ([]Entity, error) {
-	query := `
-	query($query: String!) {
+	var all []Entity
+	cursor := ""
+	for {
+		entities, nextCursor, err := c.SearchEntitiesPage(queryStr, cursor)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, entities...)
+		if nextCursor == "" {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if all == nil {
+		all = []Entity{}
+	}
+	return all, nil
+}
+
+// SearchEntitiesPage returns one page of entities matching the query and
+// the cursor for the next page. An empty cursor fetches the first page, and
+// an empty next cursor marks the last.
+func (c *Client) SearchEntitiesPage(queryStr, cursor string) ([]Entity, string, error) {
+	query := fmt.Sprintf(`
+	query($query: String!, $cursor: String) {
 		actor {
 			entitySearch(query: $query) {
-				results {
+				results(cursor: $cursor) {
+					%s
+				}
+			}
+		}
+	}`, entitySearchResultFields)
+
+	variables := map[string]interface{}{
+		"query": queryStr,
+	}
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, "", err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, "", &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	entitySearch, ok := safeMap(actor["entitySearch"])
+	if !ok {
+		return nil, "", &ResponseError{Message: "unexpected response format: missing entitySearch", RawResponse: rawResponse(result)}
+	}
+
+	return parseEntitySearchResults(result, entitySearch)
+}
+
+// entitySearchResultFields is the set of GraphQL fields requested from
+// entitySearch results
+const entitySearchResultFields = `nextCursor
 					entities {
 						guid
 						name
@@ -15,14 +87,134 @@ func (c *Client) SearchEntities(queryStr string) ([]Entity, error) {
 						domain
 						accountId
 						tags { key values }
-					}
+					}`
+
+// parseEntitySearchResults returns the entities and next cursor of an
+// entitySearch response. result is the whole response, for errors.
+func parseEntitySearchResults(result, entitySearch map[string]interface{}) ([]Entity, string, error) {
+	results, ok := safeMap(entitySearch["results"])
+	if !ok {
+		return nil, "", &ResponseError{Message: "unexpected response format: missing results", RawResponse: rawResponse(result)}
+	}
+	entitiesData, ok := safeSlice(results["entities"])
+	if !ok {
+		return nil, "", &ResponseError{Message: "unexpected response format: missing entities", RawResponse: rawResponse(result)}
+	}
+
+	entities := make([]Entity, 0, len(entitiesData))
+	for _, e := range entitiesData {
+		entity, ok := safeMap(e)
+		if !ok {
+			continue
+		}
+		entities = append(entities, parseEntity(entity))
+	}
+
+	return entities, safeString(results["nextCursor"]), nil
+}
+
+// bulkSearchBatchSize is how many searches BulkSearchEntities sends in one
+// GraphQL document
+const bulkSearchBatchSize = 10
+
+// BulkSearchEntities runs several entity searches, sending up to
+// bulkSearchBatchSize of them per request as aliased entitySearch fields.
+// The results are in the same order as queries. Searches with more than one
+// page of results have their remaining pages fetched one at a time.
+func (c *Client) BulkSearchEntities(queries []string) ([][]Entity, error) {
+	all := make([][]Entity, 0, len(queries))
+	for start := 0; start < len(queries); start += bulkSearchBatchSize {
+		end := min(start+bulkSearchBatchSize, len(queries))
+		batch, err := c.bulkSearchEntitiesBatch(queries[start:end])
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, batch...)
+	}
+	return all, nil
+}
+
+// bulkSearchEntitiesBatch runs up to bulkSearchBatchSize searches in one
+// request
+func (c *Client) bulkSearchEntitiesBatch(queries []string) ([][]Entity, error) {
+	var params, fields strings.Builder
+	variables := make(map[string]interface{}, len(queries))
+	for i, q := range queries {
+		if i > 0 {
+			params.WriteString(", ")
+		}
+		fmt.Fprintf(&params, "$query%d: String!", i)
+		fmt.Fprintf(&fields, `
+			search%d: entitySearch(query: $query%d) {
+				results {
+					%s
 				}
+			}`, i, i, entitySearchResultFields)
+		variables[fmt.Sprintf("query%d", i)] = q
+	}
+
+	query := fmt.Sprintf(`
+	query(%s) {
+		actor {%s
+		}
+	}`, params.String(), fields.String())
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+
+	batch := make([][]Entity, len(queries))
+	for i, q := range queries {
+		alias := fmt.Sprintf("search%d", i)
+		entitySearch, ok := safeMap(actor[alias])
+		if !ok {
+			return nil, &ResponseError{Message: "unexpected response format: missing " + alias, RawResponse: rawResponse(result)}
+		}
+
+		entities, cursor, err := parseEntitySearchResults(result, entitySearch)
+		if err != nil {
+			return nil, err
+		}
+		for cursor != "" {
+			var page []Entity
+			page, cursor, err = c.SearchEntitiesPage(q, cursor)
+			if err != nil {
+				return nil, err
+			}
+			entities = append(entities, page...)
+		}
+		batch[i] = entities
+	}
+
+	return batch, nil
+}
+
+// GetEntity returns a single entity by GUID, looked up directly rather
+// than through an entity search
+func (c *Client) GetEntity(guid EntityGUID) (*Entity, error) {
+	query := `
+	query($guid: EntityGuid!) {
+		actor {
+			entity(guid: $guid) {
+				guid
+				name
+				type
+				entityType
+				domain
+				accountId
+				tags { key values }
 			}
 		}
 	}`
 
 	variables := map[string]interface{}{
-		"query": queryStr,
+		"guid": guid.String(),
 	}
 
 	result, err := c.NerdGraphQuery(query, variables)
@@ -32,37 +224,359 @@ func (c *Client) SearchEntities(queryStr string) ([]Entity, error) {
 
 	actor, ok := safeMap(result["actor"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing actor"}
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
 	}
-	entitySearch, ok := safeMap(actor["entitySearch"])
-	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing entitySearch"}
+	entity, ok := safeMap(actor["entity"])
+	if !ok || entity == nil {
+		return nil, fmt.Errorf("entity not found: %s", guid)
 	}
-	results, ok := safeMap(entitySearch["results"])
+
+	ent := parseEntity(entity)
+	return &ent, nil
+}
+
+// parseEntity converts a NerdGraph entity map to an Entity, flattening
+// multi-valued tags into comma-separated strings
+func parseEntity(entity map[string]interface{}) Entity {
+	ent := Entity{
+		GUID:       EntityGUID(safeString(entity["guid"])),
+		Name:       safeString(entity["name"]),
+		Type:       safeString(entity["type"]),
+		EntityType: safeString(entity["entityType"]),
+		Domain:     safeString(entity["domain"]),
+		AccountID:  safeInt(entity["accountId"]),
+	}
+	for _, tag := range parseEntityTags(entity["tags"]) {
+		if ent.Tags == nil {
+			ent.Tags = make(map[string]string)
+		}
+		ent.Tags[tag.Key] = strings.Join(tag.Values, ",")
+	}
+	return ent
+}
+
+// GetEntityMetadata returns the full context of an entity (name, account,
+// type, tags, alert severity and permalink) in a single NerdGraph call.
+// Results are cached per client for 60 seconds, keyed by GUID.
+func (c *Client) GetEntityMetadata(guid EntityGUID) (*EntityMetadata, error) {
+	if cached, ok := c.cachedEntityMetadata(guid); ok {
+		return cached, nil
+	}
+
+	query := `
+	query($guid: EntityGuid!) {
+		actor {
+			entity(guid: $guid) {
+				guid
+				name
+				type
+				entityType
+				domain
+				accountId
+				permalink
+				tags { key values }
+				... on AlertableEntity {
+					alertSeverity
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"guid": guid.String(),
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing results"}
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
 	}
-	entitiesData, ok := safeSlice(results["entities"])
+	entity, ok := safeMap(actor["entity"])
+	if !ok || entity == nil {
+		return nil, fmt.Errorf("entity not found: %s", guid)
+	}
+
+	metadata := &EntityMetadata{
+		GUID:          EntityGUID(safeString(entity["guid"])),
+		Name:          safeString(entity["name"]),
+		Type:          safeString(entity["type"]),
+		EntityType:    safeString(entity["entityType"]),
+		Domain:        safeString(entity["domain"]),
+		AccountID:     safeInt(entity["accountId"]),
+		Tags:          parseEntityTags(entity["tags"]),
+		AlertSeverity: safeString(entity["alertSeverity"]),
+		Permalink:     safeString(entity["permalink"]),
+	}
+
+	c.cacheEntityMetadata(guid, metadata)
+
+	return metadata, nil
+}
+
+// cachedEntityMetadata returns a cached metadata entry if it has not expired
+func (c *Client) cachedEntityMetadata(guid EntityGUID) (*EntityMetadata, bool) {
+	c.entityCacheMu.Lock()
+	defer c.entityCacheMu.Unlock()
+
+	entry, ok := c.entityCache[guid]
+	if !ok || time.Since(entry.fetchedAt) > entityMetadataCacheTTL {
+		return nil, false
+	}
+	return entry.metadata, true
+}
+
+// cacheEntityMetadata stores a metadata entry for later lookups
+func (c *Client) cacheEntityMetadata(guid EntityGUID, metadata *EntityMetadata) {
+	c.entityCacheMu.Lock()
+	defer c.entityCacheMu.Unlock()
+
+	if c.entityCache == nil {
+		c.entityCache = make(map[EntityGUID]entityCacheEntry)
+	}
+	c.entityCache[guid] = entityCacheEntry{metadata: metadata, fetchedAt: time.Now()}
+}
+
+// invalidateEntityMetadata drops a cached metadata entry after a mutation
+func (c *Client) invalidateEntityMetadata(guid EntityGUID) {
+	c.entityCacheMu.Lock()
+	defer c.entityCacheMu.Unlock()
+
+	delete(c.entityCache, guid)
+}
+
+// parseEntityTags converts a NerdGraph tags list to EntityTags
+func parseEntityTags(v interface{}) []EntityTag {
+	tagsData, ok := safeSlice(v)
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing entities"}
+		return nil
 	}
 
-	entities := make([]Entity, 0, len(entitiesData))
-	for _, e := range entitiesData {
-		entity, ok := safeMap(e)
+	var tags []EntityTag
+	for _, t := range tagsData {
+		tag, ok := safeMap(t)
 		if !ok {
 			continue
 		}
-		ent := Entity{
-			GUID:       EntityGUID(safeString(entity["guid"])),
-			Name:       safeString(entity["name"]),
-			Type:       safeString(entity["type"]),
-			EntityType: safeString(entity["entityType"]),
-			Domain:     safeString(entity["domain"]),
-			AccountID:  safeInt(entity["accountId"]),
+		et := EntityTag{Key: safeString(tag["key"])}
+		if values, ok := safeSlice(tag["values"]); ok {
+			for _, val := range values {
+				et.Values = append(et.Values, safeString(val))
+			}
+		}
+		tags = append(tags, et)
+	}
+	return tags
+}
+
+// MissingTags returns the required tag keys that are not set on the entity
+func (e Entity) MissingTags(required []string) []string {
+	var missing []string
+	for _, key := range required {
+		if _, ok := e.Tags[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	return missing
+}
+
+// GetEntityTags returns the tags of an entity, bypassing the metadata cache
+func (c *Client) GetEntityTags(guid EntityGUID) ([]EntityTag, error) {
+	query := `
+	query($guid: EntityGuid!) {
+		actor {
+			entity(guid: $guid) {
+				tags { key values }
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"guid": guid.String(),
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	entity, ok := safeMap(actor["entity"])
+	if !ok || entity == nil {
+		return nil, fmt.Errorf("entity not found: %s", guid)
+	}
+
+	return parseEntityTags(entity["tags"]), nil
+}
+
+// AddEntityTags adds tags to an entity. Existing values for the same key are kept.
+func (c *Client) AddEntityTags(guid EntityGUID, tags []EntityTag) error {
+	mutation := `
+	mutation($guid: EntityGuid!, $tags: [TaggingTagInput!]!) {
+		taggingAddTagsToEntity(guid: $guid, tags: $tags) {
+			errors { message type }
+		}
+	}`
+
+	tagInputs := make([]map[string]interface{}, len(tags))
+	for i, t := range tags {
+		tagInputs[i] = map[string]interface{}{
+			"key":    t.Key,
+			"values": t.Values,
+		}
+	}
+
+	variables := map[string]interface{}{
+		"guid": guid.String(),
+		"tags": tagInputs,
+	}
+
+	result, err := c.NerdGraphQuery(mutation, variables)
+	if err != nil {
+		return err
+	}
+
+	addResult, ok := safeMap(result["taggingAddTagsToEntity"])
+	if !ok {
+		return &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
+	}
+	if errors, ok := safeSlice(addResult["errors"]); ok && len(errors) > 0 {
+		errMap, _ := safeMap(errors[0])
+		return fmt.Errorf("failed to add tags: %s", safeString(errMap["message"]))
+	}
+
+	c.invalidateEntityMetadata(guid)
+
+	return nil
+}
+
+// DeleteEntityTags removes the tags with the given keys from an entity
+func (c *Client) DeleteEntityTags(guid EntityGUID, keys []string) error {
+	mutation := `
+	mutation($guid: EntityGuid!, $tagKeys: [String!]!) {
+		taggingDeleteTagFromEntity(guid: $guid, tagKeys: $tagKeys) {
+			errors { message type }
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"guid":    guid.String(),
+		"tagKeys": keys,
+	}
+
+	result, err := c.NerdGraphQuery(mutation, variables)
+	if err != nil {
+		return err
+	}
+
+	deleteResult, ok := safeMap(result["taggingDeleteTagFromEntity"])
+	if !ok {
+		return &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
+	}
+	if errors, ok := safeSlice(deleteResult["errors"]); ok && len(errors) > 0 {
+		errMap, _ := safeMap(errors[0])
+		return fmt.Errorf("failed to delete tags: %s", safeString(errMap["message"]))
+	}
+
+	c.invalidateEntityMetadata(guid)
+
+	return nil
+}
+
+// relationshipDirections maps CLI directions to NerdGraph edge directions
+var relationshipDirections = map[string]string{
+	"upstream":   "INBOUND",
+	"downstream": "OUTBOUND",
+	"both":       "BOTH",
+}
+
+// ListEntityRelationships returns the relationships of an entity. direction
+// is upstream (relationships pointing at the entity), downstream
+// (relationships from it), or both.
+func (c *Client) ListEntityRelationships(guid EntityGUID, direction string) ([]EntityRelationship, error) {
+	edgeDirection, ok := relationshipDirections[direction]
+	if !ok {
+		return nil, fmt.Errorf("invalid direction %q: must be upstream, downstream, or both", direction)
+	}
+
+	query := `
+	query($guid: EntityGuid!, $direction: EntityRelationshipEdgeDirection) {
+		actor {
+			entity(guid: $guid) {
+				relatedEntities(filter: {direction: $direction}) {
+					results {
+						source { entity { guid name type } }
+						target { entity { guid name type } }
+						type
+					}
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"guid":      guid.String(),
+		"direction": edgeDirection,
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	entity, ok := safeMap(actor["entity"])
+	if !ok || entity == nil {
+		return nil, fmt.Errorf("entity not found: %s", guid)
+	}
+	related, ok := safeMap(entity["relatedEntities"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing relatedEntities", RawResponse: rawResponse(result)}
+	}
+	results, ok := safeSlice(related["results"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing results", RawResponse: rawResponse(result)}
+	}
+
+	relationships := make([]EntityRelationship, 0, len(results))
+	for _, r := range results {
+		rel, ok := safeMap(r)
+		if !ok {
+			continue
 		}
-		entities = append(entities, ent)
+		relationships = append(relationships, EntityRelationship{
+			Source: parseRelationshipEndpoint(rel["source"]),
+			Target: parseRelationshipEndpoint(rel["target"]),
+			Type:   safeString(rel["type"]),
+		})
 	}
 
-	return entities, nil
+	return relationships, nil
+}
+
+// parseRelationshipEndpoint converts a relationship source or target to an Entity
+func parseRelationshipEndpoint(v interface{}) Entity {
+	endpoint, _ := safeMap(v)
+	entity, _ := safeMap(endpoint["entity"])
+	return Entity{
+		GUID: EntityGUID(safeString(entity["guid"])),
+		Name: safeString(entity["name"]),
+		Type: safeString(entity["type"]),
+	}
+}
+
+// EscapeSearchValue escapes a value for use inside a single-quoted string in
+// an entity search query
+func EscapeSearchValue(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, "'", `\'`)
 }
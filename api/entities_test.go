@@ -1,8 +1,11 @@
 package api
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,6 +30,7 @@ func TestSearchEntities(t *testing.T) {
 	assert.Equal(t, "APM_APPLICATION_ENTITY", entities[0].EntityType)
 	assert.Equal(t, "APM", entities[0].Domain)
 	assert.Equal(t, 12345, entities[0].AccountID)
+	assert.Equal(t, map[string]string{"environment": "production", "team": "platform"}, entities[0].Tags)
 
 	// Verify second entity (Infrastructure host)
 	assert.Equal(t, "web-server-01", entities[1].Name)
@@ -64,6 +68,64 @@ func TestSearchEntities_Empty(t *testing.T) {
 	assert.Empty(t, entities)
 }
 
+// entityPage is an entitySearch response with one entity and the given
+// next cursor ("" for the last page)
+func entityPage(guid, name, nextCursor string) string {
+	cursor := "null"
+	if nextCursor != "" {
+		cursor = `"` + nextCursor + `"`
+	}
+	return `{"data": {"actor": {"entitySearch": {"results": {
+		"nextCursor": ` + cursor + `,
+		"entities": [{"guid": "` + guid + `", "name": "` + name + `", "type": "APPLICATION", "accountId": 12345}]
+	}}}}}`
+}
+
+func TestSearchEntities_FollowsCursor(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req NerdGraphRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		if req.Variables["cursor"] == "cursor-2" {
+			_, _ = w.Write([]byte(entityPage("guid-2", "Second", "")))
+			return
+		}
+		_, _ = w.Write([]byte(entityPage("guid-1", "First", "cursor-2")))
+	})
+
+	client := NewTestClient(server)
+	entities, err := client.SearchEntities("type = 'APPLICATION'")
+
+	require.NoError(t, err)
+	require.Len(t, entities, 2)
+	assert.Equal(t, "First", entities[0].Name)
+	assert.Equal(t, "Second", entities[1].Name)
+	server.AssertRequestCount(t, 2)
+
+	requests := server.Requests()
+	assert.NotContains(t, string(requests[0].Body), `"cursor"`)
+	assert.Contains(t, string(requests[1].Body), `"cursor":"cursor-2"`)
+	assert.Contains(t, string(requests[1].Body), `type = 'APPLICATION'`)
+}
+
+func TestSearchEntitiesPage(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, entityPage("guid-1", "First", "cursor-2"))
+
+	client := NewTestClient(server)
+	entities, nextCursor, err := client.SearchEntitiesPage("type = 'APPLICATION'", "")
+
+	require.NoError(t, err)
+	require.Len(t, entities, 1)
+	assert.Equal(t, "cursor-2", nextCursor)
+	server.AssertRequestCount(t, 1)
+}
+
 func TestSearchEntities_ByType(t *testing.T) {
 	server := NewMockServer()
 	defer server.Close()
@@ -123,3 +185,573 @@ func TestSearchEntities_InvalidResponse(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "unexpected response format")
 }
+
+// bulkSearchHandler answers both aliased bulk searches and single
+// searches with one entity per query, named after the query, after
+// waiting latency to stand in for a network round trip
+func bulkSearchHandler(latency time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req NerdGraphRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		time.Sleep(latency)
+
+		search := func(query interface{}) map[string]interface{} {
+			return map[string]interface{}{"results": map[string]interface{}{
+				"nextCursor": nil,
+				"entities":   []interface{}{map[string]interface{}{"guid": "guid-" + query.(string), "name": query}},
+			}}
+		}
+
+		actor := map[string]interface{}{}
+		if q, ok := req.Variables["query"]; ok {
+			actor["entitySearch"] = search(q)
+		}
+		for i := 0; i < bulkSearchBatchSize; i++ {
+			if q, ok := req.Variables[fmt.Sprintf("query%d", i)]; ok {
+				actor[fmt.Sprintf("search%d", i)] = search(q)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"actor": actor}})
+	}
+}
+
+// bulkQueries returns n distinct entity search queries
+func bulkQueries(n int) []string {
+	queries := make([]string, n)
+	for i := range queries {
+		queries[i] = fmt.Sprintf("name = 'app-%d'", i)
+	}
+	return queries
+}
+
+func TestBulkSearchEntities(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetHandler(bulkSearchHandler(0))
+
+	client := NewTestClient(server)
+	queries := bulkQueries(25)
+	results, err := client.BulkSearchEntities(queries)
+
+	require.NoError(t, err)
+	require.Len(t, results, 25)
+	for i, entities := range results {
+		require.Len(t, entities, 1)
+		assert.Equal(t, queries[i], entities[0].Name)
+	}
+
+	// 10 + 10 + 5 searches
+	server.AssertRequestCount(t, 3)
+	body := string(server.LastRequest().Body)
+	assert.Contains(t, body, "search4: entitySearch(query: $query4)")
+	assert.NotContains(t, body, "search5:")
+}
+
+func TestBulkSearchEntities_Empty(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	results, err := client.BulkSearchEntities(nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestBulkSearchEntities_FollowsCursor(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req NerdGraphRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		if req.Variables["cursor"] == "cursor-2" {
+			_, _ = w.Write([]byte(entityPage("guid-2", "Second", "")))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"actor": {
+			"search0": {"results": {"nextCursor": "cursor-2", "entities": [{"guid": "guid-1", "name": "First"}]}},
+			"search1": {"results": {"nextCursor": null, "entities": []}}
+		}}}`))
+	})
+
+	client := NewTestClient(server)
+	results, err := client.BulkSearchEntities([]string{"name LIKE 'a%'", "name LIKE 'b%'"})
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Len(t, results[0], 2)
+	assert.Equal(t, "First", results[0][0].Name)
+	assert.Equal(t, "Second", results[0][1].Name)
+	assert.Empty(t, results[1])
+	server.AssertRequestCount(t, 2)
+}
+
+func TestBulkSearchEntities_MissingAlias(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {
+		"search0": {"results": {"entities": []}}
+	}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.BulkSearchEntities([]string{"name = 'a'", "name = 'b'"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing search1")
+}
+
+func TestBulkSearchEntities_GraphQLError(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "graphql_error.json"))
+
+	client := NewTestClient(server)
+	_, err := client.BulkSearchEntities([]string{"name = 'a'"})
+
+	var gqlErr *GraphQLError
+	require.ErrorAs(t, err, &gqlErr)
+}
+
+// The bulk and sequential benchmarks run the same 50 searches against a
+// server with 1ms of latency per request: 5 requests against 50
+func BenchmarkBulkSearchEntities(b *testing.B) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetHandler(bulkSearchHandler(time.Millisecond))
+
+	client := NewTestClient(server)
+	queries := bulkQueries(50)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.BulkSearchEntities(queries); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSearchEntities_Sequential(b *testing.B) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetHandler(bulkSearchHandler(time.Millisecond))
+
+	client := NewTestClient(server)
+	queries := bulkQueries(50)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, q := range queries {
+			if _, err := client.SearchEntities(q); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func TestGetEntity(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "entity_metadata.json"))
+
+	client := NewTestClient(server)
+	entity, err := client.GetEntity("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=")
+
+	require.NoError(t, err)
+	require.NotNil(t, entity)
+
+	assert.Equal(t, EntityGUID("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg="), entity.GUID)
+	assert.Equal(t, "My Application", entity.Name)
+	assert.Equal(t, "APPLICATION", entity.Type)
+	assert.Equal(t, "APM_APPLICATION_ENTITY", entity.EntityType)
+	assert.Equal(t, "APM", entity.Domain)
+	assert.Equal(t, 12345, entity.AccountID)
+	assert.Equal(t, map[string]string{
+		"environment": "production",
+		"team":        "platform,sre",
+	}, entity.Tags)
+
+	server.AssertRequestCount(t, 1)
+	server.AssertLastPath(t, "/graphql")
+	req := server.LastRequest()
+	require.NotNil(t, req)
+	assert.Contains(t, string(req.Body), "entity(guid: $guid)")
+	assert.NotContains(t, string(req.Body), "entitySearch")
+	assert.Contains(t, string(req.Body), "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=")
+}
+
+func TestGetEntity_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"entity": null}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.GetEntity("MXxBUE18QVBQTElDQVRJT058OTk5OTk5OTk=")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "entity not found")
+}
+
+func TestGetEntity_MissingActor(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {}}`)
+
+	client := NewTestClient(server)
+	_, err := client.GetEntity("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing actor")
+}
+
+func TestGetEntity_GraphQLError(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "graphql_error.json"))
+
+	client := NewTestClient(server)
+	_, err := client.GetEntity("invalid")
+
+	require.Error(t, err)
+}
+
+func TestGetEntityMetadata(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "entity_metadata.json"))
+
+	client := NewTestClient(server)
+	metadata, err := client.GetEntityMetadata("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=")
+
+	require.NoError(t, err)
+	require.NotNil(t, metadata)
+
+	assert.Equal(t, EntityGUID("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg="), metadata.GUID)
+	assert.Equal(t, "My Application", metadata.Name)
+	assert.Equal(t, "APPLICATION", metadata.Type)
+	assert.Equal(t, "APM_APPLICATION_ENTITY", metadata.EntityType)
+	assert.Equal(t, "APM", metadata.Domain)
+	assert.Equal(t, 12345, metadata.AccountID)
+	assert.Equal(t, "WARNING", metadata.AlertSeverity)
+	assert.Contains(t, metadata.Permalink, "one.newrelic.com")
+
+	require.Len(t, metadata.Tags, 2)
+	assert.Equal(t, "environment", metadata.Tags[0].Key)
+	assert.Equal(t, []string{"production"}, metadata.Tags[0].Values)
+	assert.Equal(t, []string{"platform", "sre"}, metadata.Tags[1].Values)
+
+	// Verify single GraphQL call with GUID variable
+	server.AssertRequestCount(t, 1)
+	server.AssertLastPath(t, "/graphql")
+	req := server.LastRequest()
+	require.NotNil(t, req)
+	assert.Contains(t, string(req.Body), "alertSeverity")
+	assert.Contains(t, string(req.Body), "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=")
+}
+
+func TestGetEntityMetadata_Cached(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "entity_metadata.json"))
+
+	client := NewTestClient(server)
+	guid := EntityGUID("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=")
+
+	first, err := client.GetEntityMetadata(guid)
+	require.NoError(t, err)
+	second, err := client.GetEntityMetadata(guid)
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	server.AssertRequestCount(t, 1)
+}
+
+func TestGetEntityMetadata_CacheExpired(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "entity_metadata.json"))
+
+	client := NewTestClient(server)
+	guid := EntityGUID("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=")
+
+	_, err := client.GetEntityMetadata(guid)
+	require.NoError(t, err)
+
+	// Age the cache entry past the TTL
+	entry := client.entityCache[guid]
+	entry.fetchedAt = time.Now().Add(-2 * entityMetadataCacheTTL)
+	client.entityCache[guid] = entry
+
+	_, err = client.GetEntityMetadata(guid)
+	require.NoError(t, err)
+	server.AssertRequestCount(t, 2)
+}
+
+func TestGetEntityMetadata_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"entity": null}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.GetEntityMetadata("MXxBUE18QVBQTElDQVRJT058OTk5OTk5OTk=")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "entity not found")
+}
+
+func TestGetEntityMetadata_GraphQLError(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "graphql_error.json"))
+
+	client := NewTestClient(server)
+	_, err := client.GetEntityMetadata("invalid")
+
+	require.Error(t, err)
+
+	// Errors are not cached
+	assert.Empty(t, client.entityCache)
+}
+
+func TestEntity_MissingTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     map[string]string
+		required []string
+		expected []string
+	}{
+		{"all present", map[string]string{"team": "a", "environment": "prod"}, []string{"team", "environment"}, nil},
+		{"one missing", map[string]string{"team": "a"}, []string{"team", "environment"}, []string{"environment"}},
+		{"no tags", nil, []string{"team", "environment"}, []string{"team", "environment"}},
+		{"nothing required", map[string]string{"team": "a"}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := Entity{Tags: tt.tags}
+			assert.Equal(t, tt.expected, e.MissingTags(tt.required))
+		})
+	}
+}
+
+func TestGetEntityTags(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{
+		"data": {
+			"actor": {
+				"entity": {
+					"tags": [
+						{"key": "environment", "values": ["production"]},
+						{"key": "team", "values": ["backend", "platform"]}
+					]
+				}
+			}
+		}
+	}`)
+
+	client := NewTestClient(server)
+	tags, err := client.GetEntityTags("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=")
+
+	require.NoError(t, err)
+	assert.Equal(t, []EntityTag{
+		{Key: "environment", Values: []string{"production"}},
+		{Key: "team", Values: []string{"backend", "platform"}},
+	}, tags)
+	server.AssertLastPath(t, "/graphql")
+	assert.Contains(t, string(server.LastRequest().Body), "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=")
+}
+
+func TestGetEntityTags_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"entity": null}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.GetEntityTags("MXxBUE18QVBQTElDQVRJT058OTk5OTk5OTk=")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "entity not found")
+}
+
+func TestAddEntityTags(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"taggingAddTagsToEntity": {"errors": []}}}`)
+
+	client := NewTestClient(server)
+	err := client.AddEntityTags("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=", []EntityTag{
+		{Key: "team", Values: []string{"platform"}},
+	})
+
+	require.NoError(t, err)
+	server.AssertLastPath(t, "/graphql")
+	req := server.LastRequest()
+	require.NotNil(t, req)
+	assert.Contains(t, string(req.Body), "taggingAddTagsToEntity")
+	assert.Contains(t, string(req.Body), `"key":"team"`)
+	assert.Contains(t, string(req.Body), `"values":["platform"]`)
+}
+
+func TestAddEntityTags_Error(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	response := `{
+		"data": {
+			"taggingAddTagsToEntity": {
+				"errors": [{"message": "Tag key is reserved", "type": "INVALID_KEY"}]
+			}
+		}
+	}`
+	server.SetResponse(http.StatusOK, response)
+
+	client := NewTestClient(server)
+	err := client.AddEntityTags("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=", []EntityTag{
+		{Key: "guid", Values: []string{"x"}},
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Tag key is reserved")
+}
+
+func TestDeleteEntityTags(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"taggingDeleteTagFromEntity": {"errors": []}}}`)
+
+	client := NewTestClient(server)
+	err := client.DeleteEntityTags("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=", []string{"env", "team"})
+
+	require.NoError(t, err)
+	server.AssertLastPath(t, "/graphql")
+	req := server.LastRequest()
+	require.NotNil(t, req)
+	assert.Contains(t, string(req.Body), "taggingDeleteTagFromEntity")
+	assert.Contains(t, string(req.Body), `"tagKeys":["env","team"]`)
+}
+
+func TestDeleteEntityTags_InvalidatesCache(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	guid := EntityGUID("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=")
+	server.SetResponse(http.StatusOK, `{"data": {"taggingDeleteTagFromEntity": {"errors": []}}}`)
+
+	client := NewTestClient(server)
+	client.cacheEntityMetadata(guid, &EntityMetadata{GUID: guid})
+
+	require.NoError(t, client.DeleteEntityTags(guid, []string{"env"}))
+
+	_, cached := client.cachedEntityMetadata(guid)
+	assert.False(t, cached)
+}
+
+func TestDeleteEntityTags_Error(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	response := `{
+		"data": {
+			"taggingDeleteTagFromEntity": {
+				"errors": [{"message": "Tag key is immutable", "type": "INVALID_KEY"}]
+			}
+		}
+	}`
+	server.SetResponse(http.StatusOK, response)
+
+	client := NewTestClient(server)
+	err := client.DeleteEntityTags("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=", []string{"guid"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Tag key is immutable")
+}
+
+func TestListEntityRelationships(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "entity_relationships.json"))
+
+	client := NewTestClient(server)
+	rels, err := client.ListEntityRelationships("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=", "both")
+
+	require.NoError(t, err)
+	require.Len(t, rels, 2)
+
+	assert.Equal(t, "checkout-api", rels[0].Source.Name)
+	assert.Equal(t, "web-prod-01", rels[0].Target.Name)
+	assert.Equal(t, "HOST", rels[0].Target.Type)
+	assert.Equal(t, EntityGUID("MXxJTkZSQXxOQXwxMjM0NQ=="), rels[0].Target.GUID)
+	assert.Equal(t, "HOSTS", rels[0].Type)
+	assert.Equal(t, "CALLS", rels[1].Type)
+
+	req := server.LastRequest()
+	require.NotNil(t, req)
+	assert.Contains(t, string(req.Body), "relatedEntities")
+	assert.Contains(t, string(req.Body), `"direction":"BOTH"`)
+}
+
+func TestListEntityRelationships_Direction(t *testing.T) {
+	tests := map[string]string{
+		"upstream":   "INBOUND",
+		"downstream": "OUTBOUND",
+	}
+
+	for direction, expected := range tests {
+		t.Run(direction, func(t *testing.T) {
+			server := NewMockServer()
+			defer server.Close()
+
+			server.SetResponse(http.StatusOK, `{"data": {"actor": {"entity": {"relatedEntities": {"results": []}}}}}`)
+
+			client := NewTestClient(server)
+			rels, err := client.ListEntityRelationships("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=", direction)
+
+			require.NoError(t, err)
+			assert.Empty(t, rels)
+			assert.Contains(t, string(server.LastRequest().Body), `"direction":"`+expected+`"`)
+		})
+	}
+}
+
+func TestListEntityRelationships_InvalidDirection(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	_, err := client.ListEntityRelationships("MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=", "sideways")
+
+	require.Error(t, err)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestListEntityRelationships_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"entity": null}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.ListEntityRelationships("MXxBUE18QVBQTElDQVRJT058OTk5OTk5OTk=", "both")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "entity not found")
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 )
@@ -62,10 +63,12 @@ func (e *GraphQLError) Error() string {
 	return fmt.Sprintf("GraphQL error: %s", e.Message)
 }
 
-// ResponseError represents an error parsing the response
+// ResponseError represents an error parsing the response. RawResponse holds
+// the response that could not be parsed, for debugging.
 type ResponseError struct {
-	Message string
-	Err     error
+	Message     string
+	Err         error
+	RawResponse string
 }
 
 // Error implements the error interface
@@ -80,3 +83,12 @@ func (e *ResponseError) Error() string {
 func (e *ResponseError) Unwrap() error {
 	return e.Err
 }
+
+// rawResponse renders decoded response data as JSON for ResponseError
+func rawResponse(data interface{}) string {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return ""
+	}
+	return string(raw)
+}
@@ -3,7 +3,10 @@
 // apiAccessUpdateKeys, apiAccessDeleteKeys).
 package api
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // apiAccessKeyFields is the common set of GraphQL fields for API access keys
 const apiAccessKeyFields = `
@@ -13,8 +16,13 @@ const apiAccessKeyFields = `
 	type
 	key
 	... on ApiAccessIngestKey {
+		accountId
 		ingestType
 	}
+	... on ApiAccessUserKey {
+		accountId
+		userId
+	}
 `
 
 // SearchAPIKeys searches for API keys with optional type and account filters
@@ -57,19 +65,19 @@ func (c *Client) SearchAPIKeys(keyTypes []string, accountID int) ([]ApiAccessKey
 
 	actor, ok := safeMap(result["actor"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing actor"}
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
 	}
 	apiAccess, ok := safeMap(actor["apiAccess"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing apiAccess"}
+		return nil, &ResponseError{Message: "unexpected response format: missing apiAccess", RawResponse: rawResponse(result)}
 	}
 	keySearch, ok := safeMap(apiAccess["keySearch"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing keySearch"}
+		return nil, &ResponseError{Message: "unexpected response format: missing keySearch", RawResponse: rawResponse(result)}
 	}
 	keysData, ok := safeSlice(keySearch["keys"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing keys"}
+		return nil, &ResponseError{Message: "unexpected response format: missing keys", RawResponse: rawResponse(result)}
 	}
 
 	var keys []ApiAccessKey
@@ -100,11 +108,11 @@ func (c *Client) GetAPIAccessKey(keyID string, keyType string) (*ApiAccessKey, e
 
 	actor, ok := safeMap(result["actor"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing actor"}
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
 	}
 	apiAccess, ok := safeMap(actor["apiAccess"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing apiAccess"}
+		return nil, &ResponseError{Message: "unexpected response format: missing apiAccess", RawResponse: rawResponse(result)}
 	}
 	keyData, ok := safeMap(apiAccess["key"])
 	if !ok {
@@ -124,6 +132,49 @@ func (c *Client) FindAPIAccessKey(keyID string) (*ApiAccessKey, error) {
 	return c.GetAPIAccessKey(keyID, "INGEST")
 }
 
+// GetAPIKeyLastUsed returns when a key was created and last used. NerdGraph
+// requires the key type to look up a key, so keyType must be USER or INGEST.
+func (c *Client) GetAPIKeyLastUsed(keyID, keyType string) (*ApiAccessKeyMetadata, error) {
+	query := fmt.Sprintf(`
+	{
+		actor {
+			apiAccess {
+				key(id: "%s", keyType: %s) {
+					id
+					createdAt
+					... on ApiAccessUserKey {
+						lastUsed
+					}
+				}
+			}
+		}
+	}`, keyID, keyType)
+
+	result, err := c.NerdGraphQuery(query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	apiAccess, ok := safeMap(actor["apiAccess"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing apiAccess", RawResponse: rawResponse(result)}
+	}
+	keyData, ok := safeMap(apiAccess["key"])
+	if !ok {
+		return nil, fmt.Errorf("key not found: %s", keyID)
+	}
+
+	return &ApiAccessKeyMetadata{
+		ID:        safeString(keyData["id"]),
+		CreatedAt: parseEpochTime(keyData["createdAt"]),
+		LastUsed:  parseEpochTime(keyData["lastUsed"]),
+	}, nil
+}
+
 // GetCurrentUserID returns the current user's ID from NerdGraph
 func (c *Client) GetCurrentUserID() (int, error) {
 	query := `{ actor { user { id } } }`
@@ -135,11 +186,11 @@ func (c *Client) GetCurrentUserID() (int, error) {
 
 	actor, ok := safeMap(result["actor"])
 	if !ok {
-		return 0, &ResponseError{Message: "unexpected response format: missing actor"}
+		return 0, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
 	}
 	user, ok := safeMap(actor["user"])
 	if !ok {
-		return 0, &ResponseError{Message: "unexpected response format: missing user"}
+		return 0, &ResponseError{Message: "unexpected response format: missing user", RawResponse: rawResponse(result)}
 	}
 
 	return safeInt(user["id"]), nil
@@ -189,7 +240,7 @@ func (c *Client) execCreateKeys(mutation string) (*ApiAccessKey, error) {
 
 	createResult, ok := safeMap(result["apiAccessCreateKeys"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format"}
+		return nil, &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
 	}
 	if errors, ok := safeSlice(createResult["errors"]); ok && len(errors) > 0 {
 		errMap, _ := safeMap(errors[0])
@@ -198,7 +249,7 @@ func (c *Client) execCreateKeys(mutation string) (*ApiAccessKey, error) {
 
 	createdKeys, ok := safeSlice(createResult["createdKeys"])
 	if !ok || len(createdKeys) == 0 {
-		return nil, &ResponseError{Message: "unexpected response format: no created keys returned"}
+		return nil, &ResponseError{Message: "unexpected response format: no created keys returned", RawResponse: rawResponse(result)}
 	}
 
 	key := parseApiAccessKey(createdKeys[0])
@@ -246,7 +297,7 @@ func (c *Client) UpdateAPIAccessKey(keyID string, keyType string, update ApiAcce
 
 	updateResult, ok := safeMap(result["apiAccessUpdateKeys"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format"}
+		return nil, &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
 	}
 	if errors, ok := safeSlice(updateResult["errors"]); ok && len(errors) > 0 {
 		errMap, _ := safeMap(errors[0])
@@ -255,7 +306,7 @@ func (c *Client) UpdateAPIAccessKey(keyID string, keyType string, update ApiAcce
 
 	updatedKeys, ok := safeSlice(updateResult["updatedKeys"])
 	if !ok || len(updatedKeys) == 0 {
-		return nil, &ResponseError{Message: "unexpected response format: no updated keys returned"}
+		return nil, &ResponseError{Message: "unexpected response format: no updated keys returned", RawResponse: rawResponse(result)}
 	}
 
 	key := parseApiAccessKey(updatedKeys[0])
@@ -306,7 +357,7 @@ func (c *Client) DeleteAPIAccessKeys(userKeyIDs, ingestKeyIDs []string) ([]strin
 
 	deleteResult, ok := safeMap(result["apiAccessDeleteKeys"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format"}
+		return nil, &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
 	}
 	if errors, ok := safeSlice(deleteResult["errors"]); ok && len(errors) > 0 {
 		errMap, _ := safeMap(errors[0])
@@ -342,7 +393,25 @@ func parseApiAccessKey(v interface{}) ApiAccessKey {
 		Type:       safeString(m["type"]),
 		Key:        safeString(m["key"]),
 		IngestType: safeString(m["ingestType"]),
+		AccountID:  safeInt(m["accountId"]),
+		UserID:     safeInt(m["userId"]),
+	}
+}
+
+// parseEpochTime converts a NerdGraph EpochSeconds value (or an RFC3339
+// string) to a time, returning the zero time for null or unparseable values
+func parseEpochTime(v interface{}) time.Time {
+	switch t := v.(type) {
+	case float64:
+		if t > 0 {
+			return time.Unix(int64(t), 0).UTC()
+		}
+	case string:
+		if parsed, err := time.Parse(time.RFC3339, t); err == nil {
+			return parsed
+		}
 	}
+	return time.Time{}
 }
 
 // escapeGraphQL escapes special characters for GraphQL string values
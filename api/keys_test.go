@@ -3,6 +3,7 @@ package api
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -108,6 +109,8 @@ func TestGetAPIAccessKey(t *testing.T) {
 	assert.Equal(t, "My User Key", key.Name)
 	assert.Equal(t, "USER", key.Type)
 	assert.Equal(t, "For automation", key.Notes)
+	assert.Equal(t, 12345, key.AccountID)
+	assert.Equal(t, 1001, key.UserID)
 
 	// Verify request contained key ID and type
 	req := server.LastRequest()
@@ -178,7 +181,8 @@ func TestFindAPIAccessKey_FoundAsIngest(t *testing.T) {
 								"notes": "",
 								"type": "INGEST",
 								"key": "",
-								"ingestType": "LICENSE"
+								"ingestType": "LICENSE",
+								"accountId": 12345
 							}
 						}
 					}
@@ -193,9 +197,70 @@ func TestFindAPIAccessKey_FoundAsIngest(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, key)
 	assert.Equal(t, "INGEST", key.Type)
+	assert.Equal(t, 12345, key.AccountID)
+	assert.Equal(t, 0, key.UserID)
 	assert.Equal(t, 2, requestCount)
 }
 
+func TestGetAPIKeyLastUsed(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	response := `{
+		"data": {
+			"actor": {
+				"apiAccess": {
+					"key": {
+						"id": "NRAK-ABCDEF1234567890",
+						"createdAt": 1704067200,
+						"lastUsed": 1706745600
+					}
+				}
+			}
+		}
+	}`
+	server.SetResponse(http.StatusOK, response)
+
+	client := NewTestClient(server)
+	meta, err := client.GetAPIKeyLastUsed("NRAK-ABCDEF1234567890", "USER")
+
+	require.NoError(t, err)
+	assert.Equal(t, "NRAK-ABCDEF1234567890", meta.ID)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), meta.CreatedAt)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), meta.LastUsed)
+
+	body := string(server.LastRequest().Body)
+	assert.Contains(t, body, "keyType: USER")
+	assert.Contains(t, body, "lastUsed")
+}
+
+func TestGetAPIKeyLastUsed_NeverUsed(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"apiAccess": {"key": {"id": "NRII-1", "createdAt": 1704067200}}}}}`)
+
+	client := NewTestClient(server)
+	meta, err := client.GetAPIKeyLastUsed("NRII-1", "INGEST")
+
+	require.NoError(t, err)
+	assert.False(t, meta.CreatedAt.IsZero())
+	assert.True(t, meta.LastUsed.IsZero())
+}
+
+func TestGetAPIKeyLastUsed_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"apiAccess": {"key": null}}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.GetAPIKeyLastUsed("missing", "USER")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "key not found")
+}
+
 func TestGetCurrentUserID(t *testing.T) {
 	server := NewMockServer()
 	defer server.Close()
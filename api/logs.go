@@ -1,6 +1,13 @@
 package api
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// surroundingLogsLimit caps the logs fetched by GetSurroundingLogs
+const surroundingLogsLimit = 100
 
 // ListLogParsingRules returns all log parsing rules for the account
 func (c *Client) ListLogParsingRules() ([]LogParsingRule, error) {
@@ -40,19 +47,19 @@ func (c *Client) ListLogParsingRules() ([]LogParsingRule, error) {
 
 	actor, ok := safeMap(result["actor"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing actor"}
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
 	}
 	account, ok := safeMap(actor["account"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing account"}
+		return nil, &ResponseError{Message: "unexpected response format: missing account", RawResponse: rawResponse(result)}
 	}
 	logConfigs, ok := safeMap(account["logConfigurations"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing logConfigurations"}
+		return nil, &ResponseError{Message: "unexpected response format: missing logConfigurations", RawResponse: rawResponse(result)}
 	}
 	rulesData, ok := safeSlice(logConfigs["parsingRules"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing parsingRules"}
+		return nil, &ResponseError{Message: "unexpected response format: missing parsingRules", RawResponse: rawResponse(result)}
 	}
 
 	var rules []LogParsingRule
@@ -120,7 +127,7 @@ func (c *Client) CreateLogParsingRule(description, grok, nrql string, enabled bo
 
 	createResult, ok := safeMap(result["logConfigurationsCreateParsingRule"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format"}
+		return nil, &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
 	}
 	if errors, ok := safeSlice(createResult["errors"]); ok && len(errors) > 0 {
 		errMap, _ := safeMap(errors[0])
@@ -129,7 +136,7 @@ func (c *Client) CreateLogParsingRule(description, grok, nrql string, enabled bo
 
 	rule, ok := safeMap(createResult["rule"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing rule"}
+		return nil, &ResponseError{Message: "unexpected response format: missing rule", RawResponse: rawResponse(result)}
 	}
 
 	return &LogParsingRule{
@@ -162,11 +169,11 @@ func (c *Client) GetLogParsingRule(ruleID string) (*LogParsingRule, error) {
 // LogParsingRuleUpdate contains the fields that can be updated on a log parsing rule.
 // All fields are optional - only non-nil values will be included in the update.
 type LogParsingRuleUpdate struct {
-	Description *string
-	Enabled     *bool
-	Grok        *string
-	Lucene      *string
-	NRQL        *string
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+	Grok        *string `json:"grok,omitempty"`
+	Lucene      *string `json:"lucene,omitempty"`
+	NRQL        *string `json:"nrql,omitempty"`
 }
 
 // UpdateLogParsingRule updates an existing log parsing rule.
@@ -241,7 +248,7 @@ func (c *Client) UpdateLogParsingRule(ruleID string, update LogParsingRuleUpdate
 
 	updateResult, ok := safeMap(result["logConfigurationsUpdateParsingRule"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format"}
+		return nil, &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
 	}
 	if errors, ok := safeSlice(updateResult["errors"]); ok && len(errors) > 0 {
 		errMap, _ := safeMap(errors[0])
@@ -250,7 +257,7 @@ func (c *Client) UpdateLogParsingRule(ruleID string, update LogParsingRuleUpdate
 
 	rule, ok := safeMap(updateResult["rule"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing rule"}
+		return nil, &ResponseError{Message: "unexpected response format: missing rule", RawResponse: rawResponse(result)}
 	}
 
 	return &LogParsingRule{
@@ -284,7 +291,7 @@ func (c *Client) DeleteLogParsingRule(ruleID string) error {
 
 	deleteResult, ok := safeMap(result["logConfigurationsDeleteParsingRule"])
 	if !ok {
-		return &ResponseError{Message: "unexpected response format"}
+		return &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
 	}
 	if errors, ok := safeSlice(deleteResult["errors"]); ok && len(errors) > 0 {
 		errMap, _ := safeMap(errors[0])
@@ -293,3 +300,45 @@ func (c *Client) DeleteLogParsingRule(ruleID string) error {
 
 	return nil
 }
+
+// GetSurroundingLogs returns the logs within windowSecs seconds either side
+// of timestamp, oldest first. A non-empty entityGUID limits them to logs
+// from that entity.
+func (c *Client) GetSurroundingLogs(timestamp time.Time, entityGUID string, windowSecs int) ([]LogEntry, error) {
+	window := time.Duration(windowSecs) * time.Second
+	since := timestamp.Add(-window).UnixMilli()
+	// UNTIL is exclusive, so extend it by a millisecond to include the end
+	until := timestamp.Add(window).UnixMilli() + 1
+
+	nrql := "FROM Log SELECT *"
+	if entityGUID != "" {
+		nrql += fmt.Sprintf(" WHERE entity.guid = '%s'", EscapeSearchValue(entityGUID))
+	}
+	nrql += fmt.Sprintf(" SINCE %d UNTIL %d LIMIT %d", since, until, surroundingLogsLimit)
+
+	result, err := c.QueryNRQL(nrql)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]LogEntry, 0, len(result.Results))
+	for _, r := range result.Results {
+		if r == nil {
+			continue
+		}
+		entry := LogEntry{
+			Message:    safeString(r["message"]),
+			Attributes: r,
+		}
+		if ts, ok := r["timestamp"].(float64); ok {
+			entry.Timestamp = time.UnixMilli(int64(ts))
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+
+	return entries, nil
+}
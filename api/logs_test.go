@@ -1,8 +1,10 @@
 package api
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -344,3 +346,63 @@ func TestUpdateLogParsingRule_NoAccountID(t *testing.T) {
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrAccountIDRequired)
 }
+
+func TestGetSurroundingLogs(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"nrql": {"results": [
+		{"timestamp": 1735689605000, "message": "after", "entity.guid": "MXxBUE18QVBQTElDQVRJT058MQ=="},
+		{"timestamp": 1735689600000, "message": "match", "entity.guid": "MXxBUE18QVBQTElDQVRJT058MQ=="},
+		{"timestamp": 1735689597000, "message": "before", "entity.guid": "MXxBUE18QVBQTElDQVRJT058MQ=="}
+	]}}}}}`)
+
+	client := NewTestClient(server)
+	ts := time.UnixMilli(1735689600000)
+	entries, err := client.GetSurroundingLogs(ts, "MXxBUE18QVBQTElDQVRJT058MQ==", 5)
+
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	// Oldest first
+	assert.Equal(t, "before", entries[0].Message)
+	assert.Equal(t, "match", entries[1].Message)
+	assert.True(t, entries[1].Timestamp.Equal(ts))
+	assert.Equal(t, "after", entries[2].Message)
+	assert.Equal(t, "MXxBUE18QVBQTElDQVRJT058MQ==", entries[2].Attributes["entity.guid"])
+
+	var req NerdGraphRequest
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &req))
+	assert.Equal(t,
+		"FROM Log SELECT * WHERE entity.guid = 'MXxBUE18QVBQTElDQVRJT058MQ==' SINCE 1735689595000 UNTIL 1735689605001 LIMIT 100",
+		req.Variables["nrql"])
+}
+
+func TestGetSurroundingLogs_NoEntity(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"nrql": {"results": []}}}}}`)
+
+	client := NewTestClient(server)
+	entries, err := client.GetSurroundingLogs(time.UnixMilli(1735689600000), "", 2)
+
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	var req NerdGraphRequest
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &req))
+	assert.Equal(t, "FROM Log SELECT * SINCE 1735689598000 UNTIL 1735689602001 LIMIT 100", req.Variables["nrql"])
+}
+
+func TestGetSurroundingLogs_Error(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "graphql_error.json"))
+
+	client := NewTestClient(server)
+	_, err := client.GetSurroundingLogs(time.Now(), "", 1)
+
+	require.Error(t, err)
+}
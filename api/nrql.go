@@ -28,25 +28,73 @@ func (c *Client) QueryNRQL(nrql string) (*NRQLResult, error) {
 		return nil, err
 	}
 
+	return parseNRQLResult(result)
+}
+
+// QueryNRQLPaginated executes an NRQL query and returns one page of results.
+// Pass the NextCursor of the previous page to fetch the next one; an empty
+// cursor fetches the first page, and an empty NextCursor marks the last.
+func (c *Client) QueryNRQLPaginated(nrql string, cursor string) (*NRQLResult, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	query := `
+	query($accountId: Int!, $nrql: Nrql!, $cursor: String) {
+		actor {
+			account(id: $accountId) {
+				nrql(query: $nrql, cursor: $cursor) {
+					results
+					nextCursor
+				}
+			}
+		}
+	}`
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+		"nrql":      nrql,
+	}
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseNRQLResult(result)
+}
+
+// parseNRQLResult extracts the nrql container from a NerdGraph response
+func parseNRQLResult(result map[string]interface{}) (*NRQLResult, error) {
 	actor, ok := safeMap(result["actor"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing actor"}
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
 	}
 	account, ok := safeMap(actor["account"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing account"}
+		return nil, &ResponseError{Message: "unexpected response format: missing account", RawResponse: rawResponse(result)}
 	}
 	nrqlResult, ok := safeMap(account["nrql"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing nrql"}
+		return nil, &ResponseError{Message: "unexpected response format: missing nrql", RawResponse: rawResponse(result)}
 	}
+	return parseNRQLResults(nrqlResult)
+}
+
+// parseNRQLResults converts the results and nextCursor of an nrql container
+func parseNRQLResults(nrqlResult map[string]interface{}) (*NRQLResult, error) {
 	results, ok := safeSlice(nrqlResult["results"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing results"}
+		return nil, &ResponseError{Message: "unexpected response format: missing results", RawResponse: rawResponse(nrqlResult)}
 	}
 
 	nrqlResults := &NRQLResult{
-		Results: make([]map[string]interface{}, len(results)),
+		Results:    make([]map[string]interface{}, len(results)),
+		NextCursor: safeString(nrqlResult["nextCursor"]),
 	}
 	for i, r := range results {
 		if m, ok := safeMap(r); ok {
@@ -56,3 +104,118 @@ func (c *Client) QueryNRQL(nrql string) (*NRQLResult, error) {
 
 	return nrqlResults, nil
 }
+
+// QueryNRQLAsync submits an NRQL query as an asynchronous job, for queries
+// that would exceed the synchronous timeout. If the query finishes straight
+// away the returned job is already complete and carries the result;
+// otherwise poll it with PollNRQLAsyncJob.
+func (c *Client) QueryNRQLAsync(nrql string) (*NRQLAsyncJob, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	query := `
+	query($accountId: Int!, $nrql: Nrql!) {
+		actor {
+			account(id: $accountId) {
+				nrql(query: $nrql, async: true) {
+					results
+					queryProgress {
+						queryId
+						completed
+						retryAfter
+					}
+				}
+			}
+		}
+	}`
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+		"nrql":      nrql,
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseNRQLAsyncJob(result, "nrql")
+}
+
+// PollNRQLAsyncJob checks on an asynchronous NRQL job. It returns the
+// result and true once the job has completed, or nil and false while it is
+// still running.
+func (c *Client) PollNRQLAsyncJob(jobID string) (*NRQLResult, bool, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, false, err
+	}
+
+	query := `
+	query($accountId: Int!, $queryId: ID!) {
+		actor {
+			account(id: $accountId) {
+				nrqlQueryProgress(queryId: $queryId) {
+					results
+					queryProgress {
+						queryId
+						completed
+						retryAfter
+					}
+				}
+			}
+		}
+	}`
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+		"queryId":   jobID,
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, false, err
+	}
+
+	job, err := parseNRQLAsyncJob(result, "nrqlQueryProgress")
+	if err != nil {
+		return nil, false, err
+	}
+	return job.Result, job.Completed, nil
+}
+
+// parseNRQLAsyncJob extracts an async job from the named account field. A
+// missing queryProgress means the query completed synchronously.
+func parseNRQLAsyncJob(result map[string]interface{}, field string) (*NRQLAsyncJob, error) {
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	account, ok := safeMap(actor["account"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing account", RawResponse: rawResponse(result)}
+	}
+	nrqlResult, ok := safeMap(account[field])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing " + field, RawResponse: rawResponse(result)}
+	}
+
+	job := &NRQLAsyncJob{Completed: true}
+	if progress, ok := safeMap(nrqlResult["queryProgress"]); ok && progress != nil {
+		job.QueryID = safeString(progress["queryId"])
+		job.Completed = progress["completed"] == true
+		job.RetryAfter = safeInt(progress["retryAfter"])
+	}
+	if !job.Completed {
+		return job, nil
+	}
+
+	results, err := parseNRQLResults(nrqlResult)
+	if err != nil {
+		return nil, err
+	}
+	job.Result = results
+	return job, nil
+}
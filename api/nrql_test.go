@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -115,4 +116,192 @@ func TestQueryNRQL_InvalidResponse(t *testing.T) {
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "unexpected response format")
+
+	// The response that could not be navigated is kept for debugging
+	var respErr *ResponseError
+	require.ErrorAs(t, err, &respErr)
+	assert.JSONEq(t, `{"actor": {}}`, respErr.RawResponse)
+}
+
+func TestQueryNRQLPaginated(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{
+		"data": {
+			"actor": {
+				"account": {
+					"nrql": {
+						"results": [{"count": 1}, {"count": 2}],
+						"nextCursor": "cursor-2"
+					}
+				}
+			}
+		}
+	}`)
+
+	client := NewTestClient(server)
+	result, err := client.QueryNRQLPaginated("SELECT * FROM Log", "")
+
+	require.NoError(t, err)
+	require.Len(t, result.Results, 2)
+	assert.Equal(t, "cursor-2", result.NextCursor)
+
+	// The first page is requested without a cursor
+	var body struct {
+		Variables map[string]interface{} `json:"variables"`
+	}
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &body))
+	assert.NotContains(t, body.Variables, "cursor")
+}
+
+func TestQueryNRQLPaginated_ThreadsCursor(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{
+		"data": {
+			"actor": {
+				"account": {
+					"nrql": {
+						"results": [{"count": 3}]
+					}
+				}
+			}
+		}
+	}`)
+
+	client := NewTestClient(server)
+	result, err := client.QueryNRQLPaginated("SELECT * FROM Log", "cursor-2")
+
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Empty(t, result.NextCursor)
+
+	var body struct {
+		Variables map[string]interface{} `json:"variables"`
+	}
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &body))
+	assert.Equal(t, "cursor-2", body.Variables["cursor"])
+}
+
+func TestQueryNRQLPaginated_NoAccountID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+
+	_, err := client.QueryNRQLPaginated("SELECT * FROM Log", "")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrAccountIDRequired)
+}
+
+func TestQueryNRQLAsync_Pending(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"nrql": {
+		"results": null,
+		"queryProgress": {"queryId": "job-123", "completed": false, "retryAfter": 5}
+	}}}}}`)
+
+	client := NewTestClient(server)
+	job, err := client.QueryNRQLAsync("SELECT * FROM Log SINCE 1 month ago")
+
+	require.NoError(t, err)
+	assert.Equal(t, "job-123", job.QueryID)
+	assert.False(t, job.Completed)
+	assert.Equal(t, 5, job.RetryAfter)
+	assert.Nil(t, job.Result)
+
+	req := server.LastRequest()
+	require.NotNil(t, req)
+	assert.Contains(t, string(req.Body), "async: true")
+}
+
+func TestQueryNRQLAsync_CompletedImmediately(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "nrql_results.json"))
+
+	client := NewTestClient(server)
+	job, err := client.QueryNRQLAsync("SELECT count(*) FROM Transaction FACET name")
+
+	require.NoError(t, err)
+	assert.True(t, job.Completed)
+	require.NotNil(t, job.Result)
+	assert.Len(t, job.Result.Results, 3)
+}
+
+func TestQueryNRQLAsync_NoAccountID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+
+	_, err := client.QueryNRQLAsync("SELECT count(*) FROM Transaction")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrAccountIDRequired)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestPollNRQLAsyncJob(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"nrqlQueryProgress": {
+		"results": [{"count": 42}],
+		"queryProgress": {"queryId": "job-123", "completed": true}
+	}}}}}`)
+
+	client := NewTestClient(server)
+	result, done, err := client.PollNRQLAsyncJob("job-123")
+
+	require.NoError(t, err)
+	assert.True(t, done)
+	require.NotNil(t, result)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, float64(42), result.Results[0]["count"])
+
+	req := server.LastRequest()
+	require.NotNil(t, req)
+	var body NerdGraphRequest
+	require.NoError(t, json.Unmarshal(req.Body, &body))
+	assert.Equal(t, "job-123", body.Variables["queryId"])
+	assert.Contains(t, body.Query, "nrqlQueryProgress")
+}
+
+func TestPollNRQLAsyncJob_Running(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"nrqlQueryProgress": {
+		"results": null,
+		"queryProgress": {"queryId": "job-123", "completed": false, "retryAfter": 2}
+	}}}}}`)
+
+	client := NewTestClient(server)
+	result, done, err := client.PollNRQLAsyncJob("job-123")
+
+	require.NoError(t, err)
+	assert.False(t, done)
+	assert.Nil(t, result)
+}
+
+func TestPollNRQLAsyncJob_MissingProgress(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {}}}}`)
+
+	client := NewTestClient(server)
+	_, _, err := client.PollNRQLAsyncJob("job-123")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing nrqlQueryProgress")
 }
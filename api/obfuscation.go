@@ -0,0 +1,239 @@
+package api
+
+import "fmt"
+
+// obfuscationRuleFields is the common set of GraphQL fields for obfuscation rules
+const obfuscationRuleFields = `
+	id
+	name
+	description
+	enabled
+	filter
+	actions {
+		attributes
+		method
+		expression { id regex }
+	}`
+
+// ListObfuscationRules returns all log obfuscation rules for the account
+func (c *Client) ListObfuscationRules() ([]LogObfuscationRule, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+	query($accountId: Int!) {
+		actor {
+			account(id: $accountId) {
+				logConfigurations {
+					obfuscationRules {
+						%s
+					}
+				}
+			}
+		}
+	}`, obfuscationRuleFields)
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	account, ok := safeMap(actor["account"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing account", RawResponse: rawResponse(result)}
+	}
+	logConfigs, ok := safeMap(account["logConfigurations"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing logConfigurations", RawResponse: rawResponse(result)}
+	}
+	rulesData, ok := safeSlice(logConfigs["obfuscationRules"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing obfuscationRules", RawResponse: rawResponse(result)}
+	}
+
+	rules := make([]LogObfuscationRule, 0, len(rulesData))
+	for _, r := range rulesData {
+		if rule, ok := safeMap(r); ok {
+			rules = append(rules, parseObfuscationRule(rule))
+		}
+	}
+
+	return rules, nil
+}
+
+// CreateObfuscationRule creates a log obfuscation rule. NerdGraph keeps the
+// regex in a separate obfuscation expression, so one is created first and
+// the rule's action refers to it. If the rule cannot be created, the
+// expression is deleted again; should that fail too, the error names it.
+func (c *Client) CreateObfuscationRule(input LogObfuscationRuleInput) (*LogObfuscationRule, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	accountID, _ := c.GetAccountIDInt()
+
+	expressionMutation := `
+	mutation($accountId: Int!, $expression: LogConfigurationsCreateObfuscationExpressionInput!) {
+		logConfigurationsCreateObfuscationExpression(accountId: $accountId, expression: $expression) {
+			id
+		}
+	}`
+
+	result, err := c.NerdGraphQuery(expressionMutation, map[string]interface{}{
+		"accountId": accountID,
+		"expression": map[string]interface{}{
+			"name":  input.Name,
+			"regex": input.Regex,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create obfuscation expression: %w", err)
+	}
+
+	expression, ok := safeMap(result["logConfigurationsCreateObfuscationExpression"])
+	if !ok || safeString(expression["id"]) == "" {
+		return nil, &ResponseError{Message: "unexpected response format: missing expression", RawResponse: rawResponse(result)}
+	}
+
+	ruleMutation := fmt.Sprintf(`
+	mutation($accountId: Int!, $rule: LogConfigurationsCreateObfuscationRuleInput!) {
+		logConfigurationsCreateObfuscationRule(accountId: $accountId, rule: $rule) {
+			%s
+		}
+	}`, obfuscationRuleFields)
+
+	rule := map[string]interface{}{
+		"name":    input.Name,
+		"enabled": input.Enabled,
+		"actions": []map[string]interface{}{{
+			"attributes":   input.Attributes,
+			"expressionId": safeString(expression["id"]),
+			"method":       input.Method,
+		}},
+	}
+	if input.Description != "" {
+		rule["description"] = input.Description
+	}
+	if input.Filter != "" {
+		rule["filter"] = input.Filter
+	}
+
+	result, err = c.NerdGraphQuery(ruleMutation, map[string]interface{}{
+		"accountId": accountID,
+		"rule":      rule,
+	})
+	if err != nil {
+		expressionID := safeString(expression["id"])
+		if cleanupErr := c.deleteObfuscationExpression(accountID, expressionID); cleanupErr != nil {
+			return nil, fmt.Errorf("%w (obfuscation expression %s could not be deleted: %v)", err, expressionID, cleanupErr)
+		}
+		return nil, err
+	}
+
+	created, ok := safeMap(result["logConfigurationsCreateObfuscationRule"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing rule", RawResponse: rawResponse(result)}
+	}
+
+	parsed := parseObfuscationRule(created)
+	return &parsed, nil
+}
+
+// DeleteObfuscationRule deletes a log obfuscation rule. The rule's
+// expressions are left in place, since other rules may use them.
+func (c *Client) DeleteObfuscationRule(ruleID string) error {
+	if err := c.RequireAccountID(); err != nil {
+		return err
+	}
+
+	mutation := `
+	mutation($accountId: Int!, $id: ID!) {
+		logConfigurationsDeleteObfuscationRule(accountId: $accountId, id: $id) {
+			id
+		}
+	}`
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+		"id":        ruleID,
+	}
+
+	result, err := c.NerdGraphQuery(mutation, variables)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := safeMap(result["logConfigurationsDeleteObfuscationRule"]); !ok {
+		return &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
+	}
+
+	return nil
+}
+
+// deleteObfuscationExpression deletes an obfuscation expression
+func (c *Client) deleteObfuscationExpression(accountID int, expressionID string) error {
+	mutation := `
+	mutation($accountId: Int!, $id: ID!) {
+		logConfigurationsDeleteObfuscationExpression(accountId: $accountId, id: $id) {
+			id
+		}
+	}`
+
+	result, err := c.NerdGraphQuery(mutation, map[string]interface{}{
+		"accountId": accountID,
+		"id":        expressionID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, ok := safeMap(result["logConfigurationsDeleteObfuscationExpression"]); !ok {
+		return &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
+	}
+
+	return nil
+}
+
+// parseObfuscationRule converts a NerdGraph obfuscation rule map
+func parseObfuscationRule(rule map[string]interface{}) LogObfuscationRule {
+	parsed := LogObfuscationRule{
+		ID:          safeString(rule["id"]),
+		Name:        safeString(rule["name"]),
+		Description: safeString(rule["description"]),
+		Enabled:     rule["enabled"] == true,
+		Filter:      safeString(rule["filter"]),
+		Actions:     []LogObfuscationAction{},
+	}
+
+	actions, _ := safeSlice(rule["actions"])
+	for _, a := range actions {
+		action, ok := safeMap(a)
+		if !ok {
+			continue
+		}
+		parsedAction := LogObfuscationAction{Method: safeString(action["method"])}
+		if attributes, ok := safeSlice(action["attributes"]); ok {
+			for _, attr := range attributes {
+				parsedAction.Attributes = append(parsedAction.Attributes, safeString(attr))
+			}
+		}
+		if expression, ok := safeMap(action["expression"]); ok {
+			parsedAction.ExpressionID = safeString(expression["id"])
+			parsedAction.Regex = safeString(expression["regex"])
+		}
+		parsed.Actions = append(parsed.Actions, parsedAction)
+	}
+
+	return parsed
+}
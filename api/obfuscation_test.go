@@ -0,0 +1,223 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListObfuscationRules(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "log_obfuscation_rules.json"))
+
+	client := NewTestClient(server)
+	rules, err := client.ListObfuscationRules()
+
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+
+	assert.Equal(t, "101", rules[0].ID)
+	assert.Equal(t, "Mask SSN", rules[0].Name)
+	assert.True(t, rules[0].Enabled)
+	assert.Contains(t, rules[0].Filter, "billing")
+	require.Len(t, rules[0].Actions, 1)
+	assert.Equal(t, LogObfuscationAction{
+		Attributes:   []string{"message"},
+		Method:       "MASK",
+		ExpressionID: "201",
+		Regex:        `\d{3}-\d{2}-\d{4}`,
+	}, rules[0].Actions[0])
+
+	assert.False(t, rules[1].Enabled)
+	assert.Equal(t, "HASH_SHA256", rules[1].Actions[0].Method)
+	assert.Equal(t, []string{"message", "user.email"}, rules[1].Actions[0].Attributes)
+
+	server.AssertLastPath(t, "/graphql")
+	assert.Contains(t, string(server.LastRequest().Body), "obfuscationRules")
+}
+
+func TestListObfuscationRules_MissingRules(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"logConfigurations": {}}}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.ListObfuscationRules()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing obfuscationRules")
+}
+
+func TestListObfuscationRules_NoAccountID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+
+	_, err := client.ListObfuscationRules()
+
+	assert.ErrorIs(t, err, ErrAccountIDRequired)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestCreateObfuscationRule(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	var requests []NerdGraphRequest
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req NerdGraphRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		requests = append(requests, req)
+
+		if len(requests) == 1 {
+			_, _ = w.Write([]byte(`{"data": {"logConfigurationsCreateObfuscationExpression": {"id": "201"}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"logConfigurationsCreateObfuscationRule": {
+			"id": "101",
+			"name": "Mask SSN",
+			"enabled": true,
+			"actions": [{"attributes": ["message"], "method": "MASK", "expression": {"id": "201", "regex": "\\d{3}-\\d{2}-\\d{4}"}}]
+		}}}`))
+	})
+
+	client := NewTestClient(server)
+	rule, err := client.CreateObfuscationRule(LogObfuscationRuleInput{
+		Name:       "Mask SSN",
+		Enabled:    true,
+		Regex:      `\d{3}-\d{2}-\d{4}`,
+		Method:     "MASK",
+		Attributes: []string{"message"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "101", rule.ID)
+	assert.Equal(t, "201", rule.Actions[0].ExpressionID)
+
+	require.Len(t, requests, 2)
+
+	expression, ok := requests[0].Variables["expression"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, `\d{3}-\d{2}-\d{4}`, expression["regex"])
+
+	ruleInput, ok := requests[1].Variables["rule"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "Mask SSN", ruleInput["name"])
+	assert.NotContains(t, ruleInput, "filter")
+	actions, ok := ruleInput["actions"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, actions, 1)
+	action := actions[0].(map[string]interface{})
+	assert.Equal(t, "201", action["expressionId"])
+	assert.Equal(t, "MASK", action["method"])
+}
+
+func TestCreateObfuscationRule_ExpressionError(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"errors": [{"message": "Invalid regex"}]}`)
+
+	client := NewTestClient(server)
+	_, err := client.CreateObfuscationRule(LogObfuscationRuleInput{Name: "Bad", Regex: "(", Method: "MASK"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create obfuscation expression")
+	assert.Contains(t, err.Error(), "Invalid regex")
+	server.AssertRequestCount(t, 1)
+}
+
+func TestCreateObfuscationRule_RuleErrorDeletesExpression(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	var requests []NerdGraphRequest
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req NerdGraphRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		requests = append(requests, req)
+
+		switch len(requests) {
+		case 1:
+			_, _ = w.Write([]byte(`{"data": {"logConfigurationsCreateObfuscationExpression": {"id": "201"}}}`))
+		case 2:
+			_, _ = w.Write([]byte(`{"errors": [{"message": "Invalid attribute"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"data": {"logConfigurationsDeleteObfuscationExpression": {"id": "201"}}}`))
+		}
+	})
+
+	client := NewTestClient(server)
+	_, err := client.CreateObfuscationRule(LogObfuscationRuleInput{Name: "Mask SSN", Regex: `\d+`, Method: "MASK"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid attribute")
+	assert.NotContains(t, err.Error(), "201")
+
+	require.Len(t, requests, 3)
+	assert.Contains(t, requests[2].Query, "logConfigurationsDeleteObfuscationExpression")
+	assert.Equal(t, "201", requests[2].Variables["id"])
+}
+
+func TestCreateObfuscationRule_RuleErrorCleanupFails(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	calls := 0
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			_, _ = w.Write([]byte(`{"data": {"logConfigurationsCreateObfuscationExpression": {"id": "201"}}}`))
+		case 2:
+			_, _ = w.Write([]byte(`{"errors": [{"message": "Invalid attribute"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"errors": [{"message": "Access denied"}]}`))
+		}
+	})
+
+	client := NewTestClient(server)
+	_, err := client.CreateObfuscationRule(LogObfuscationRuleInput{Name: "Mask SSN", Regex: `\d+`, Method: "MASK"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid attribute")
+	assert.Contains(t, err.Error(), "obfuscation expression 201 could not be deleted")
+	assert.Contains(t, err.Error(), "Access denied")
+	server.AssertRequestCount(t, 3)
+}
+
+func TestDeleteObfuscationRule(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"logConfigurationsDeleteObfuscationRule": {"id": "101"}}}`)
+
+	client := NewTestClient(server)
+	err := client.DeleteObfuscationRule("101")
+
+	require.NoError(t, err)
+	body := string(server.LastRequest().Body)
+	assert.Contains(t, body, "logConfigurationsDeleteObfuscationRule")
+	assert.Contains(t, body, `"id":"101"`)
+}
+
+func TestDeleteObfuscationRule_Error(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"errors": [{"message": "Rule not found"}]}`)
+
+	client := NewTestClient(server)
+	err := client.DeleteObfuscationRule("999")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Rule not found")
+}
@@ -0,0 +1,177 @@
+package api
+
+import "fmt"
+
+// dataPartitionRuleFields is the common set of GraphQL fields for data
+// partition rules
+const dataPartitionRuleFields = `
+	id
+	targetDataPartition
+	description
+	enabled
+	nrql
+	retentionPolicy
+	deleted`
+
+// ListDataPartitionRules returns the log data partition rules for the
+// account, leaving out deleted rules
+func (c *Client) ListDataPartitionRules() ([]DataPartitionRule, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+	query($accountId: Int!) {
+		actor {
+			account(id: $accountId) {
+				logConfigurations {
+					dataPartitionRules {
+						%s
+					}
+				}
+			}
+		}
+	}`, dataPartitionRuleFields)
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	account, ok := safeMap(actor["account"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing account", RawResponse: rawResponse(result)}
+	}
+	logConfigs, ok := safeMap(account["logConfigurations"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing logConfigurations", RawResponse: rawResponse(result)}
+	}
+	rulesData, ok := safeSlice(logConfigs["dataPartitionRules"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing dataPartitionRules", RawResponse: rawResponse(result)}
+	}
+
+	rules := make([]DataPartitionRule, 0, len(rulesData))
+	for _, r := range rulesData {
+		rule, ok := safeMap(r)
+		if !ok || rule["deleted"] == true {
+			continue
+		}
+		rules = append(rules, parseDataPartitionRule(rule))
+	}
+
+	return rules, nil
+}
+
+// CreateDataPartitionRule creates a rule routing matching logs to a data
+// partition
+func (c *Client) CreateDataPartitionRule(input DataPartitionRuleInput) (*DataPartitionRule, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	mutation := fmt.Sprintf(`
+	mutation($accountId: Int!, $rule: LogConfigurationsCreateDataPartitionRuleInput!) {
+		logConfigurationsCreateDataPartitionRule(accountId: $accountId, rule: $rule) {
+			rule {
+				%s
+			}
+			errors { message type }
+		}
+	}`, dataPartitionRuleFields)
+
+	accountID, _ := c.GetAccountIDInt()
+	rule := map[string]interface{}{
+		"targetDataPartition": input.TargetDataPartition,
+		"enabled":             input.Enabled,
+		"nrql":                input.NRQL,
+		"retentionPolicy":     input.RetentionPolicy,
+	}
+	if input.Description != "" {
+		rule["description"] = input.Description
+	}
+	variables := map[string]interface{}{
+		"accountId": accountID,
+		"rule":      rule,
+	}
+
+	result, err := c.NerdGraphQuery(mutation, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	createResult, ok := safeMap(result["logConfigurationsCreateDataPartitionRule"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
+	}
+	if errors, ok := safeSlice(createResult["errors"]); ok && len(errors) > 0 {
+		errMap, _ := safeMap(errors[0])
+		return nil, fmt.Errorf("failed to create data partition rule: %s", safeString(errMap["message"]))
+	}
+
+	created, ok := safeMap(createResult["rule"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing rule", RawResponse: rawResponse(result)}
+	}
+
+	parsed := parseDataPartitionRule(created)
+	return &parsed, nil
+}
+
+// DeleteDataPartitionRule deletes a log data partition rule. Logs already
+// routed to the partition stay there.
+func (c *Client) DeleteDataPartitionRule(ruleID string) error {
+	if err := c.RequireAccountID(); err != nil {
+		return err
+	}
+
+	mutation := `
+	mutation($accountId: Int!, $id: String!) {
+		logConfigurationsDeleteDataPartitionRule(accountId: $accountId, id: $id) {
+			errors { message type }
+		}
+	}`
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+		"id":        ruleID,
+	}
+
+	result, err := c.NerdGraphQuery(mutation, variables)
+	if err != nil {
+		return err
+	}
+
+	deleteResult, ok := safeMap(result["logConfigurationsDeleteDataPartitionRule"])
+	if !ok {
+		return &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
+	}
+	if errors, ok := safeSlice(deleteResult["errors"]); ok && len(errors) > 0 {
+		errMap, _ := safeMap(errors[0])
+		return fmt.Errorf("failed to delete data partition rule: %s", safeString(errMap["message"]))
+	}
+
+	return nil
+}
+
+// parseDataPartitionRule converts a NerdGraph data partition rule map
+func parseDataPartitionRule(rule map[string]interface{}) DataPartitionRule {
+	return DataPartitionRule{
+		ID:                  safeString(rule["id"]),
+		TargetDataPartition: safeString(rule["targetDataPartition"]),
+		Description:         safeString(rule["description"]),
+		Enabled:             rule["enabled"] == true,
+		NRQL:                safeString(rule["nrql"]),
+		RetentionPolicy:     safeString(rule["retentionPolicy"]),
+	}
+}
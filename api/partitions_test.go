@@ -0,0 +1,146 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListDataPartitionRules(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "log_data_partition_rules.json"))
+
+	client := NewTestClient(server)
+	rules, err := client.ListDataPartitionRules()
+
+	require.NoError(t, err)
+	require.Len(t, rules, 2, "deleted rules are skipped")
+
+	assert.Equal(t, DataPartitionRule{
+		ID:                  "301",
+		TargetDataPartition: "Log_Security",
+		Description:         "Security events",
+		Enabled:             true,
+		NRQL:                "WHERE category = 'security'",
+		RetentionPolicy:     "STANDARD",
+	}, rules[0])
+	assert.Equal(t, "SECONDARY", rules[1].RetentionPolicy)
+	assert.False(t, rules[1].Enabled)
+
+	server.AssertLastPath(t, "/graphql")
+	assert.Contains(t, string(server.LastRequest().Body), "dataPartitionRules")
+}
+
+func TestListDataPartitionRules_MissingRules(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"logConfigurations": {}}}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.ListDataPartitionRules()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing dataPartitionRules")
+}
+
+func TestListDataPartitionRules_NoAccountID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+
+	_, err := client.ListDataPartitionRules()
+
+	assert.ErrorIs(t, err, ErrAccountIDRequired)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestCreateDataPartitionRule(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"logConfigurationsCreateDataPartitionRule": {
+		"rule": {
+			"id": "301",
+			"targetDataPartition": "Log_Security",
+			"enabled": true,
+			"nrql": "WHERE category = 'security'",
+			"retentionPolicy": "STANDARD"
+		},
+		"errors": []
+	}}}`)
+
+	client := NewTestClient(server)
+	rule, err := client.CreateDataPartitionRule(DataPartitionRuleInput{
+		TargetDataPartition: "Log_Security",
+		Enabled:             true,
+		NRQL:                "WHERE category = 'security'",
+		RetentionPolicy:     "STANDARD",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "301", rule.ID)
+	assert.Equal(t, "Log_Security", rule.TargetDataPartition)
+
+	var req NerdGraphRequest
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &req))
+	input, ok := req.Variables["rule"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "Log_Security", input["targetDataPartition"])
+	assert.Equal(t, "WHERE category = 'security'", input["nrql"])
+	assert.Equal(t, "STANDARD", input["retentionPolicy"])
+	assert.NotContains(t, input, "description")
+}
+
+func TestCreateDataPartitionRule_MutationError(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"logConfigurationsCreateDataPartitionRule": {
+		"rule": null,
+		"errors": [{"message": "Partition name must start with Log_", "type": "INVALID_NAME"}]
+	}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.CreateDataPartitionRule(DataPartitionRuleInput{TargetDataPartition: "Security"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must start with Log_")
+}
+
+func TestDeleteDataPartitionRule(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"logConfigurationsDeleteDataPartitionRule": {"errors": []}}}`)
+
+	client := NewTestClient(server)
+	err := client.DeleteDataPartitionRule("301")
+
+	require.NoError(t, err)
+	body := string(server.LastRequest().Body)
+	assert.Contains(t, body, "logConfigurationsDeleteDataPartitionRule")
+	assert.Contains(t, body, `"id":"301"`)
+}
+
+func TestDeleteDataPartitionRule_MutationError(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"logConfigurationsDeleteDataPartitionRule": {
+		"errors": [{"message": "Rule not found", "type": "NOT_FOUND"}]
+	}}}`)
+
+	client := NewTestClient(server)
+	err := client.DeleteDataPartitionRule("999")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Rule not found")
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimitingTransport is an http.RoundTripper that paces requests so that
+// no more than a fixed number are sent per second
+type RateLimitingTransport struct {
+	Base    http.RoundTripper // Defaults to http.DefaultTransport
+	limiter *limiter
+}
+
+// NewRateLimitingTransport wraps base so that at most perSecond requests
+// are sent per second
+func NewRateLimitingTransport(base http.RoundTripper, perSecond float64) *RateLimitingTransport {
+	return &RateLimitingTransport{
+		Base:    base,
+		limiter: newLimiter(perSecond),
+	}
+}
+
+// RoundTrip implements http.RoundTripper, waiting for the limiter before
+// forwarding the request
+func (t *RateLimitingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+// limiter spaces events evenly at a fixed rate, without bursts
+type limiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time // Earliest time the next event may happen
+}
+
+func newLimiter(perSecond float64) *limiter {
+	return &limiter{interval: time.Duration(float64(time.Second) / perSecond)}
+}
+
+// Wait blocks until the next event is allowed or ctx is done
+func (l *limiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
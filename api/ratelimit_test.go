@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitingTransport_PacesRequests(t *testing.T) {
+	var count atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRateLimitingTransport(nil, 50)}
+
+	// The first request goes out immediately, then one every 20ms
+	const requests = 11
+	start := time.Now()
+	for i := 0; i < requests; i++ {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	elapsed := time.Since(start)
+
+	assert.Equal(t, int32(requests), count.Load())
+	assert.GreaterOrEqual(t, elapsed, 200*time.Millisecond)
+	assert.Less(t, elapsed, time.Second)
+}
+
+func TestRateLimitingTransport_ContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRateLimitingTransport(nil, 0.1)}
+
+	// Use up the first slot so the next request has to wait 10s
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestNewWithConfig_RateLimit(t *testing.T) {
+	c := NewWithConfig(ClientConfig{APIKey: "test", RateLimit: 25})
+	assert.IsType(t, &RateLimitingTransport{}, c.HTTPClient.Transport)
+
+	c = NewWithConfig(ClientConfig{APIKey: "test"})
+	assert.Nil(t, c.HTTPClient.Transport)
+}
@@ -32,7 +32,7 @@ func (c *Client) ResolveAppID(identifier string) (string, error) {
 // resolveAppName looks up an application by name and returns its ID
 func (c *Client) resolveAppName(name string) (string, error) {
 	// Search for APM applications with the exact name
-	query := fmt.Sprintf("name = '%s' AND domain = 'APM' AND type = 'APPLICATION'", name)
+	query := fmt.Sprintf("name = '%s' AND domain = 'APM' AND type = 'APPLICATION'", EscapeSearchValue(name))
 	entities, err := c.SearchEntities(query)
 	if err != nil {
 		return "", fmt.Errorf("failed to search for application: %w", err)
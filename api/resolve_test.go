@@ -2,9 +2,12 @@ package api
 
 import (
 	"encoding/base64"
+	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEntityGUID_Parse(t *testing.T) {
@@ -189,6 +192,25 @@ func TestIsValidEntityGUID(t *testing.T) {
 
 // APIKey tests
 
+func TestResolveAppID_Name(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"entitySearch": {"results": {"entities": [
+		{"guid": "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=", "name": "Bob's app", "type": "APPLICATION", "domain": "APM", "accountId": 1}
+	]}}}}}`)
+
+	client := NewTestClient(server)
+	appID, err := client.ResolveAppID("Bob's app")
+
+	require.NoError(t, err)
+	assert.Equal(t, "12345678", appID)
+
+	var req NerdGraphRequest
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &req))
+	assert.Equal(t, `name = 'Bob\'s app' AND domain = 'APM' AND type = 'APPLICATION'`, req.Variables["query"])
+}
+
 func TestNewAPIKey(t *testing.T) {
 	t.Run("valid NRAK key", func(t *testing.T) {
 		key, warning, err := NewAPIKey("NRAK-ABCDEFGHIJ1234567890")
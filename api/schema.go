@@ -0,0 +1,46 @@
+package api
+
+// schemaQuery introspects the NerdGraph types and their fields. Wrapped
+// types such as lists and non-null types are unwrapped two levels deep
+// through ofType.
+const schemaQuery = `
+{
+	__schema {
+		types {
+			name
+			kind
+			description
+			fields {
+				name
+				type {
+					name
+					kind
+					ofType {
+						name
+						kind
+						ofType { name kind }
+					}
+				}
+			}
+		}
+	}
+}`
+
+// GetNerdGraphSchema returns the NerdGraph schema from an introspection
+// query, as the decoded __schema object
+func (c *Client) GetNerdGraphSchema() (map[string]interface{}, error) {
+	result, err := c.NerdGraphQuery(schemaQuery, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, ok := safeMap(result["__schema"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing __schema", RawResponse: rawResponse(result)}
+	}
+	if _, ok := safeSlice(schema["types"]); !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing types", RawResponse: rawResponse(result)}
+	}
+
+	return schema, nil
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNerdGraphSchema(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "nerdgraph_schema.json"))
+
+	client := NewTestClient(server)
+	schema, err := client.GetNerdGraphSchema()
+
+	require.NoError(t, err)
+	types, ok := safeSlice(schema["types"])
+	require.True(t, ok)
+	require.Len(t, types, 4)
+
+	first, ok := safeMap(types[0])
+	require.True(t, ok)
+	assert.Equal(t, "DashboardEntity", first["name"])
+
+	server.AssertLastPath(t, "/graphql")
+	req := server.LastRequest()
+	require.NotNil(t, req)
+	assert.Contains(t, string(req.Body), "__schema")
+}
+
+func TestGetNerdGraphSchema_MissingSchema(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {}}`)
+
+	client := NewTestClient(server)
+	_, err := client.GetNerdGraphSchema()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing __schema")
+}
+
+func TestGetNerdGraphSchema_Error(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "graphql_error.json"))
+
+	client := NewTestClient(server)
+	_, err := client.GetNerdGraphSchema()
+
+	require.Error(t, err)
+}
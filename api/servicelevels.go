@@ -0,0 +1,244 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+)
+
+// serviceLevelIndicatorFields is the common set of GraphQL fields for
+// service level indicators
+const serviceLevelIndicatorFields = `
+	guid
+	id
+	name
+	description
+	entityGuid
+	events {
+		validEvents { from where }
+		goodEvents { from where }
+		badEvents { from where }
+	}
+	objectives {
+		name
+		target
+		timeWindow { rolling { count unit } }
+	}
+	resultQueries {
+		indicator { nrql }
+	}`
+
+// ListServiceLevels returns the service level indicators attached to an
+// entity, or every indicator in the account when entityGUID is empty
+func (c *Client) ListServiceLevels(entityGUID EntityGUID) ([]ServiceLevelIndicator, error) {
+	if entityGUID != "" {
+		return c.listEntityServiceLevels(entityGUID)
+	}
+
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+	query($accountId: Int!) {
+		actor {
+			account(id: $accountId) {
+				serviceLevel {
+					indicators {
+						%s
+					}
+				}
+			}
+		}
+	}`, serviceLevelIndicatorFields)
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	account, ok := safeMap(actor["account"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing account", RawResponse: rawResponse(result)}
+	}
+	serviceLevel, ok := safeMap(account["serviceLevel"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing serviceLevel", RawResponse: rawResponse(result)}
+	}
+	indicators, ok := safeSlice(serviceLevel["indicators"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing indicators", RawResponse: rawResponse(result)}
+	}
+
+	return parseServiceLevelIndicators(indicators), nil
+}
+
+// listEntityServiceLevels returns the service level indicators attached to
+// one entity
+func (c *Client) listEntityServiceLevels(entityGUID EntityGUID) ([]ServiceLevelIndicator, error) {
+	query := fmt.Sprintf(`
+	query($guid: EntityGuid!) {
+		actor {
+			entity(guid: $guid) {
+				serviceLevel {
+					indicators {
+						%s
+					}
+				}
+			}
+		}
+	}`, serviceLevelIndicatorFields)
+
+	variables := map[string]interface{}{
+		"guid": entityGUID.String(),
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	entity, ok := safeMap(actor["entity"])
+	if !ok || entity == nil {
+		return nil, fmt.Errorf("entity not found: %s", entityGUID)
+	}
+
+	// Entities without service levels have no serviceLevel at all
+	serviceLevel, _ := safeMap(entity["serviceLevel"])
+	indicators, _ := safeSlice(serviceLevel["indicators"])
+
+	return parseServiceLevelIndicators(indicators), nil
+}
+
+// GetServiceLevel returns the service level indicator with the given GUID
+func (c *Client) GetServiceLevel(guid EntityGUID) (*ServiceLevelIndicator, error) {
+	indicators, err := c.ListServiceLevels("")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sli := range indicators {
+		if sli.GUID == guid {
+			return &sli, nil
+		}
+	}
+
+	return nil, fmt.Errorf("service level not found: %s", guid)
+}
+
+// GetServiceLevelAttainment runs an indicator's NRQL over the window
+// starting at since (any time accepted by ParseFlexibleTime) and returns
+// the percentage of valid events that were good
+func (c *Client) GetServiceLevelAttainment(sli ServiceLevelIndicator, since string) (float64, error) {
+	if sli.IndicatorNRQL == "" {
+		return 0, fmt.Errorf("service level %s has no indicator query", sli.Name)
+	}
+
+	nrql, err := AppendNRQLTimeRange(sli.IndicatorNRQL, since, "", false)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := c.QueryNRQL(nrql)
+	if err != nil {
+		return 0, err
+	}
+	if len(result.Results) == 0 {
+		return 0, fmt.Errorf("no data for service level %s", sli.Name)
+	}
+
+	// The indicator query selects a single value under an alias that varies
+	keys := make([]string, 0, len(result.Results[0]))
+	for k := range result.Results[0] {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if v, ok := result.Results[0][k].(float64); ok {
+			return v, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no data for service level %s", sli.Name)
+}
+
+// parseServiceLevelIndicators converts NerdGraph indicator maps to
+// ServiceLevelIndicators
+func parseServiceLevelIndicators(indicators []interface{}) []ServiceLevelIndicator {
+	slis := make([]ServiceLevelIndicator, 0, len(indicators))
+	for _, i := range indicators {
+		indicator, ok := safeMap(i)
+		if !ok {
+			continue
+		}
+
+		sli := ServiceLevelIndicator{
+			GUID:        EntityGUID(safeString(indicator["guid"])),
+			ID:          safeString(indicator["id"]),
+			Name:        safeString(indicator["name"]),
+			Description: safeString(indicator["description"]),
+			EntityGUID:  EntityGUID(safeString(indicator["entityGuid"])),
+			Objectives:  []ServiceLevelObjective{},
+		}
+
+		if events, ok := safeMap(indicator["events"]); ok {
+			sli.ValidEvents = parseServiceLevelEvents(events["validEvents"])
+			sli.GoodEvents = parseServiceLevelEvents(events["goodEvents"])
+			sli.BadEvents = parseServiceLevelEvents(events["badEvents"])
+		}
+
+		if objectives, ok := safeSlice(indicator["objectives"]); ok {
+			for _, o := range objectives {
+				objective, ok := safeMap(o)
+				if !ok {
+					continue
+				}
+				target, _ := objective["target"].(float64)
+				slo := ServiceLevelObjective{
+					Name:   safeString(objective["name"]),
+					Target: target,
+				}
+				if window, ok := safeMap(objective["timeWindow"]); ok {
+					if rolling, ok := safeMap(window["rolling"]); ok && safeString(rolling["unit"]) == "DAY" {
+						slo.WindowDays = safeInt(rolling["count"])
+					}
+				}
+				sli.Objectives = append(sli.Objectives, slo)
+			}
+		}
+
+		if queries, ok := safeMap(indicator["resultQueries"]); ok {
+			if q, ok := safeMap(queries["indicator"]); ok {
+				sli.IndicatorNRQL = safeString(q["nrql"])
+			}
+		}
+
+		slis = append(slis, sli)
+	}
+	return slis
+}
+
+// parseServiceLevelEvents converts an events selector, which is null when
+// unset, to ServiceLevelEvents
+func parseServiceLevelEvents(v interface{}) *ServiceLevelEvents {
+	events, ok := safeMap(v)
+	if !ok || events == nil {
+		return nil
+	}
+	return &ServiceLevelEvents{
+		From:  safeString(events["from"]),
+		Where: safeString(events["where"]),
+	}
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListServiceLevels(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "service_levels.json"))
+
+	client := NewTestClient(server)
+	slis, err := client.ListServiceLevels("")
+
+	require.NoError(t, err)
+	require.Len(t, slis, 2)
+
+	assert.Equal(t, ServiceLevelIndicator{
+		GUID:        "MTIzNDU2N3xFWFR8U0VSVklDRV9MRVZFTHwxMDE",
+		ID:          "101",
+		Name:        "Checkout latency",
+		Description: "Checkout requests under 500ms",
+		EntityGUID:  "MTIzNDU2N3xBUE18QVBQTElDQVRJT058OTk",
+		ValidEvents: &ServiceLevelEvents{From: "Transaction", Where: "appName = 'checkout'"},
+		GoodEvents:  &ServiceLevelEvents{From: "Transaction", Where: "appName = 'checkout' AND duration < 0.5"},
+		Objectives: []ServiceLevelObjective{
+			{Name: "Weekly", Target: 99.5, WindowDays: 7},
+		},
+		IndicatorNRQL: "SELECT clamp_max(sum(newrelic.sli.good) / sum(newrelic.sli.valid) * 100, 100) AS 'SLI' FROM Metric WHERE sli.guid = 'abc'",
+	}, slis[0])
+
+	assert.Nil(t, slis[1].GoodEvents)
+	assert.Equal(t, &ServiceLevelEvents{From: "TransactionError"}, slis[1].BadEvents)
+	assert.Empty(t, slis[1].Objectives)
+	assert.Empty(t, slis[1].IndicatorNRQL)
+
+	var req NerdGraphRequest
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &req))
+	assert.Contains(t, req.Query, "serviceLevel")
+	assert.Contains(t, req.Variables, "accountId")
+}
+
+func TestListServiceLevels_Entity(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"entity": {"serviceLevel": {"indicators": [
+		{"guid": "SLI-1", "name": "Availability", "objectives": [{"target": 99.9, "timeWindow": {"rolling": {"count": 28, "unit": "DAY"}}}]}
+	]}}}}}`)
+
+	client := NewTestClient(server)
+	slis, err := client.ListServiceLevels("APP-1")
+
+	require.NoError(t, err)
+	require.Len(t, slis, 1)
+	assert.Equal(t, "Availability", slis[0].Name)
+	assert.Equal(t, 28, slis[0].Objectives[0].WindowDays)
+
+	var req NerdGraphRequest
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &req))
+	assert.Equal(t, "APP-1", req.Variables["guid"])
+}
+
+func TestListServiceLevels_EntityWithoutServiceLevels(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"entity": {"serviceLevel": null}}}}`)
+
+	client := NewTestClient(server)
+	slis, err := client.ListServiceLevels("APP-1")
+
+	require.NoError(t, err)
+	assert.Empty(t, slis)
+}
+
+func TestListServiceLevels_EntityNotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"entity": null}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.ListServiceLevels("MISSING")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "entity not found: MISSING")
+}
+
+func TestListServiceLevels_MissingIndicators(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"serviceLevel": {}}}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.ListServiceLevels("")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing indicators")
+}
+
+func TestListServiceLevels_NoAccountID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+
+	_, err := client.ListServiceLevels("")
+
+	assert.ErrorIs(t, err, ErrAccountIDRequired)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestGetServiceLevel(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "service_levels.json"))
+
+	client := NewTestClient(server)
+	sli, err := client.GetServiceLevel("MTIzNDU2N3xFWFR8U0VSVklDRV9MRVZFTHwxMDI")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Checkout errors", sli.Name)
+}
+
+func TestGetServiceLevel_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "service_levels.json"))
+
+	client := NewTestClient(server)
+	_, err := client.GetServiceLevel("MISSING")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "service level not found: MISSING")
+}
+
+func TestGetServiceLevelAttainment(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"nrql": {"results": [{"SLI": 99.75}]}}}}}`)
+
+	client := NewTestClient(server)
+	attainment, err := client.GetServiceLevelAttainment(ServiceLevelIndicator{
+		Name:          "Checkout latency",
+		IndicatorNRQL: "SELECT 1 AS 'SLI' FROM Metric",
+	}, "7 days ago")
+
+	require.NoError(t, err)
+	assert.InDelta(t, 99.75, attainment, 0.0001)
+
+	var req NerdGraphRequest
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &req))
+	assert.Regexp(t, `SELECT 1 AS 'SLI' FROM Metric SINCE \d+`, req.Variables["nrql"])
+}
+
+func TestGetServiceLevelAttainment_NoData(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"nrql": {"results": [{"SLI": null}]}}}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.GetServiceLevelAttainment(ServiceLevelIndicator{
+		Name:          "Checkout latency",
+		IndicatorNRQL: "SELECT 1 AS 'SLI' FROM Metric",
+	}, "7d")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no data for service level Checkout latency")
+}
+
+func TestGetServiceLevelAttainment_NoQuery(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	_, err := client.GetServiceLevelAttainment(ServiceLevelIndicator{Name: "Broken"}, "7d")
+
+	require.Error(t, err)
+	server.AssertRequestCount(t, 0)
+}
@@ -0,0 +1,227 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// AccountSummary is a high-level overview of an account
+type AccountSummary struct {
+	EntityCounts        map[string]int    `json:"entityCounts"`
+	ActiveAlertPolicies int               `json:"activeAlertPolicies"`
+	SyntheticMonitors   int               `json:"syntheticMonitors"`
+	RecentDeployments   int               `json:"recentDeployments"`
+	DeploymentsSince    string            `json:"deploymentsSince"`
+	Errors              map[string]string `json:"errors,omitempty"`
+}
+
+// Summary sections, used as keys in AccountSummary.Errors
+const (
+	SummaryEntities          = "entities"
+	SummaryAlertPolicies     = "alertPolicies"
+	SummarySyntheticMonitors = "syntheticMonitors"
+	SummaryDeployments       = "deployments"
+)
+
+// GetAccountSummary queries entity counts, alert policies, synthetic monitors
+// and deployments within the deploymentsSince window concurrently. A section
+// that fails is recorded in Errors and left zero; an error is returned only
+// if every section fails.
+func (c *Client) GetAccountSummary(deploymentsSince time.Duration) (*AccountSummary, error) {
+	accountID, err := c.GetAccountIDInt()
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &AccountSummary{
+		EntityCounts:     map[string]int{},
+		DeploymentsSince: deploymentsSince.String(),
+	}
+
+	sections := map[string]func() error{
+		SummaryEntities: func() error {
+			counts, err := c.countEntitiesByType(fmt.Sprintf("accountId = %d", accountID))
+			summary.EntityCounts = counts
+			return err
+		},
+		SummaryAlertPolicies: func() error {
+			n, err := c.countAlertPolicies(accountID)
+			summary.ActiveAlertPolicies = n
+			return err
+		},
+		SummarySyntheticMonitors: func() error {
+			n, err := c.countEntities(fmt.Sprintf("accountId = %d AND domain = 'SYNTH' AND type = 'MONITOR'", accountID))
+			summary.SyntheticMonitors = n
+			return err
+		},
+		SummaryDeployments: func() error {
+			n, err := c.countDeployments(deploymentsSince)
+			summary.RecentDeployments = n
+			return err
+		},
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs = map[string]string{}
+	)
+	for name, fetch := range sections {
+		wg.Add(1)
+		go func(name string, fetch func() error) {
+			defer wg.Done()
+			if err := fetch(); err != nil {
+				mu.Lock()
+				errs[name] = err.Error()
+				mu.Unlock()
+			}
+		}(name, fetch)
+	}
+	wg.Wait()
+
+	if len(errs) == len(sections) {
+		return nil, errors.New("failed to fetch account summary: " + errs[SummaryEntities])
+	}
+	if len(errs) > 0 {
+		summary.Errors = errs
+	}
+	if summary.EntityCounts == nil {
+		summary.EntityCounts = map[string]int{}
+	}
+
+	return summary, nil
+}
+
+// countEntitiesByType returns the number of entities matching query, by type
+func (c *Client) countEntitiesByType(query string) (map[string]int, error) {
+	gql := `
+	query($query: String!) {
+		actor {
+			entitySearch(query: $query) {
+				counts(facet: TYPE) {
+					count
+					facet
+				}
+			}
+		}
+	}`
+
+	result, err := c.NerdGraphQuery(gql, map[string]interface{}{"query": query})
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	entitySearch, ok := safeMap(actor["entitySearch"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing entitySearch", RawResponse: rawResponse(result)}
+	}
+	countsData, ok := safeSlice(entitySearch["counts"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing counts", RawResponse: rawResponse(result)}
+	}
+
+	counts := make(map[string]int, len(countsData))
+	for _, c := range countsData {
+		m, ok := safeMap(c)
+		if !ok {
+			continue
+		}
+		// A single facet is returned as a string, multiple facets as a list
+		facet := safeString(m["facet"])
+		if list, ok := safeSlice(m["facet"]); ok && len(list) > 0 {
+			facet = safeString(list[0])
+		}
+		counts[facet] += safeInt(m["count"])
+	}
+
+	return counts, nil
+}
+
+// countEntities returns the number of entities matching query
+func (c *Client) countEntities(query string) (int, error) {
+	gql := `
+	query($query: String!) {
+		actor {
+			entitySearch(query: $query) {
+				count
+			}
+		}
+	}`
+
+	result, err := c.NerdGraphQuery(gql, map[string]interface{}{"query": query})
+	if err != nil {
+		return 0, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return 0, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	entitySearch, ok := safeMap(actor["entitySearch"])
+	if !ok {
+		return 0, &ResponseError{Message: "unexpected response format: missing entitySearch", RawResponse: rawResponse(result)}
+	}
+
+	return safeInt(entitySearch["count"]), nil
+}
+
+// countAlertPolicies returns the number of alert policies in the account
+func (c *Client) countAlertPolicies(accountID int) (int, error) {
+	gql := `
+	query($accountId: Int!) {
+		actor {
+			account(id: $accountId) {
+				alerts {
+					policiesSearch {
+						totalCount
+					}
+				}
+			}
+		}
+	}`
+
+	result, err := c.NerdGraphQuery(gql, map[string]interface{}{"accountId": accountID})
+	if err != nil {
+		return 0, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return 0, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	account, ok := safeMap(actor["account"])
+	if !ok {
+		return 0, &ResponseError{Message: "unexpected response format: missing account", RawResponse: rawResponse(result)}
+	}
+	alerts, ok := safeMap(account["alerts"])
+	if !ok {
+		return 0, &ResponseError{Message: "unexpected response format: missing alerts", RawResponse: rawResponse(result)}
+	}
+	policiesSearch, ok := safeMap(alerts["policiesSearch"])
+	if !ok {
+		return 0, &ResponseError{Message: "unexpected response format: missing policiesSearch", RawResponse: rawResponse(result)}
+	}
+
+	return safeInt(policiesSearch["totalCount"]), nil
+}
+
+// countDeployments returns the number of deployments recorded within since
+func (c *Client) countDeployments(since time.Duration) (int, error) {
+	nrql := fmt.Sprintf("SELECT count(*) FROM Deployment SINCE %d seconds ago", int(since.Seconds()))
+
+	result, err := c.QueryNRQL(nrql)
+	if err != nil {
+		return 0, err
+	}
+	if len(result.Results) == 0 {
+		return 0, nil
+	}
+
+	return safeInt(result.Results[0]["count"]), nil
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// summaryHandler answers each account summary query based on the request
+// body; queries matching a marker in fail return a GraphQL error instead
+func summaryHandler(fail ...string) http.HandlerFunc {
+	responses := map[string]string{
+		"counts(facet: TYPE)": `{"data": {"actor": {"entitySearch": {"counts": [
+			{"count": 12, "facet": "APPLICATION"},
+			{"count": 30, "facet": "HOST"},
+			{"count": 4, "facet": ["MONITOR"]}
+		]}}}}`,
+		"policiesSearch":  `{"data": {"actor": {"account": {"alerts": {"policiesSearch": {"totalCount": 7}}}}}}`,
+		"SYNTH":           `{"data": {"actor": {"entitySearch": {"count": 4}}}}`,
+		"FROM Deployment": `{"data": {"actor": {"account": {"nrql": {"results": [{"count": 9}]}}}}}`,
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body := string(data)
+		w.Header().Set("Content-Type", "application/json")
+
+		for marker, response := range responses {
+			if !strings.Contains(body, marker) {
+				continue
+			}
+			for _, f := range fail {
+				if f == marker {
+					response = `{"errors": [{"message": "query failed"}]}`
+				}
+			}
+			_, _ = w.Write([]byte(response))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func TestGetAccountSummary(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetHandler(summaryHandler())
+
+	client := NewTestClient(server)
+	summary, err := client.GetAccountSummary(24 * time.Hour)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"APPLICATION": 12, "HOST": 30, "MONITOR": 4}, summary.EntityCounts)
+	assert.Equal(t, 7, summary.ActiveAlertPolicies)
+	assert.Equal(t, 4, summary.SyntheticMonitors)
+	assert.Equal(t, 9, summary.RecentDeployments)
+	assert.Equal(t, "24h0m0s", summary.DeploymentsSince)
+	assert.Empty(t, summary.Errors)
+
+	// One request per section
+	server.AssertRequestCount(t, 4)
+
+	var nrqlBody string
+	for _, req := range server.Requests() {
+		if strings.Contains(string(req.Body), "FROM Deployment") {
+			nrqlBody = string(req.Body)
+		}
+	}
+	assert.Contains(t, nrqlBody, "SINCE 86400 seconds ago")
+}
+
+func TestGetAccountSummary_PartialFailure(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetHandler(summaryHandler("policiesSearch"))
+
+	client := NewTestClient(server)
+	summary, err := client.GetAccountSummary(time.Hour)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, summary.ActiveAlertPolicies)
+	assert.Equal(t, 4, summary.SyntheticMonitors)
+	assert.Equal(t, 9, summary.RecentDeployments)
+	require.Contains(t, summary.Errors, SummaryAlertPolicies)
+	assert.Contains(t, summary.Errors[SummaryAlertPolicies], "query failed")
+	assert.Len(t, summary.Errors, 1)
+}
+
+func TestGetAccountSummary_AllFail(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "graphql_error.json"))
+
+	client := NewTestClient(server)
+	_, err := client.GetAccountSummary(time.Hour)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to fetch account summary")
+}
+
+func TestGetAccountSummary_NoAccountID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+
+	_, err := client.GetAccountSummary(time.Hour)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrAccountIDRequired)
+	server.AssertRequestCount(t, 0)
+}
@@ -1,6 +1,12 @@
 package api
 
-import "encoding/json"
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
 
 // ListSyntheticMonitors returns all synthetic monitors
 func (c *Client) ListSyntheticMonitors() ([]SyntheticMonitor, error) {
@@ -73,7 +79,10 @@ func (c *Client) CreateSyntheticMonitor(input *SyntheticMonitorInput) (*Syntheti
 	return &monitor, nil
 }
 
-// UpdateSyntheticMonitor updates an existing synthetic monitor
+// UpdateSyntheticMonitor replaces an existing synthetic monitor with input.
+// A v3 PUT replaces the whole monitor, so settings input does not model,
+// such as options and slaThreshold, are reset; use PatchSyntheticMonitor to
+// change only some fields.
 func (c *Client) UpdateSyntheticMonitor(monitorID string, input *SyntheticMonitorInput) (*SyntheticMonitor, error) {
 	// Build the request body
 	body := map[string]interface{}{
@@ -82,6 +91,9 @@ func (c *Client) UpdateSyntheticMonitor(monitorID string, input *SyntheticMonito
 		"status":    input.Status,
 	}
 
+	if input.Type != "" {
+		body["type"] = input.Type
+	}
 	if input.URI != "" {
 		body["uri"] = input.URI
 	}
@@ -94,6 +106,19 @@ func (c *Client) UpdateSyntheticMonitor(monitorID string, input *SyntheticMonito
 		return nil, err
 	}
 
+	// The API may answer 204 No Content; the monitor is then what was sent
+	if len(bytes.TrimSpace(data)) == 0 {
+		return &SyntheticMonitor{
+			ID:        monitorID,
+			Name:      input.Name,
+			Type:      input.Type,
+			Frequency: input.Frequency,
+			Status:    input.Status,
+			URI:       input.URI,
+			Locations: input.Locations,
+		}, nil
+	}
+
 	var monitor SyntheticMonitor
 	if err := json.Unmarshal(data, &monitor); err != nil {
 		return nil, &ResponseError{Message: "failed to parse response", Err: err}
@@ -102,8 +127,236 @@ func (c *Client) UpdateSyntheticMonitor(monitorID string, input *SyntheticMonito
 	return &monitor, nil
 }
 
+// SyntheticMonitorPatch holds a partial monitor update; nil fields are left
+// unchanged. Other holds any further monitor fields to change, such as
+// options or slaThreshold, keyed by their JSON names.
+type SyntheticMonitorPatch struct {
+	Name      *string  `json:"name,omitempty"`
+	Frequency *int     `json:"frequency,omitempty"`
+	Status    *string  `json:"status,omitempty"`
+	URI       *string  `json:"uri,omitempty"`
+	Locations []string `json:"locations,omitempty"`
+
+	Other map[string]interface{} `json:"-"`
+}
+
+// syntheticMonitorPatchFields are the patch fields without its JSON methods
+type syntheticMonitorPatchFields SyntheticMonitorPatch
+
+// MarshalJSON encodes the patch as one object holding both the named
+// fields and Other
+func (p SyntheticMonitorPatch) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(syntheticMonitorPatchFields(p))
+	if err != nil || len(p.Other) == 0 {
+		return data, err
+	}
+
+	merged := make(map[string]interface{}, len(p.Other))
+	for k, v := range p.Other {
+		merged[k] = v
+	}
+	// The named fields win over the same keys in Other
+	if err := json.Unmarshal(data, &merged); err != nil {
+		return nil, err
+	}
+	return json.Marshal(merged)
+}
+
+// UnmarshalJSON decodes a partial monitor, keeping fields without a named
+// field in Other
+func (p *SyntheticMonitorPatch) UnmarshalJSON(data []byte) error {
+	var fields syntheticMonitorPatchFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var other map[string]interface{}
+	if err := json.Unmarshal(data, &other); err != nil {
+		return err
+	}
+	for _, key := range []string{"name", "frequency", "status", "uri", "locations"} {
+		delete(other, key)
+	}
+
+	*p = SyntheticMonitorPatch(fields)
+	if len(other) > 0 {
+		p.Other = other
+	}
+	return nil
+}
+
+// IsEmpty returns true if the patch changes nothing
+func (p *SyntheticMonitorPatch) IsEmpty() bool {
+	return p.Name == nil && p.Frequency == nil && p.Status == nil && p.URI == nil && p.Locations == nil && len(p.Other) == 0
+}
+
+// PatchSyntheticMonitor fetches a monitor's full definition, merges the
+// patch into it and PUTs it back. Nested objects such as options are merged
+// key by key, and every field the patch does not set is sent back as
+// fetched, including ones SyntheticMonitor does not model.
+func (c *Client) PatchSyntheticMonitor(monitorID string, patch *SyntheticMonitorPatch) (*SyntheticMonitor, error) {
+	url := c.SyntheticsURL + "/monitors/" + monitorID
+
+	data, err := c.doRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var current map[string]interface{}
+	if err := json.Unmarshal(data, &current); err != nil {
+		return nil, &ResponseError{Message: "failed to parse response", Err: err}
+	}
+
+	patchJSON, err := json.Marshal(patch)
+	if err != nil {
+		return nil, &ResponseError{Message: "failed to marshal request body", Err: err}
+	}
+	var changes map[string]interface{}
+	if err := json.Unmarshal(patchJSON, &changes); err != nil {
+		return nil, &ResponseError{Message: "failed to marshal request body", Err: err}
+	}
+	mergeFields(current, changes)
+
+	data, err = c.doRequest("PUT", url, current)
+	if err != nil {
+		return nil, err
+	}
+
+	// The API may answer 204 No Content; the monitor is then what was sent
+	if len(bytes.TrimSpace(data)) == 0 {
+		if data, err = json.Marshal(current); err != nil {
+			return nil, &ResponseError{Message: "failed to parse response", Err: err}
+		}
+	}
+
+	var monitor SyntheticMonitor
+	if err := json.Unmarshal(data, &monitor); err != nil {
+		return nil, &ResponseError{Message: "failed to parse response", Err: err}
+	}
+	if monitor.ID == "" {
+		monitor.ID = monitorID
+	}
+
+	return &monitor, nil
+}
+
+// mergeFields merges src into dst. Objects present in both are merged
+// recursively; any other value in src replaces the one in dst.
+func mergeFields(dst, src map[string]interface{}) {
+	for k, v := range src {
+		srcObj, srcIsObj := v.(map[string]interface{})
+		dstObj, dstIsObj := dst[k].(map[string]interface{})
+		if srcIsObj && dstIsObj {
+			mergeFields(dstObj, srcObj)
+			continue
+		}
+		dst[k] = v
+	}
+}
+
 // DeleteSyntheticMonitor deletes a synthetic monitor by ID
 func (c *Client) DeleteSyntheticMonitor(monitorID string) error {
 	_, err := c.doRequest("DELETE", c.SyntheticsURL+"/monitors/"+monitorID, nil)
 	return err
 }
+
+// ListSyntheticResults returns the most recent check results of a monitor.
+// A limit of 0 uses the API default.
+func (c *Client) ListSyntheticResults(monitorID string, limit int) ([]SyntheticResult, error) {
+	url := c.SyntheticsURL + "/monitors/" + monitorID + "/results"
+	if limit > 0 {
+		url += "?limit=" + strconv.Itoa(limit)
+	}
+
+	data, err := c.doRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp SyntheticResultsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, &ResponseError{Message: "failed to parse response", Err: err}
+	}
+
+	return resp.Results, nil
+}
+
+// ListSyntheticLocations returns the public and private locations monitors
+// can run from
+func (c *Client) ListSyntheticLocations() ([]SyntheticLocation, error) {
+	data, err := c.doRequest("GET", c.SyntheticsURL+"/locations", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var locations []SyntheticLocation
+	if err := json.Unmarshal(data, &locations); err != nil {
+		return nil, &ResponseError{Message: "failed to parse response", Err: err}
+	}
+
+	return locations, nil
+}
+
+// Synthetic monitor status values
+const (
+	SyntheticStatusEnabled  = "ENABLED"
+	SyntheticStatusDisabled = "DISABLED"
+	SyntheticStatusMuted    = "MUTED"
+)
+
+// SetSyntheticMonitorStatus changes only the status of a synthetic monitor.
+// A v3 PUT replaces the whole monitor, so this goes through
+// PatchSyntheticMonitor to send its other settings back unchanged.
+func (c *Client) SetSyntheticMonitorStatus(monitorID string, status string) error {
+	switch status {
+	case SyntheticStatusEnabled, SyntheticStatusDisabled, SyntheticStatusMuted:
+	default:
+		return fmt.Errorf("invalid monitor status %q: must be ENABLED, DISABLED, or MUTED", status)
+	}
+
+	_, err := c.PatchSyntheticMonitor(monitorID, &SyntheticMonitorPatch{Status: &status})
+	return err
+}
+
+// ScriptLocation is a private location that needs an HMAC to run a scripted monitor
+type ScriptLocation struct {
+	Name string `json:"name"`
+	HMAC string `json:"hmac"`
+}
+
+// syntheticScript is the wire format of a monitor script
+type syntheticScript struct {
+	ScriptText      string           `json:"scriptText"`
+	ScriptLocations []ScriptLocation `json:"scriptLocations,omitempty"`
+}
+
+// GetSyntheticMonitorScript returns the decoded script of a scripted monitor
+func (c *Client) GetSyntheticMonitorScript(monitorID string) (string, error) {
+	data, err := c.doRequest("GET", c.SyntheticsURL+"/monitors/"+monitorID+"/script", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var script syntheticScript
+	if err := json.Unmarshal(data, &script); err != nil {
+		return "", &ResponseError{Message: "failed to parse response", Err: err}
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(script.ScriptText)
+	if err != nil {
+		return "", &ResponseError{Message: "failed to decode script", Err: err}
+	}
+
+	return string(decoded), nil
+}
+
+// UpdateSyntheticMonitorScript encodes and uploads the script of a scripted monitor
+func (c *Client) UpdateSyntheticMonitorScript(monitorID string, script string, locations []ScriptLocation) error {
+	body := syntheticScript{
+		ScriptText:      base64.StdEncoding.EncodeToString([]byte(script)),
+		ScriptLocations: locations,
+	}
+
+	_, err := c.doRequest("PUT", c.SyntheticsURL+"/monitors/"+monitorID+"/script", body)
+	return err
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -91,3 +92,406 @@ func TestGetSyntheticMonitor_NotFound(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsNotFound(err))
 }
+
+func TestPatchSyntheticMonitor(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	fixture := LoadTestFixture(t, "synthetics_monitor_single.json")
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(fixture)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "syn-001", "name": "Homepage Check", "status": "DISABLED"}`))
+	})
+
+	status := "DISABLED"
+	frequency := 15
+
+	client := NewTestClient(server)
+	monitor, err := client.PatchSyntheticMonitor("syn-001", &SyntheticMonitorPatch{
+		Status:    &status,
+		Frequency: &frequency,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "DISABLED", monitor.Status)
+
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, http.MethodGet, requests[0].Method)
+	assert.Equal(t, http.MethodPut, requests[1].Method)
+	assert.Equal(t, "/synthetics/monitors/syn-001", requests[1].Path)
+
+	// Patched fields change, unspecified fields are sent back as fetched
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(requests[1].Body, &body))
+	assert.Equal(t, "DISABLED", body["status"])
+	assert.Equal(t, float64(15), body["frequency"])
+	assert.Equal(t, "Homepage Check", body["name"])
+	assert.Equal(t, "SIMPLE", body["type"])
+	assert.Equal(t, "https://example.com", body["uri"])
+	assert.Equal(t, []interface{}{"AWS_US_EAST_1", "AWS_EU_WEST_1"}, body["locations"])
+	assert.Equal(t, 7.5, body["slaThreshold"])
+	assert.Equal(t, map[string]interface{}{
+		"verifySSL":              true,
+		"validationString":       "Welcome",
+		"bypassHEADRequest":      true,
+		"treatRedirectAsFailure": false,
+	}, body["options"])
+}
+
+func TestPatchSyntheticMonitor_MergesOptions(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	fixture := LoadTestFixture(t, "synthetics_monitor_single.json")
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(fixture)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var patch SyntheticMonitorPatch
+	require.NoError(t, json.Unmarshal([]byte(`{"options": {"verifySSL": false}, "slaThreshold": 3}`), &patch))
+
+	client := NewTestClient(server)
+	monitor, err := client.PatchSyntheticMonitor("syn-001", &patch)
+
+	require.NoError(t, err)
+	assert.Equal(t, "syn-001", monitor.ID)
+	assert.Equal(t, "Homepage Check", monitor.Name)
+
+	// Only the given option changes; the others are kept
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &body))
+	assert.Equal(t, float64(3), body["slaThreshold"])
+	assert.Equal(t, map[string]interface{}{
+		"verifySSL":              false,
+		"validationString":       "Welcome",
+		"bypassHEADRequest":      true,
+		"treatRedirectAsFailure": false,
+	}, body["options"])
+	assert.Equal(t, "ENABLED", body["status"])
+}
+
+func TestPatchSyntheticMonitor_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusNotFound, `{"error": "monitor not found"}`)
+
+	name := "Renamed"
+	client := NewTestClient(server)
+	_, err := client.PatchSyntheticMonitor("nonexistent", &SyntheticMonitorPatch{Name: &name})
+
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+
+	// No update is attempted when the fetch fails
+	server.AssertRequestCount(t, 1)
+}
+
+func TestSyntheticMonitorPatch_FromJSON(t *testing.T) {
+	var patch SyntheticMonitorPatch
+	require.NoError(t, json.Unmarshal([]byte(`{"name": "New Name", "locations": ["AWS_US_WEST_2"], "options": {"verifySSL": true}}`), &patch))
+	assert.False(t, patch.IsEmpty())
+
+	require.NotNil(t, patch.Name)
+	assert.Equal(t, "New Name", *patch.Name)
+	assert.Nil(t, patch.Frequency)
+	assert.Equal(t, []string{"AWS_US_WEST_2"}, patch.Locations)
+	assert.Equal(t, map[string]interface{}{"options": map[string]interface{}{"verifySSL": true}}, patch.Other)
+
+	assert.True(t, (&SyntheticMonitorPatch{}).IsEmpty())
+	assert.False(t, (&SyntheticMonitorPatch{Other: map[string]interface{}{"slaThreshold": 2}}).IsEmpty())
+}
+
+func TestSyntheticMonitorPatch_ToJSON(t *testing.T) {
+	name := "New Name"
+	patch := &SyntheticMonitorPatch{
+		Name:  &name,
+		Other: map[string]interface{}{"name": "ignored", "slaThreshold": 2},
+	}
+
+	data, err := json.Marshal(patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "New Name", "slaThreshold": 2}`, string(data))
+}
+
+func TestGetSyntheticMonitorScript(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	// "console.log('ok');" base64-encoded
+	server.SetResponse(http.StatusOK, `{"scriptText": "Y29uc29sZS5sb2coJ29rJyk7"}`)
+
+	client := NewTestClient(server)
+	script, err := client.GetSyntheticMonitorScript("syn-001")
+
+	require.NoError(t, err)
+	assert.Equal(t, "console.log('ok');", script)
+	server.AssertLastPath(t, "/synthetics/monitors/syn-001/script")
+}
+
+func TestGetSyntheticMonitorScript_InvalidBase64(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"scriptText": "not base64!"}`)
+
+	client := NewTestClient(server)
+	_, err := client.GetSyntheticMonitorScript("syn-001")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to decode script")
+}
+
+func TestUpdateSyntheticMonitorScript(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusNoContent, ``)
+
+	client := NewTestClient(server)
+	err := client.UpdateSyntheticMonitorScript("syn-001", "console.log('ok');", []ScriptLocation{
+		{Name: "my-private-location", HMAC: "abc123"},
+	})
+
+	require.NoError(t, err)
+	server.AssertLastMethod(t, "PUT")
+	server.AssertLastPath(t, "/synthetics/monitors/syn-001/script")
+
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &body))
+	assert.Equal(t, "Y29uc29sZS5sb2coJ29rJyk7", body["scriptText"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "my-private-location", "hmac": "abc123"},
+	}, body["scriptLocations"])
+}
+
+func TestSyntheticMonitorScript_RoundTrip(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	var stored []byte
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			stored = server.LastRequest().Body
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(stored)
+	})
+
+	script := "var assert = require('assert');\n$http.get('https://example.com', (err, res) => {\n  assert.equal(res.statusCode, 200); // ✓\n});\n"
+
+	client := NewTestClient(server)
+	require.NoError(t, client.UpdateSyntheticMonitorScript("syn-001", script, nil))
+
+	got, err := client.GetSyntheticMonitorScript("syn-001")
+	require.NoError(t, err)
+	assert.Equal(t, script, got)
+}
+
+func TestSetSyntheticMonitorStatus(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	fixture := LoadTestFixture(t, "synthetics_monitor_single.json")
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(fixture)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := NewTestClient(server)
+	err := client.SetSyntheticMonitorStatus("syn-001", SyntheticStatusDisabled)
+
+	require.NoError(t, err)
+	server.AssertLastMethod(t, "PUT")
+	server.AssertLastPath(t, "/synthetics/monitors/syn-001")
+
+	// The whole monitor is sent back, with only its status changed
+	assert.JSONEq(t, `{
+		"id": "syn-001",
+		"name": "Homepage Check",
+		"type": "SIMPLE",
+		"frequency": 5,
+		"status": "DISABLED",
+		"uri": "https://example.com",
+		"locations": ["AWS_US_EAST_1", "AWS_EU_WEST_1"],
+		"slaThreshold": 7.5,
+		"apiVersion": "0.6.0",
+		"options": {
+			"verifySSL": true,
+			"validationString": "Welcome",
+			"bypassHEADRequest": true,
+			"treatRedirectAsFailure": false
+		},
+		"createdAt": "2024-01-15T10:00:00.000+0000",
+		"modifiedAt": "2024-03-01T12:30:00.000+0000"
+	}`, string(server.LastRequest().Body))
+}
+
+func TestSetSyntheticMonitorStatus_Invalid(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	err := client.SetSyntheticMonitorStatus("syn-001", "PAUSED")
+
+	require.Error(t, err)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestSetSyntheticMonitorStatus_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusNotFound, `{"error": "Monitor not found"}`)
+
+	client := NewTestClient(server)
+	err := client.SetSyntheticMonitorStatus("missing", SyntheticStatusEnabled)
+
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestListSyntheticLocations(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "synthetics_locations.json"))
+
+	client := NewTestClient(server)
+	locations, err := client.ListSyntheticLocations()
+
+	require.NoError(t, err)
+	require.Len(t, locations, 3)
+
+	assert.Equal(t, "AWS_US_EAST_1", locations[0].Name)
+	assert.Equal(t, "Washington, DC, USA", locations[0].Label)
+	assert.False(t, locations[0].Private)
+	assert.Equal(t, "12345.private-datacenter", locations[2].Name)
+	assert.True(t, locations[2].Private)
+
+	server.AssertLastMethod(t, "GET")
+	server.AssertLastPath(t, "/synthetics/locations")
+}
+
+func TestListSyntheticLocations_Empty(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `[]`)
+
+	client := NewTestClient(server)
+	locations, err := client.ListSyntheticLocations()
+
+	require.NoError(t, err)
+	assert.Empty(t, locations)
+}
+
+func TestListSyntheticLocations_Error(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusUnauthorized, `{"error": "unauthorized"}`)
+
+	client := NewTestClient(server)
+	_, err := client.ListSyntheticLocations()
+
+	require.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+}
+
+func TestListSyntheticLocations_InvalidJSON(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"locations": "nope"}`)
+
+	client := NewTestClient(server)
+	_, err := client.ListSyntheticLocations()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse response")
+}
+
+func TestListSyntheticResults(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "synthetics_results.json"))
+
+	client := NewTestClient(server)
+	results, err := client.ListSyntheticResults("syn-001", 20)
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.Equal(t, "check-001", results[0].CheckID)
+	assert.Equal(t, int64(1735689600000), results[0].Timestamp)
+	assert.Equal(t, "AWS_US_EAST_1", results[0].Location)
+	assert.Equal(t, "SUCCESS", results[0].Result)
+	assert.Equal(t, 245.5, results[0].Duration)
+	assert.Empty(t, results[0].Error)
+
+	assert.Equal(t, "FAILED", results[1].Result)
+	assert.Contains(t, results[1].Error, "Timeout")
+
+	server.AssertLastMethod(t, "GET")
+	server.AssertLastPath(t, "/synthetics/monitors/syn-001/results")
+	assert.Equal(t, "limit=20", server.LastRequest().Query)
+}
+
+func TestListSyntheticResults_NoLimit(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"results": []}`)
+
+	client := NewTestClient(server)
+	results, err := client.ListSyntheticResults("syn-001", 0)
+
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.Empty(t, server.LastRequest().Query)
+}
+
+func TestListSyntheticResults_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusNotFound, `{"error": "Monitor not found"}`)
+
+	client := NewTestClient(server)
+	_, err := client.ListSyntheticResults("missing", 20)
+
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestListSyntheticResults_InvalidJSON(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `not json`)
+
+	client := NewTestClient(server)
+	_, err := client.ListSyntheticResults("syn-001", 20)
+
+	require.Error(t, err)
+	var respErr *ResponseError
+	assert.ErrorAs(t, err, &respErr)
+}
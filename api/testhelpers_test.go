@@ -1,6 +1,7 @@
 package api
 
 import (
+	"bytes"
 	"embed"
 	"encoding/json"
 	"io"
@@ -19,6 +20,7 @@ var testdataFS embed.FS
 type RecordedRequest struct {
 	Method  string
 	Path    string
+	Query   string
 	Headers http.Header
 	Body    []byte
 }
@@ -43,10 +45,12 @@ func NewMockServer() *MockServer {
 	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Record the request
 		body, _ := io.ReadAll(r.Body)
+		r.Body = io.NopCloser(bytes.NewReader(body))
 		m.mu.Lock()
 		m.requests = append(m.requests, RecordedRequest{
 			Method:  r.Method,
 			Path:    r.URL.Path,
+			Query:   r.URL.RawQuery,
 			Headers: r.Header.Clone(),
 			Body:    body,
 		})
@@ -23,10 +23,36 @@ var timeFormats = []string{
 // relativeTimePattern matches strings like "7 days ago", "2 hours ago", "1 week ago"
 var relativeTimePattern = regexp.MustCompile(`^(\d+)\s+(second|minute|hour|day|week|month|year)s?\s+ago$`)
 
+// compactRelativePattern matches compact relative times like "30m", "3h", or "7d"
+var compactRelativePattern = regexp.MustCompile(`^(\d+)([a-z]+)$`)
+
+// compactUnits maps compact relative time suffixes to time units
+var compactUnits = map[string]string{
+	"s": "second",
+	"m": "minute",
+	"h": "hour",
+	"d": "day",
+	"w": "week",
+}
+
+// epochPattern matches bare integers such as the output of $(date +%s)
+var epochPattern = regexp.MustCompile(`^\d+$`)
+
+// Bounds for treating a bare integer as a Unix timestamp. Values above
+// minEpochSeconds are seconds; values from minEpochMillis to maxEpochMillis
+// are milliseconds.
+const (
+	minEpochSeconds = 1_000_000_000
+	minEpochMillis  = 1_000_000_000_000
+	maxEpochMillis  = 9_999_999_999_999
+)
+
 // ParseFlexibleTime parses a time string in various formats:
 // - ISO 8601 / RFC3339 formats
+// - Unix timestamps in seconds or milliseconds ("1736950200", "1736950200000")
 // - Date-only formats (YYYY-MM-DD, MM/DD/YYYY, etc.)
 // - Relative formats ("7 days ago", "1 week ago", etc.)
+// - Compact relative formats ("30m", "3h", "7d", "1w")
 // - Special values ("now", "today", "yesterday")
 func ParseFlexibleTime(s string) (time.Time, error) {
 	original := strings.TrimSpace(s)
@@ -55,6 +81,19 @@ func ParseFlexibleTime(s string) (time.Time, error) {
 		return parseRelativeTime(now, amount, unit)
 	}
 
+	if matches := compactRelativePattern.FindStringSubmatch(lower); matches != nil {
+		unit, ok := compactUnits[matches[2]]
+		if !ok {
+			return time.Time{}, fmt.Errorf("unable to parse time: %s (unknown unit %q: use s, m, h, d, or w)", original, matches[2])
+		}
+		amount, _ := strconv.Atoi(matches[1])
+		return parseRelativeTime(now, amount, unit)
+	}
+
+	if epochPattern.MatchString(original) {
+		return parseEpoch(original)
+	}
+
 	// Try standard formats with original case (important for RFC3339 with 'Z' suffix)
 	for _, format := range timeFormats {
 		if t, err := time.Parse(format, original); err == nil {
@@ -65,6 +104,18 @@ func ParseFlexibleTime(s string) (time.Time, error) {
 	return time.Time{}, fmt.Errorf("unable to parse time: %s", original)
 }
 
+// parseEpoch parses a bare integer as Unix seconds or milliseconds
+func parseEpoch(s string) (time.Time, error) {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n <= minEpochSeconds || n > maxEpochMillis {
+		return time.Time{}, fmt.Errorf("unable to parse time: %s (numbers must be Unix timestamps in seconds or milliseconds)", s)
+	}
+	if n >= minEpochMillis {
+		return time.UnixMilli(n), nil
+	}
+	return time.Unix(n, 0), nil
+}
+
 func parseRelativeTime(now time.Time, amount int, unit string) (time.Time, error) {
 	switch unit {
 	case "second":
@@ -86,6 +137,73 @@ func parseRelativeTime(now time.Time, amount int, unit string) (time.Time, error
 	}
 }
 
+// FormatNRQLTimeClause builds a SINCE or UNTIL clause for a time string
+// accepted by ParseFlexibleTime. By default the time is written as a Unix
+// timestamp in seconds. With naturalLanguage, relative expressions and the
+// special values are passed through as NRQL understands them ("7 days ago",
+// "yesterday") and absolute times are written as quoted date-times.
+func FormatNRQLTimeClause(clause, value string, naturalLanguage bool) (string, error) {
+	t, err := ParseFlexibleTime(value)
+	if err != nil {
+		return "", err
+	}
+
+	if !naturalLanguage {
+		return fmt.Sprintf("%s %d", clause, t.Unix()), nil
+	}
+
+	lower := strings.ToLower(strings.TrimSpace(value))
+	switch {
+	case lower == "now" || lower == "today" || lower == "yesterday":
+		return fmt.Sprintf("%s %s", clause, lower), nil
+	case relativeTimePattern.MatchString(lower):
+		return fmt.Sprintf("%s %s", clause, strings.Join(strings.Fields(lower), " ")), nil
+	}
+	if matches := compactRelativePattern.FindStringSubmatch(lower); matches != nil {
+		unit := compactUnits[matches[2]]
+		if matches[1] != "1" {
+			unit += "s"
+		}
+		return fmt.Sprintf("%s %s %s ago", clause, matches[1], unit), nil
+	}
+
+	layout := "2006-01-02 15:04:05"
+	if _, offset := t.Zone(); offset != 0 {
+		layout += " -0700"
+	}
+	return fmt.Sprintf("%s '%s'", clause, t.Format(layout)), nil
+}
+
+// AppendNRQLTimeRange appends SINCE and UNTIL clauses for since and until
+// (either may be empty) unless the query already has that clause
+func AppendNRQLTimeRange(nrql, since, until string, naturalLanguage bool) (string, error) {
+	query := nrql
+
+	if since != "" && !ContainsNRQLClause(nrql, "SINCE") {
+		clause, err := FormatNRQLTimeClause("SINCE", since, naturalLanguage)
+		if err != nil {
+			return "", fmt.Errorf("invalid --since value: %w", err)
+		}
+		query += " " + clause
+	}
+
+	if until != "" && !ContainsNRQLClause(nrql, "UNTIL") {
+		clause, err := FormatNRQLTimeClause("UNTIL", until, naturalLanguage)
+		if err != nil {
+			return "", fmt.Errorf("invalid --until value: %w", err)
+		}
+		query += " " + clause
+	}
+
+	return query, nil
+}
+
+// ContainsNRQLClause checks if the NRQL query already contains a specific clause
+func ContainsNRQLClause(nrql, clause string) bool {
+	upper := strings.ToUpper(nrql)
+	return strings.Contains(upper, " "+clause+" ") || strings.HasSuffix(upper, " "+clause)
+}
+
 // ParseDeploymentTimestamp parses the timestamp format returned by New Relic's deployment API
 func ParseDeploymentTimestamp(s string) (time.Time, error) {
 	// New Relic typically returns timestamps in RFC3339 or similar formats
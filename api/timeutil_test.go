@@ -108,6 +108,77 @@ func TestParseFlexibleTime(t *testing.T) {
 		assert.Equal(t, expected.Month(), result.Month())
 	})
 
+	t.Run("compact relative times", func(t *testing.T) {
+		tests := []struct {
+			value string
+			want  time.Duration
+		}{
+			{"45s", 45 * time.Second},
+			{"30m", 30 * time.Minute},
+			{"3h", 3 * time.Hour},
+			{"3H", 3 * time.Hour},
+			{"2d", 48 * time.Hour},
+			{"1w", 7 * 24 * time.Hour},
+		}
+
+		for _, tt := range tests {
+			result, err := ParseFlexibleTime(tt.value)
+			expected := time.Now().Add(-tt.want)
+
+			assert.NoError(t, err, tt.value)
+			// Days and weeks use calendar arithmetic, so allow for DST shifts
+			assert.WithinDuration(t, expected, result, time.Hour, tt.value)
+		}
+	})
+
+	t.Run("compact relative time with invalid unit", func(t *testing.T) {
+		_, err := ParseFlexibleTime("3x")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown unit "x"`)
+	})
+
+	t.Run("epoch seconds", func(t *testing.T) {
+		result, err := ParseFlexibleTime("1736950200")
+
+		assert.NoError(t, err)
+		assert.True(t, result.Equal(time.Date(2025, 1, 15, 14, 10, 0, 0, time.UTC)))
+	})
+
+	t.Run("epoch milliseconds", func(t *testing.T) {
+		result, err := ParseFlexibleTime("1736950200123")
+
+		assert.NoError(t, err)
+		assert.True(t, result.Equal(time.Date(2025, 1, 15, 14, 10, 0, 123*int(time.Millisecond), time.UTC)))
+	})
+
+	t.Run("epoch thresholds", func(t *testing.T) {
+		result, err := ParseFlexibleTime("1000000001")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1000000001), result.Unix())
+
+		result, err = ParseFlexibleTime("999999999999")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(999999999999), result.Unix())
+
+		result, err = ParseFlexibleTime("1000000000000")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1000000000), result.Unix())
+
+		result, err = ParseFlexibleTime("9999999999999")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9999999999999), result.UnixMilli())
+	})
+
+	t.Run("integers outside the epoch ranges", func(t *testing.T) {
+		for _, value := range []string{"1000000000", "12345", "10000000000000"} {
+			_, err := ParseFlexibleTime(value)
+
+			assert.Error(t, err, value)
+			assert.Contains(t, err.Error(), "Unix timestamps")
+		}
+	})
+
 	t.Run("empty string", func(t *testing.T) {
 		_, err := ParseFlexibleTime("")
 
@@ -195,3 +266,93 @@ func TestParseDeploymentTimestamp(t *testing.T) {
 		assert.Contains(t, err.Error(), "unable to parse deployment timestamp")
 	})
 }
+
+func TestFormatNRQLTimeClause(t *testing.T) {
+	t.Run("unix timestamp by default", func(t *testing.T) {
+		clause, err := FormatNRQLTimeClause("SINCE", "2025-01-01", false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SINCE 1735689600", clause)
+	})
+
+	t.Run("relative natural language", func(t *testing.T) {
+		clause, err := FormatNRQLTimeClause("SINCE", "7  Days Ago", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SINCE 7 days ago", clause)
+	})
+
+	t.Run("compact relative natural language", func(t *testing.T) {
+		clause, err := FormatNRQLTimeClause("SINCE", "3h", true)
+		assert.NoError(t, err)
+		assert.Equal(t, "SINCE 3 hours ago", clause)
+
+		clause, err = FormatNRQLTimeClause("SINCE", "1w", true)
+		assert.NoError(t, err)
+		assert.Equal(t, "SINCE 1 week ago", clause)
+	})
+
+	t.Run("special value natural language", func(t *testing.T) {
+		clause, err := FormatNRQLTimeClause("UNTIL", "Now", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "UNTIL now", clause)
+	})
+
+	t.Run("absolute date natural language", func(t *testing.T) {
+		clause, err := FormatNRQLTimeClause("SINCE", "2025-01-01", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SINCE '2025-01-01 00:00:00'", clause)
+	})
+
+	t.Run("absolute time with offset keeps the offset", func(t *testing.T) {
+		clause, err := FormatNRQLTimeClause("SINCE", "2025-01-01T09:30:00+05:00", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SINCE '2025-01-01 09:30:00 +0500'", clause)
+	})
+
+	t.Run("invalid time", func(t *testing.T) {
+		_, err := FormatNRQLTimeClause("SINCE", "not a time", true)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestAppendNRQLTimeRange(t *testing.T) {
+	t.Run("appends since and until", func(t *testing.T) {
+		query, err := AppendNRQLTimeRange("SELECT count(*) FROM Transaction", "1 day ago", "now", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT count(*) FROM Transaction SINCE 1 day ago UNTIL now", query)
+	})
+
+	t.Run("does not add a second SINCE", func(t *testing.T) {
+		query, err := AppendNRQLTimeRange("SELECT count(*) FROM Transaction SINCE 1 hour ago", "7 days ago", "", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT count(*) FROM Transaction SINCE 1 hour ago", query)
+	})
+
+	t.Run("does not add a second UNTIL", func(t *testing.T) {
+		query, err := AppendNRQLTimeRange("SELECT * FROM Log since 1 day ago until now", "", "yesterday", false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT * FROM Log since 1 day ago until now", query)
+	})
+
+	t.Run("no flags leaves query unchanged", func(t *testing.T) {
+		query, err := AppendNRQLTimeRange("SELECT * FROM Log", "", "", false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT * FROM Log", query)
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		_, err := AppendNRQLTimeRange("SELECT * FROM Log", "whenever", "", false)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid --since value")
+	})
+}
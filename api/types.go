@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // EntityGUID is a New Relic entity identifier.
@@ -199,12 +200,63 @@ func (a AccountID) IsEmpty() bool {
 
 // Application represents a New Relic APM application
 type Application struct {
-	ID             int    `json:"id"`
-	Name           string `json:"name"`
-	Language       string `json:"language"`
-	HealthStatus   string `json:"health_status"`
-	Reporting      bool   `json:"reporting"`
-	LastReportedAt string `json:"last_reported_at"`
+	ID             int        `json:"id"`
+	Name           string     `json:"name"`
+	Language       string     `json:"language"`
+	HealthStatus   string     `json:"health_status"`
+	Reporting      bool       `json:"reporting"`
+	LastReportedAt string     `json:"last_reported_at"`
+	GUID           EntityGUID `json:"guid,omitempty"`
+}
+
+// AppHealthStatus is an APM application's alert severity and recent golden
+// metrics. The metrics are zero when Reporting is false.
+type AppHealthStatus struct {
+	GUID          EntityGUID `json:"guid"`
+	Name          string     `json:"name"`
+	AlertSeverity string     `json:"alertSeverity"`
+	Reporting     bool       `json:"reporting"`
+	ErrorRate     float64    `json:"errorRate"`    // Fraction of transactions that failed
+	ResponseTime  float64    `json:"responseTime"` // Average, in seconds
+	Throughput    float64    `json:"throughput"`   // Requests per minute
+}
+
+// ApplicationHost represents a host an APM application runs on
+type ApplicationHost struct {
+	ID              int    `json:"id"`
+	ApplicationName string `json:"application_name"`
+	Host            string `json:"host"`
+	Port            int    `json:"port,omitempty"`
+	HealthStatus    string `json:"health_status"`
+	Language        string `json:"language"`
+	AgentVersion    string `json:"agent_version,omitempty"`
+}
+
+// ApplicationHostsResponse is the API response for listing application hosts
+type ApplicationHostsResponse struct {
+	ApplicationHosts []ApplicationHost `json:"application_hosts"`
+}
+
+// ApplicationHostResponse is the API response for a single application host
+type ApplicationHostResponse struct {
+	ApplicationHost ApplicationHost `json:"application_host"`
+}
+
+// AppLabel represents a label (category:name pair) applied to an APM application
+type AppLabel struct {
+	Key      string `json:"key,omitempty"`
+	Category string `json:"category"`
+	Name     string `json:"name"`
+}
+
+// AppLabelsResponse is the API response for listing application labels
+type AppLabelsResponse struct {
+	Labels []AppLabel `json:"labels"`
+}
+
+// AppLabelResponse is the API response for a single application label
+type AppLabelResponse struct {
+	Label AppLabel `json:"label"`
 }
 
 // ApplicationsResponse is the API response for listing applications
@@ -228,6 +280,41 @@ type MetricsResponse struct {
 	Metrics []Metric `json:"metrics"`
 }
 
+// MetricDataQuery selects the metric data to fetch for an application
+type MetricDataQuery struct {
+	Names  []string  // Metric names, e.g. HttpDispatcher
+	Values []string  // Value names, e.g. call_count; empty returns all values
+	From   time.Time // Zero uses the API default (30 minutes ago)
+	To     time.Time // Zero uses the API default (now)
+}
+
+// MetricData holds metric timeslices for an application
+type MetricData struct {
+	From            string             `json:"from"`
+	To              string             `json:"to"`
+	MetricsNotFound []string           `json:"metrics_not_found"`
+	MetricsFound    []string           `json:"metrics_found"`
+	Metrics         []MetricTimeslices `json:"metrics"`
+}
+
+// MetricTimeslices holds the timeslices of a single metric
+type MetricTimeslices struct {
+	Name       string            `json:"name"`
+	Timeslices []MetricTimeslice `json:"timeslices"`
+}
+
+// MetricTimeslice holds metric values for one time period
+type MetricTimeslice struct {
+	From   string             `json:"from"`
+	To     string             `json:"to"`
+	Values map[string]float64 `json:"values"`
+}
+
+// MetricDataResponse is the API response for application metric data
+type MetricDataResponse struct {
+	MetricData MetricData `json:"metric_data"`
+}
+
 // AlertPolicy represents an alert policy
 type AlertPolicy struct {
 	ID                 int    `json:"id"`
@@ -240,6 +327,35 @@ type AlertPoliciesResponse struct {
 	Policies []AlertPolicy `json:"policies"`
 }
 
+// AlertCondition represents a NRQL alert condition
+type AlertCondition struct {
+	ID                string               `json:"id"`
+	Name              string               `json:"name"`
+	PolicyID          string               `json:"policyId"`
+	Enabled           bool                 `json:"enabled"`
+	NRQL              string               `json:"nrql"`
+	AggregationWindow int                  `json:"aggregationWindow,omitempty"`
+	Terms             []AlertConditionTerm `json:"terms"`
+}
+
+// AlertConditionTerm represents a threshold term on an alert condition
+type AlertConditionTerm struct {
+	Operator             string  `json:"operator"`
+	Priority             string  `json:"priority"`
+	Threshold            float64 `json:"threshold"`
+	ThresholdDuration    int     `json:"thresholdDuration"`
+	ThresholdOccurrences string  `json:"thresholdOccurrences"`
+}
+
+// AlertIncident represents an open alert incident
+type AlertIncident struct {
+	ID          string `json:"id"`
+	IncidentID  string `json:"incidentId"`
+	Title       string `json:"title"`
+	Priority    string `json:"priority"`
+	Description string `json:"description"`
+}
+
 // Dashboard represents a New Relic dashboard
 type Dashboard struct {
 	GUID        EntityGUID `json:"guid"`
@@ -250,26 +366,30 @@ type Dashboard struct {
 
 // DashboardPage represents a page within a dashboard
 type DashboardPage struct {
-	GUID    EntityGUID        `json:"guid"`
-	Name    string            `json:"name"`
-	Widgets []DashboardWidget `json:"widgets"`
+	GUID        EntityGUID        `json:"guid"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Widgets     []DashboardWidget `json:"widgets"`
 }
 
 // DashboardWidget represents a widget on a dashboard page
 type DashboardWidget struct {
-	ID            string                 `json:"id"`
-	Title         string                 `json:"title"`
-	Visualization map[string]interface{} `json:"visualization"`
-	Configuration map[string]interface{} `json:"rawConfiguration"`
+	ID                string                 `json:"id"`
+	Title             string                 `json:"title"`
+	Visualization     map[string]interface{} `json:"visualization"`
+	Configuration     map[string]interface{} `json:"rawConfiguration"`
+	Layout            map[string]interface{} `json:"layout,omitempty"`
+	LinkedEntityGUIDs []EntityGUID           `json:"linkedEntityGuids,omitempty"`
 }
 
 // DashboardDetail represents detailed dashboard information
 type DashboardDetail struct {
-	GUID        EntityGUID      `json:"guid"`
-	Name        string          `json:"name"`
-	Description string          `json:"description"`
-	Permissions string          `json:"permissions"`
-	Pages       []DashboardPage `json:"pages"`
+	GUID        EntityGUID               `json:"guid"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Permissions string                   `json:"permissions"`
+	Variables   []map[string]interface{} `json:"variables,omitempty"`
+	Pages       []DashboardPage          `json:"pages"`
 }
 
 // User represents a New Relic user
@@ -282,6 +402,27 @@ type User struct {
 	AuthenticationDomain string   `json:"authentication_domain,omitempty"`
 }
 
+// UserInviteInput is the input for creating a user in an authentication domain
+type UserInviteInput struct {
+	Email                  string `json:"email"`
+	Name                   string `json:"name"`
+	Type                   string `json:"userType"`
+	AuthenticationDomainID string `json:"authenticationDomainId"`
+}
+
+// Group represents a user management group
+type Group struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	AuthenticationDomain string `json:"authentication_domain,omitempty"`
+}
+
+// AuthenticationDomain represents a user management authentication domain
+type AuthenticationDomain struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
 // Entity represents a New Relic entity
 type Entity struct {
 	GUID       EntityGUID        `json:"guid"`
@@ -293,14 +434,42 @@ type Entity struct {
 	Tags       map[string]string `json:"tags,omitempty"`
 }
 
+// EntityRelationship is a directed relationship between two entities
+type EntityRelationship struct {
+	Source Entity `json:"source"`
+	Target Entity `json:"target"`
+	Type   string `json:"type"`
+}
+
+// EntityTag represents a tag on an entity. Tags can hold multiple values.
+type EntityTag struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+// EntityMetadata represents the full context of an entity, fetched in a
+// single NerdGraph call
+type EntityMetadata struct {
+	GUID          EntityGUID  `json:"guid"`
+	Name          string      `json:"name"`
+	Type          string      `json:"type"`
+	EntityType    string      `json:"entityType"`
+	Domain        string      `json:"domain"`
+	AccountID     int         `json:"accountId"`
+	Tags          []EntityTag `json:"tags,omitempty"`
+	AlertSeverity string      `json:"alertSeverity,omitempty"`
+	Permalink     string      `json:"permalink,omitempty"`
+}
+
 // SyntheticMonitor represents a synthetic monitor
 type SyntheticMonitor struct {
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	Type      string `json:"type"`
-	Frequency int    `json:"frequency"`
-	Status    string `json:"status"`
-	URI       string `json:"uri,omitempty"`
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	Frequency int      `json:"frequency"`
+	Status    string   `json:"status"`
+	URI       string   `json:"uri,omitempty"`
+	Locations []string `json:"locations,omitempty"`
 }
 
 // SyntheticsResponse is the API response for listing synthetic monitors
@@ -308,6 +477,29 @@ type SyntheticsResponse struct {
 	Monitors []SyntheticMonitor `json:"monitors"`
 }
 
+// SyntheticResult is a single check result of a synthetic monitor
+type SyntheticResult struct {
+	CheckID   string  `json:"checkId"`
+	Timestamp int64   `json:"timestamp"`
+	Location  string  `json:"location"`
+	Result    string  `json:"result"`
+	Duration  float64 `json:"duration"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// SyntheticResultsResponse is the API response for listing monitor results
+type SyntheticResultsResponse struct {
+	Results []SyntheticResult `json:"results"`
+}
+
+// SyntheticLocation is a location synthetic monitors can run from. Name is
+// the identifier used when creating a monitor, such as AWS_US_EAST_1.
+type SyntheticLocation struct {
+	Name    string `json:"name"`
+	Label   string `json:"label"`
+	Private bool   `json:"private"`
+}
+
 // Deployment represents a deployment marker
 type Deployment struct {
 	ID          int    `json:"id"`
@@ -329,7 +521,17 @@ type DeploymentResponse struct {
 
 // NRQLResult represents the result of an NRQL query
 type NRQLResult struct {
-	Results []map[string]interface{} `json:"results"`
+	Results    []map[string]interface{} `json:"results"`
+	NextCursor string                   `json:"nextCursor,omitempty"`
+}
+
+// NRQLAsyncJob is an NRQL query submitted with QueryNRQLAsync. Result is
+// set once the job has completed.
+type NRQLAsyncJob struct {
+	QueryID    string      `json:"queryId,omitempty"`
+	Completed  bool        `json:"completed"`
+	RetryAfter int         `json:"retryAfter,omitempty"`
+	Result     *NRQLResult `json:"result,omitempty"`
 }
 
 // LogParsingRule represents a log parsing rule
@@ -343,6 +545,129 @@ type LogParsingRule struct {
 	UpdatedAt   string `json:"updatedAt"`
 }
 
+// LogEntry is a single log record. Attributes holds every attribute of the
+// record, including timestamp and message.
+type LogEntry struct {
+	Timestamp  time.Time              `json:"timestamp"`
+	Message    string                 `json:"message"`
+	Attributes map[string]interface{} `json:"attributes"`
+}
+
+// LogObfuscationRule represents a log obfuscation rule
+type LogObfuscationRule struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Enabled     bool                   `json:"enabled"`
+	Filter      string                 `json:"filter,omitempty"`
+	Actions     []LogObfuscationAction `json:"actions"`
+}
+
+// LogObfuscationAction is one obfuscation applied by a rule: the log
+// attributes matched against an expression's regex and the method (MASK or
+// HASH_SHA256) applied to the matches
+type LogObfuscationAction struct {
+	Attributes   []string `json:"attributes"`
+	Method       string   `json:"method"`
+	ExpressionID string   `json:"expressionId"`
+	Regex        string   `json:"regex"`
+}
+
+// LogObfuscationRuleInput contains the fields for creating an obfuscation
+// rule with a single action
+type LogObfuscationRuleInput struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Enabled     bool     `json:"enabled"`
+	Filter      string   `json:"filter,omitempty"`
+	Regex       string   `json:"regex"`
+	Method      string   `json:"method"`
+	Attributes  []string `json:"attributes"`
+}
+
+// DataPartitionRule represents a rule routing matching logs to a data
+// partition
+type DataPartitionRule struct {
+	ID                  string `json:"id"`
+	TargetDataPartition string `json:"targetDataPartition"`
+	Description         string `json:"description,omitempty"`
+	Enabled             bool   `json:"enabled"`
+	NRQL                string `json:"nrql"`
+	RetentionPolicy     string `json:"retentionPolicy"`
+}
+
+// DataPartitionRuleInput contains the fields for creating a data partition
+// rule. NRQL is a WHERE clause selecting the logs to route.
+type DataPartitionRuleInput struct {
+	TargetDataPartition string `json:"targetDataPartition"`
+	Description         string `json:"description,omitempty"`
+	Enabled             bool   `json:"enabled"`
+	NRQL                string `json:"nrql"`
+	RetentionPolicy     string `json:"retentionPolicy"`
+}
+
+// Account is a New Relic account the API key can access
+type Account struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// Workload represents a workload, a group of entities whose health is
+// monitored together. Status is the computed status value (OPERATIONAL,
+// DEGRADED, DISRUPTED or UNKNOWN).
+type Workload struct {
+	GUID      EntityGUID       `json:"guid"`
+	Name      string           `json:"name"`
+	Status    string           `json:"status"`
+	Permalink string           `json:"permalink,omitempty"`
+	Entities  []WorkloadEntity `json:"entities,omitempty"`
+}
+
+// WorkloadEntity is an entity belonging to a workload
+type WorkloadEntity struct {
+	GUID EntityGUID `json:"guid"`
+	Name string     `json:"name"`
+	Type string     `json:"type"`
+}
+
+// WorkloadStatus is the computed health of a workload and where it came
+// from (ROLLUP_RULE, STATIC, WORKLOAD or UNKNOWN)
+type WorkloadStatus struct {
+	Value       string `json:"value"`
+	Source      string `json:"source"`
+	Description string `json:"description,omitempty"`
+}
+
+// ServiceLevelIndicator represents a service level indicator (SLI), which
+// measures the share of good events among valid events for an entity.
+// IndicatorNRQL is the query New Relic uses to compute it.
+type ServiceLevelIndicator struct {
+	GUID          EntityGUID              `json:"guid"`
+	ID            string                  `json:"id"`
+	Name          string                  `json:"name"`
+	Description   string                  `json:"description,omitempty"`
+	EntityGUID    EntityGUID              `json:"entityGuid"`
+	ValidEvents   *ServiceLevelEvents     `json:"validEvents,omitempty"`
+	GoodEvents    *ServiceLevelEvents     `json:"goodEvents,omitempty"`
+	BadEvents     *ServiceLevelEvents     `json:"badEvents,omitempty"`
+	Objectives    []ServiceLevelObjective `json:"objectives"`
+	IndicatorNRQL string                  `json:"indicatorNrql,omitempty"`
+}
+
+// ServiceLevelEvents selects the events counted by an SLI
+type ServiceLevelEvents struct {
+	From  string `json:"from"`
+	Where string `json:"where,omitempty"`
+}
+
+// ServiceLevelObjective is a target for an SLI over a rolling window, such
+// as 99.9% over 7 days
+type ServiceLevelObjective struct {
+	Name       string  `json:"name,omitempty"`
+	Target     float64 `json:"target"`
+	WindowDays int     `json:"windowDays"`
+}
+
 // ApiAccessKey represents a New Relic API access key (user or ingest)
 type ApiAccessKey struct {
 	ID         string `json:"id"`
@@ -351,6 +676,16 @@ type ApiAccessKey struct {
 	Type       string `json:"type"`
 	Key        string `json:"key,omitempty"`
 	IngestType string `json:"ingestType,omitempty"`
+	AccountID  int    `json:"accountId,omitempty"`
+	UserID     int    `json:"userId,omitempty"` // User keys only
+}
+
+// ApiAccessKeyMetadata holds creation and usage times for an API key.
+// Zero times mean the value is unknown (LastUsed is only tracked for user keys).
+type ApiAccessKeyMetadata struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	LastUsed  time.Time `json:"lastUsed"`
 }
 
 // ApiAccessKeyUpdate contains the fields that can be updated on an API key.
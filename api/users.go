@@ -1,6 +1,9 @@
 package api
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // ListUsers returns all users in the organization
 func (c *Client) ListUsers() ([]User, error) {
@@ -36,23 +39,23 @@ func (c *Client) ListUsers() ([]User, error) {
 	// Navigate the nested structure safely
 	actor, ok := safeMap(result["actor"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing actor"}
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
 	}
 	org, ok := safeMap(actor["organization"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing organization"}
+		return nil, &ResponseError{Message: "unexpected response format: missing organization", RawResponse: rawResponse(result)}
 	}
 	userMgmt, ok := safeMap(org["userManagement"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing userManagement"}
+		return nil, &ResponseError{Message: "unexpected response format: missing userManagement", RawResponse: rawResponse(result)}
 	}
 	authDomains, ok := safeMap(userMgmt["authenticationDomains"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing authenticationDomains"}
+		return nil, &ResponseError{Message: "unexpected response format: missing authenticationDomains", RawResponse: rawResponse(result)}
 	}
 	domains, ok := safeSlice(authDomains["authenticationDomains"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format: missing domains list"}
+		return nil, &ResponseError{Message: "unexpected response format: missing domains list", RawResponse: rawResponse(result)}
 	}
 
 	var users []User
@@ -127,23 +130,23 @@ func (c *Client) GetUser(userID string) (*User, error) {
 	// Navigate and find the user
 	actor, ok := safeMap(result["actor"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format"}
+		return nil, &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
 	}
 	org, ok := safeMap(actor["organization"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format"}
+		return nil, &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
 	}
 	userMgmt, ok := safeMap(org["userManagement"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format"}
+		return nil, &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
 	}
 	authDomains, ok := safeMap(userMgmt["authenticationDomains"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format"}
+		return nil, &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
 	}
 	domains, ok := safeSlice(authDomains["authenticationDomains"])
 	if !ok {
-		return nil, &ResponseError{Message: "unexpected response format"}
+		return nil, &ResponseError{Message: "unexpected response format", RawResponse: rawResponse(result)}
 	}
 
 	for _, d := range domains {
@@ -199,3 +202,291 @@ func (c *Client) GetUser(userID string) (*User, error) {
 
 	return nil, fmt.Errorf("user not found")
 }
+
+// InviteUser creates a user in an authentication domain. New Relic emails
+// the user an invitation to set up their login.
+func (c *Client) InviteUser(input UserInviteInput) (*User, error) {
+	mutation := `
+	mutation($options: UserManagementCreateUser!) {
+		userManagementCreateUser(createUserOptions: $options) {
+			createdUser {
+				id
+				name
+				email
+				type { displayName }
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"options": input,
+	}
+
+	result, err := c.NerdGraphQuery(mutation, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	createResult, ok := safeMap(result["userManagementCreateUser"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing userManagementCreateUser", RawResponse: rawResponse(result)}
+	}
+	created, ok := safeMap(createResult["createdUser"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing createdUser", RawResponse: rawResponse(result)}
+	}
+
+	userType := ""
+	if t, ok := safeMap(created["type"]); ok {
+		userType = safeString(t["displayName"])
+	}
+
+	return &User{
+		ID:    safeString(created["id"]),
+		Name:  safeString(created["name"]),
+		Email: safeString(created["email"]),
+		Type:  userType,
+	}, nil
+}
+
+// ListGroups returns all groups in the organization
+func (c *Client) ListGroups() ([]Group, error) {
+	query := `
+	{
+		actor {
+			organization {
+				userManagement {
+					authenticationDomains {
+						authenticationDomains {
+							name
+							groups {
+								groups {
+									id
+									displayName
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}`
+
+	result, err := c.NerdGraphQuery(query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	org, ok := safeMap(actor["organization"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing organization", RawResponse: rawResponse(result)}
+	}
+	userMgmt, ok := safeMap(org["userManagement"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing userManagement", RawResponse: rawResponse(result)}
+	}
+	authDomains, ok := safeMap(userMgmt["authenticationDomains"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing authenticationDomains", RawResponse: rawResponse(result)}
+	}
+	domains, ok := safeSlice(authDomains["authenticationDomains"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing domains list", RawResponse: rawResponse(result)}
+	}
+
+	var groups []Group
+	for _, d := range domains {
+		domain, ok := safeMap(d)
+		if !ok {
+			continue
+		}
+		domainName := safeString(domain["name"])
+		groupsData, ok := safeMap(domain["groups"])
+		if !ok {
+			continue
+		}
+		groupsList, ok := safeSlice(groupsData["groups"])
+		if !ok {
+			continue
+		}
+
+		for _, g := range groupsList {
+			group, ok := safeMap(g)
+			if !ok {
+				continue
+			}
+			groups = append(groups, Group{
+				ID:                   safeString(group["id"]),
+				Name:                 safeString(group["displayName"]),
+				AuthenticationDomain: domainName,
+			})
+		}
+	}
+
+	return groups, nil
+}
+
+// ListGroupMembers returns the users in a group
+func (c *Client) ListGroupMembers(groupID string) ([]User, error) {
+	query := `
+	query($groupId: ID!) {
+		actor {
+			organization {
+				userManagement {
+					authenticationDomains {
+						authenticationDomains {
+							name
+							groups(filter: {id: {eq: $groupId}}) {
+								groups {
+									id
+									users {
+										users {
+											id
+											name
+											email
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}`
+
+	result, err := c.NerdGraphQuery(query, map[string]interface{}{"groupId": groupID})
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	org, ok := safeMap(actor["organization"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing organization", RawResponse: rawResponse(result)}
+	}
+	userMgmt, ok := safeMap(org["userManagement"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing userManagement", RawResponse: rawResponse(result)}
+	}
+	authDomains, ok := safeMap(userMgmt["authenticationDomains"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing authenticationDomains", RawResponse: rawResponse(result)}
+	}
+	domains, ok := safeSlice(authDomains["authenticationDomains"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing domains list", RawResponse: rawResponse(result)}
+	}
+
+	for _, d := range domains {
+		domain, ok := safeMap(d)
+		if !ok {
+			continue
+		}
+		domainName := safeString(domain["name"])
+		groupsData, ok := safeMap(domain["groups"])
+		if !ok {
+			continue
+		}
+		groupsList, ok := safeSlice(groupsData["groups"])
+		if !ok {
+			continue
+		}
+
+		for _, g := range groupsList {
+			group, ok := safeMap(g)
+			if !ok || safeString(group["id"]) != groupID {
+				continue
+			}
+
+			users := []User{}
+			if usersData, ok := safeMap(group["users"]); ok {
+				if usersList, ok := safeSlice(usersData["users"]); ok {
+					for _, u := range usersList {
+						user, ok := safeMap(u)
+						if !ok {
+							continue
+						}
+						users = append(users, User{
+							ID:                   safeString(user["id"]),
+							Name:                 safeString(user["name"]),
+							Email:                safeString(user["email"]),
+							AuthenticationDomain: domainName,
+						})
+					}
+				}
+			}
+			return users, nil
+		}
+	}
+
+	return nil, fmt.Errorf("group not found: %s", groupID)
+}
+
+// FindAuthDomain returns the authentication domain with the given name
+// (case-insensitive)
+func (c *Client) FindAuthDomain(name string) (*AuthenticationDomain, error) {
+	query := `
+	{
+		actor {
+			organization {
+				userManagement {
+					authenticationDomains {
+						authenticationDomains {
+							id
+							name
+						}
+					}
+				}
+			}
+		}
+	}`
+
+	result, err := c.NerdGraphQuery(query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	org, ok := safeMap(actor["organization"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing organization", RawResponse: rawResponse(result)}
+	}
+	userMgmt, ok := safeMap(org["userManagement"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing userManagement", RawResponse: rawResponse(result)}
+	}
+	authDomains, ok := safeMap(userMgmt["authenticationDomains"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing authenticationDomains", RawResponse: rawResponse(result)}
+	}
+	domains, ok := safeSlice(authDomains["authenticationDomains"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing domains list", RawResponse: rawResponse(result)}
+	}
+
+	for _, d := range domains {
+		domain, ok := safeMap(d)
+		if !ok {
+			continue
+		}
+		if strings.EqualFold(safeString(domain["name"]), name) {
+			return &AuthenticationDomain{
+				ID:   safeString(domain["id"]),
+				Name: safeString(domain["name"]),
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("authentication domain not found: %s", name)
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -205,3 +206,187 @@ func TestGetUser_EmptyDomains(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "user not found")
 }
+
+func TestInviteUser(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{
+		"data": {
+			"userManagementCreateUser": {
+				"createdUser": {
+					"id": "user-100",
+					"name": "Alice Smith",
+					"email": "alice@example.com",
+					"type": {"displayName": "Full platform"}
+				}
+			}
+		}
+	}`)
+
+	client := NewTestClient(server)
+	user, err := client.InviteUser(UserInviteInput{
+		Email:                  "alice@example.com",
+		Name:                   "Alice Smith",
+		Type:                   "FULL_USER_TIER",
+		AuthenticationDomainID: "domain-1",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "user-100", user.ID)
+	assert.Equal(t, "Alice Smith", user.Name)
+	assert.Equal(t, "Full platform", user.Type)
+
+	var body struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &body))
+	assert.Contains(t, body.Query, "userManagementCreateUser")
+	assert.Equal(t, map[string]interface{}{
+		"email":                  "alice@example.com",
+		"name":                   "Alice Smith",
+		"userType":               "FULL_USER_TIER",
+		"authenticationDomainId": "domain-1",
+	}, body.Variables["options"])
+}
+
+func TestInviteUser_GraphQLError(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "graphql_error.json"))
+
+	client := NewTestClient(server)
+	_, err := client.InviteUser(UserInviteInput{Email: "alice@example.com"})
+
+	require.Error(t, err)
+}
+
+func TestFindAuthDomain(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{
+		"data": {
+			"actor": {
+				"organization": {
+					"userManagement": {
+						"authenticationDomains": {
+							"authenticationDomains": [
+								{"id": "domain-1", "name": "Default"},
+								{"id": "domain-2", "name": "Okta SSO"}
+							]
+						}
+					}
+				}
+			}
+		}
+	}`)
+
+	client := NewTestClient(server)
+	domain, err := client.FindAuthDomain("okta sso")
+
+	require.NoError(t, err)
+	assert.Equal(t, "domain-2", domain.ID)
+	assert.Equal(t, "Okta SSO", domain.Name)
+
+	_, err = client.FindAuthDomain("missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "authentication domain not found")
+}
+
+func TestListGroups(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{
+		"data": {
+			"actor": {
+				"organization": {
+					"userManagement": {
+						"authenticationDomains": {
+							"authenticationDomains": [
+								{
+									"name": "Default",
+									"groups": {"groups": [
+										{"id": "grp-1", "displayName": "Admin"},
+										{"id": "grp-2", "displayName": "Read Only"}
+									]}
+								},
+								{
+									"name": "Okta SSO",
+									"groups": {"groups": [{"id": "grp-3", "displayName": "Engineering"}]}
+								}
+							]
+						}
+					}
+				}
+			}
+		}
+	}`)
+
+	client := NewTestClient(server)
+	groups, err := client.ListGroups()
+
+	require.NoError(t, err)
+	require.Len(t, groups, 3)
+	assert.Equal(t, Group{ID: "grp-1", Name: "Admin", AuthenticationDomain: "Default"}, groups[0])
+	assert.Equal(t, "Okta SSO", groups[2].AuthenticationDomain)
+}
+
+func TestListGroupMembers(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{
+		"data": {
+			"actor": {
+				"organization": {
+					"userManagement": {
+						"authenticationDomains": {
+							"authenticationDomains": [
+								{"name": "Default", "groups": {"groups": []}},
+								{
+									"name": "Okta SSO",
+									"groups": {"groups": [{
+										"id": "grp-3",
+										"users": {"users": [
+											{"id": "100", "name": "Jane Doe", "email": "jane@example.com"},
+											{"id": "101", "name": "John Smith", "email": "john@example.com"}
+										]}
+									}]}
+								}
+							]
+						}
+					}
+				}
+			}
+		}
+	}`)
+
+	client := NewTestClient(server)
+	users, err := client.ListGroupMembers("grp-3")
+
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, "Jane Doe", users[0].Name)
+	assert.Equal(t, "Okta SSO", users[0].AuthenticationDomain)
+
+	var req NerdGraphRequest
+	require.NoError(t, json.Unmarshal(server.LastRequest().Body, &req))
+	assert.Equal(t, "grp-3", req.Variables["groupId"])
+}
+
+func TestListGroupMembers_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"organization": {"userManagement": {"authenticationDomains": {"authenticationDomains": [{"name": "Default", "groups": {"groups": []}}]}}}}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.ListGroupMembers("missing")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "group not found")
+}
@@ -0,0 +1,270 @@
+package api
+
+import "fmt"
+
+// workloadEntityBatchSize is the most GUIDs NerdGraph's entities(guids:)
+// field accepts at once
+const workloadEntityBatchSize = 25
+
+// ListWorkloads returns the workloads in the account with their computed
+// status
+func (c *Client) ListWorkloads() ([]Workload, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	query := `
+	query($accountId: Int!) {
+		actor {
+			account(id: $accountId) {
+				workload {
+					collections {
+						guid
+						name
+						permalink
+						status { value }
+					}
+				}
+			}
+		}
+	}`
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	workload, err := workloadData(result)
+	if err != nil {
+		return nil, err
+	}
+	collections, ok := safeSlice(workload["collections"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing collections", RawResponse: rawResponse(result)}
+	}
+
+	workloads := make([]Workload, 0, len(collections))
+	for _, w := range collections {
+		collection, ok := safeMap(w)
+		if !ok {
+			continue
+		}
+		workloads = append(workloads, parseWorkload(collection))
+	}
+
+	return workloads, nil
+}
+
+// GetWorkload returns a workload with the name and type of each of its
+// entities
+func (c *Client) GetWorkload(guid EntityGUID) (*Workload, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	query := `
+	query($accountId: Int!, $guid: EntityGuid!) {
+		actor {
+			account(id: $accountId) {
+				workload {
+					collection(guid: $guid) {
+						guid
+						name
+						permalink
+						status { value }
+						entities { guid }
+					}
+				}
+			}
+		}
+	}`
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+		"guid":      guid.String(),
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := workloadCollection(result, guid)
+	if err != nil {
+		return nil, err
+	}
+
+	w := parseWorkload(collection)
+
+	// The workload only references its entities by GUID
+	var guids []string
+	if refs, ok := safeSlice(collection["entities"]); ok {
+		for _, r := range refs {
+			if ref, ok := safeMap(r); ok && safeString(ref["guid"]) != "" {
+				guids = append(guids, safeString(ref["guid"]))
+			}
+		}
+	}
+
+	for start := 0; start < len(guids); start += workloadEntityBatchSize {
+		end := start + workloadEntityBatchSize
+		if end > len(guids) {
+			end = len(guids)
+		}
+		entities, err := c.getWorkloadEntities(guids[start:end])
+		if err != nil {
+			return nil, err
+		}
+		w.Entities = append(w.Entities, entities...)
+	}
+
+	return &w, nil
+}
+
+// GetWorkloadStatus returns the computed status of a workload
+func (c *Client) GetWorkloadStatus(guid EntityGUID) (*WorkloadStatus, error) {
+	if err := c.RequireAccountID(); err != nil {
+		return nil, err
+	}
+
+	query := `
+	query($accountId: Int!, $guid: EntityGuid!) {
+		actor {
+			account(id: $accountId) {
+				workload {
+					collection(guid: $guid) {
+						status {
+							value
+							source
+							description
+						}
+					}
+				}
+			}
+		}
+	}`
+
+	accountID, _ := c.GetAccountIDInt()
+	variables := map[string]interface{}{
+		"accountId": accountID,
+		"guid":      guid.String(),
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := workloadCollection(result, guid)
+	if err != nil {
+		return nil, err
+	}
+	status, ok := safeMap(collection["status"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing status", RawResponse: rawResponse(result)}
+	}
+
+	return &WorkloadStatus{
+		Value:       safeString(status["value"]),
+		Source:      safeString(status["source"]),
+		Description: safeString(status["description"]),
+	}, nil
+}
+
+// getWorkloadEntities looks up the name and type of up to
+// workloadEntityBatchSize entities
+func (c *Client) getWorkloadEntities(guids []string) ([]WorkloadEntity, error) {
+	query := `
+	query($guids: [EntityGuid]!) {
+		actor {
+			entities(guids: $guids) {
+				guid
+				name
+				type
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"guids": guids,
+	}
+
+	result, err := c.NerdGraphQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	entitiesData, ok := safeSlice(actor["entities"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing entities", RawResponse: rawResponse(result)}
+	}
+
+	entities := make([]WorkloadEntity, 0, len(entitiesData))
+	for _, e := range entitiesData {
+		entity, ok := safeMap(e)
+		if !ok {
+			continue
+		}
+		entities = append(entities, WorkloadEntity{
+			GUID: EntityGUID(safeString(entity["guid"])),
+			Name: safeString(entity["name"]),
+			Type: safeString(entity["type"]),
+		})
+	}
+
+	return entities, nil
+}
+
+// workloadData navigates to actor.account.workload in a response
+func workloadData(result map[string]interface{}) (map[string]interface{}, error) {
+	actor, ok := safeMap(result["actor"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing actor", RawResponse: rawResponse(result)}
+	}
+	account, ok := safeMap(actor["account"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing account", RawResponse: rawResponse(result)}
+	}
+	workload, ok := safeMap(account["workload"])
+	if !ok {
+		return nil, &ResponseError{Message: "unexpected response format: missing workload", RawResponse: rawResponse(result)}
+	}
+	return workload, nil
+}
+
+// workloadCollection returns the single workload in a collection(guid:)
+// response
+func workloadCollection(result map[string]interface{}, guid EntityGUID) (map[string]interface{}, error) {
+	workload, err := workloadData(result)
+	if err != nil {
+		return nil, err
+	}
+	collection, ok := safeMap(workload["collection"])
+	if !ok || collection == nil {
+		return nil, fmt.Errorf("workload not found: %s", guid)
+	}
+	return collection, nil
+}
+
+// parseWorkload converts a NerdGraph workload collection map to a Workload
+func parseWorkload(collection map[string]interface{}) Workload {
+	w := Workload{
+		GUID:      EntityGUID(safeString(collection["guid"])),
+		Name:      safeString(collection["name"]),
+		Permalink: safeString(collection["permalink"]),
+	}
+	if status, ok := safeMap(collection["status"]); ok {
+		w.Status = safeString(status["value"])
+	}
+	return w
+}
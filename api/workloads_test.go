@@ -0,0 +1,215 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListWorkloads(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "workloads_list.json"))
+
+	client := NewTestClient(server)
+	workloads, err := client.ListWorkloads()
+
+	require.NoError(t, err)
+	require.Len(t, workloads, 2)
+
+	assert.Equal(t, Workload{
+		GUID:      "MTIzNDU2N3xOUjF8V09SS0xPQUR8MTAx",
+		Name:      "Checkout",
+		Status:    "OPERATIONAL",
+		Permalink: "https://one.newrelic.com/redirect/entity/MTIzNDU2N3xOUjF8V09SS0xPQUR8MTAx",
+	}, workloads[0])
+	assert.Equal(t, "DEGRADED", workloads[1].Status)
+	assert.Empty(t, workloads[1].Permalink)
+
+	server.AssertLastPath(t, "/graphql")
+	assert.Contains(t, string(server.LastRequest().Body), "collections")
+}
+
+func TestListWorkloads_MissingCollections(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"workload": {}}}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.ListWorkloads()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing collections")
+}
+
+func TestListWorkloads_NoAccountID(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	client := NewTestClient(server)
+	client.AccountID = ""
+
+	_, err := client.ListWorkloads()
+
+	assert.ErrorIs(t, err, ErrAccountIDRequired)
+	server.AssertRequestCount(t, 0)
+}
+
+func TestGetWorkload(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	var requests []NerdGraphRequest
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req NerdGraphRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		requests = append(requests, req)
+
+		if len(requests) == 1 {
+			_, _ = w.Write([]byte(`{"data": {"actor": {"account": {"workload": {"collection": {
+				"guid": "MTIzNDU2N3xOUjF8V09SS0xPQUR8MTAx",
+				"name": "Checkout",
+				"status": {"value": "DISRUPTED"},
+				"entities": [{"guid": "APP-1"}, {"guid": "HOST-1"}]
+			}}}}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"actor": {"entities": [
+			{"guid": "APP-1", "name": "checkout-api", "type": "APPLICATION"},
+			{"guid": "HOST-1", "name": "web-01", "type": "HOST"}
+		]}}}`))
+	})
+
+	client := NewTestClient(server)
+	workload, err := client.GetWorkload("MTIzNDU2N3xOUjF8V09SS0xPQUR8MTAx")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Checkout", workload.Name)
+	assert.Equal(t, "DISRUPTED", workload.Status)
+	assert.Equal(t, []WorkloadEntity{
+		{GUID: "APP-1", Name: "checkout-api", Type: "APPLICATION"},
+		{GUID: "HOST-1", Name: "web-01", Type: "HOST"},
+	}, workload.Entities)
+
+	require.Len(t, requests, 2)
+	assert.Equal(t, "MTIzNDU2N3xOUjF8V09SS0xPQUR8MTAx", requests[0].Variables["guid"])
+	assert.Equal(t, []interface{}{"APP-1", "HOST-1"}, requests[1].Variables["guids"])
+}
+
+func TestGetWorkload_BatchesEntityLookups(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	refs := make([]map[string]string, 30)
+	for i := range refs {
+		refs[i] = map[string]string{"guid": fmt.Sprintf("E-%d", i)}
+	}
+	refsJSON, _ := json.Marshal(refs)
+
+	var batches [][]interface{}
+	server.SetHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req NerdGraphRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		guids, ok := req.Variables["guids"].([]interface{})
+		if !ok {
+			_, _ = fmt.Fprintf(w, `{"data": {"actor": {"account": {"workload": {"collection": {"guid": "W", "name": "Big", "entities": %s}}}}}}`, refsJSON)
+			return
+		}
+		batches = append(batches, guids)
+		entities := make([]map[string]interface{}, len(guids))
+		for i, g := range guids {
+			entities[i] = map[string]interface{}{"guid": g, "name": g, "type": "HOST"}
+		}
+		body, _ := json.Marshal(map[string]interface{}{"data": map[string]interface{}{"actor": map[string]interface{}{"entities": entities}}})
+		_, _ = w.Write(body)
+	})
+
+	client := NewTestClient(server)
+	workload, err := client.GetWorkload("W")
+
+	require.NoError(t, err)
+	assert.Len(t, workload.Entities, 30)
+	require.Len(t, batches, 2)
+	assert.Len(t, batches[0], 25)
+	assert.Len(t, batches[1], 5)
+}
+
+func TestGetWorkload_NotFound(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"workload": {"collection": null}}}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.GetWorkload("MISSING")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "workload not found: MISSING")
+	server.AssertRequestCount(t, 1)
+}
+
+func TestGetWorkload_NoEntities(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"workload": {"collection": {"guid": "W", "name": "Empty", "entities": []}}}}}}`)
+
+	client := NewTestClient(server)
+	workload, err := client.GetWorkload("W")
+
+	require.NoError(t, err)
+	assert.Empty(t, workload.Entities)
+	server.AssertRequestCount(t, 1)
+}
+
+func TestGetWorkloadStatus(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"workload": {"collection": {
+		"status": {"value": "DEGRADED", "source": "ROLLUP_RULE", "description": "2 of 5 entities are not operational"}
+	}}}}}}`)
+
+	client := NewTestClient(server)
+	status, err := client.GetWorkloadStatus("W")
+
+	require.NoError(t, err)
+	assert.Equal(t, &WorkloadStatus{
+		Value:       "DEGRADED",
+		Source:      "ROLLUP_RULE",
+		Description: "2 of 5 entities are not operational",
+	}, status)
+}
+
+func TestGetWorkloadStatus_MissingStatus(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, `{"data": {"actor": {"account": {"workload": {"collection": {}}}}}}`)
+
+	client := NewTestClient(server)
+	_, err := client.GetWorkloadStatus("W")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing status")
+}
+
+func TestGetWorkloadStatus_GraphQLError(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(http.StatusOK, LoadTestFixture(t, "graphql_error.json"))
+
+	client := NewTestClient(server)
+	_, err := client.GetWorkloadStatus("W")
+
+	var gqlErr *GraphQLError
+	assert.ErrorAs(t, err, &gqlErr)
+}
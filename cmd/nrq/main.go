@@ -1,10 +1,13 @@
 package main
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"os"
 
 	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/accounts"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/alerts"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/apps"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/completion"
@@ -18,14 +21,19 @@ import (
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/nerdgraph"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/nrql"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/slo"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/summary"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/synthetics"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/users"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/whoami"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/workloads"
 	"github.com/open-cli-collective/newrelic-cli/internal/exitcode"
 )
 
 func main() {
 	// Register all commands
 	root.RegisterCommands(
+		accounts.Register,
 		alerts.Register,
 		apps.Register,
 		completion.Register,
@@ -38,16 +46,35 @@ func main() {
 		logs.Register,
 		nerdgraph.Register,
 		nrql.Register,
+		slo.Register,
+		summary.Register,
 		synthetics.Register,
 		users.Register,
+		whoami.Register,
+		workloads.Register,
+		// Dynamic completions attach to the commands registered above
+		completion.RegisterDynamic,
 	)
 
 	if err := root.Execute(); err != nil {
+		// Show what NerdGraph actually returned when its response couldn't be parsed
+		var respErr *api.ResponseError
+		if errors.As(err, &respErr) && respErr.RawResponse != "" && root.GlobalOpts().Verbose {
+			fmt.Fprintf(os.Stderr, "Raw response: %s\n", respErr.RawResponse)
+		}
+
 		// Map error types to exit codes for shell scripting
+		if errors.Is(err, context.Canceled) {
+			os.Exit(exitcode.Interrupted)
+		}
 		var apiErr *api.APIError
 		if errors.As(err, &apiErr) {
 			os.Exit(exitcode.FromHTTPStatus(apiErr.StatusCode))
 		}
+		var graphqlErr *api.GraphQLError
+		if errors.As(err, &graphqlErr) {
+			os.Exit(exitcode.GraphQLError)
+		}
 		if errors.Is(err, api.ErrAPIKeyRequired) || errors.Is(err, api.ErrAccountIDRequired) {
 			os.Exit(exitcode.ConfigError)
 		}
@@ -0,0 +1,111 @@
+package accounts
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+// Register adds the accounts commands to the root command
+func Register(rootCmd *cobra.Command, opts *root.Options) {
+	accountsCmd := &cobra.Command{
+		Use:     "accounts",
+		Aliases: []string{"account"},
+		Short:   "View the accounts your API key can access",
+	}
+
+	accountsCmd.AddCommand(newListCmd(opts))
+	accountsCmd.AddCommand(newGetCmd(opts))
+
+	rootCmd.AddCommand(accountsCmd)
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List accessible accounts",
+		Long: `List every account your API key can access, including sub-accounts.
+
+Use an ID with --account-id or 'nrq config set-account-id' to run
+commands against that account.`,
+		Example: `  nrq accounts list
+  nrq accounts list -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(opts)
+		},
+	}
+}
+
+func runList(opts *root.Options) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	accounts, err := client.ListAccounts()
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	if len(accounts) == 0 {
+		v.Println("No accounts found")
+		return nil
+	}
+
+	headers := []string{"ID", "NAME"}
+	rows := make([][]string, len(accounts))
+	for i, a := range accounts {
+		rows[i] = []string{strconv.Itoa(a.ID), view.Truncate(a.Name, 50)}
+	}
+
+	return v.Render(headers, rows, accounts)
+}
+
+func newGetCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <account-id>",
+		Short: "Get an account by ID",
+		Example: `  nrq accounts get 12345
+  nrq accounts get 12345 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGet(opts, args[0])
+		},
+	}
+}
+
+func runGet(opts *root.Options, idArg string) error {
+	id, err := strconv.Atoi(idArg)
+	if err != nil || id <= 0 {
+		return fmt.Errorf("invalid account ID %q: must be a positive number", idArg)
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	account, err := client.GetAccount(id)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		return v.JSON(account)
+	case "plain":
+		return v.Plain([][]string{{strconv.Itoa(account.ID), account.Name}})
+	default:
+		v.Print("ID:   %d\n", account.ID)
+		v.Print("Name: %s\n", account.Name)
+		return nil
+	}
+}
@@ -3,6 +3,7 @@ package alerts
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/alerts/conditions"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
 )
 
@@ -22,6 +23,16 @@ func Register(rootCmd *cobra.Command, opts *root.Options) {
 	policiesCmd.AddCommand(newListPoliciesCmd(opts))
 	policiesCmd.AddCommand(newGetPolicyCmd(opts))
 
+	incidentsCmd := &cobra.Command{
+		Use:     "incidents",
+		Aliases: []string{"incident"},
+		Short:   "View alert incidents",
+	}
+
+	incidentsCmd.AddCommand(newListIncidentsCmd(opts))
+
 	alertsCmd.AddCommand(policiesCmd)
+	alertsCmd.AddCommand(incidentsCmd)
+	conditions.Register(alertsCmd, opts)
 	rootCmd.AddCommand(alertsCmd)
 }
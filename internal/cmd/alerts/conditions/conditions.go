@@ -0,0 +1,23 @@
+package conditions
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+// Register adds the conditions commands to the alerts command
+func Register(alertsCmd *cobra.Command, opts *root.Options) {
+	conditionsCmd := &cobra.Command{
+		Use:   "conditions",
+		Short: "Manage alert conditions",
+	}
+
+	conditionsCmd.AddCommand(newListConditionsCmd(opts))
+	conditionsCmd.AddCommand(newGetConditionCmd(opts))
+	conditionsCmd.AddCommand(newCreateConditionCmd(opts))
+	conditionsCmd.AddCommand(newDeleteConditionCmd(opts))
+	conditionsCmd.AddCommand(newTestConditionCmd(opts))
+
+	alertsCmd.AddCommand(conditionsCmd)
+}
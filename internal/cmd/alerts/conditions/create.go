@@ -0,0 +1,122 @@
+package conditions
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+// createConditionOptions holds options for the conditions create command
+type createConditionOptions struct {
+	*root.Options
+	policyID string
+	fromFile string
+}
+
+func newCreateConditionCmd(opts *root.Options) *cobra.Command {
+	createOpts := &createConditionOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a NRQL alert condition from a JSON file",
+		Long: `Create a static NRQL alert condition in a policy from a JSON file.
+
+The JSON file should contain the condition definition with the following structure:
+{
+  "name": "High Error Rate",
+  "enabled": true,
+  "nrql": {"query": "SELECT count(*) FROM TransactionError"},
+  "terms": [
+    {
+      "priority": "CRITICAL",
+      "operator": "ABOVE",
+      "threshold": 10,
+      "thresholdDuration": 300,
+      "thresholdOccurrences": "ALL"
+    }
+  ],
+  "signal": {"aggregationWindow": 60, "fillOption": "STATIC", "fillValue": 0},
+  "violationTimeLimitSeconds": 86400
+}
+
+Priorities: CRITICAL, WARNING
+Operators: ABOVE, ABOVE_OR_EQUALS, BELOW, BELOW_OR_EQUALS, EQUALS, NOT_EQUALS
+Fill options: NONE, LAST_VALUE, STATIC
+
+The condition is enabled unless "enabled" is set to false.`,
+		Example: `  # Create a condition in policy 111
+  nrq alerts conditions create --policy-id 111 --from-file condition.json
+
+  # Create and output result as JSON
+  nrq alerts conditions create --policy-id 111 --from-file condition.json -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCreateCondition(createOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&createOpts.policyID, "policy-id", "", "ID of the policy to add the condition to (required)")
+	cmd.Flags().StringVarP(&createOpts.fromFile, "from-file", "f", "", "Path to JSON file containing condition definition (required)")
+	_ = cmd.MarkFlagRequired("policy-id")
+	_ = cmd.MarkFlagRequired("from-file")
+
+	return cmd
+}
+
+func runCreateCondition(opts *createConditionOptions) error {
+	v := opts.View()
+
+	// Read and parse the JSON file
+	data, err := os.ReadFile(opts.fromFile)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var input api.AlertConditionInput
+	if err := json.Unmarshal(data, &input); err != nil {
+		return fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	// Validate required fields
+	if input.Name == "" {
+		return fmt.Errorf("condition name is required")
+	}
+	if input.NRQL.Query == "" {
+		return fmt.Errorf("condition NRQL query is required (nrql.query)")
+	}
+	if len(input.Terms) == 0 {
+		return fmt.Errorf("at least one threshold term is required")
+	}
+	if input.Enabled == nil {
+		enabled := true
+		input.Enabled = &enabled
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	condition, err := client.CreateAlertCondition(opts.policyID, &input)
+	if err != nil {
+		return fmt.Errorf("failed to create condition: %w", err)
+	}
+
+	switch v.Format {
+	case "json":
+		return v.JSON(condition)
+	case "plain":
+		rows := [][]string{
+			{condition.ID, condition.Name},
+		}
+		return v.Plain(rows)
+	default:
+		v.Success("Alert condition \"%s\" created", condition.Name)
+		v.Print("ID: %s\n", condition.ID)
+		return nil
+	}
+}
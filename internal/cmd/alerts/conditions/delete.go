@@ -0,0 +1,88 @@
+package conditions
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/confirm"
+)
+
+// deleteConditionOptions holds options for the conditions delete command
+type deleteConditionOptions struct {
+	*root.Options
+	policyID string
+	force    bool
+}
+
+func newDeleteConditionCmd(opts *root.Options) *cobra.Command {
+	deleteOpts := &deleteConditionOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "delete <condition-id>",
+		Short: "Delete an alert condition",
+		Long: `Delete an alert condition by its ID.
+
+By default, you will be prompted to confirm the deletion.
+Use --force to skip the confirmation prompt. With --policy-id, the
+condition is only deleted if it belongs to that policy.
+
+WARNING: This action cannot be undone.`,
+		Example: `  # Delete with confirmation
+  nrq alerts conditions delete 999
+
+  # Make sure the condition belongs to the expected policy
+  nrq alerts conditions delete 999 --policy-id 111
+
+  # Delete without confirmation (use with caution)
+  nrq alerts conditions delete 999 --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDeleteCondition(deleteOpts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&deleteOpts.policyID, "policy-id", "", "Only delete if the condition belongs to this policy")
+	root.AddForceFlag(cmd, &deleteOpts.force, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runDeleteCondition(opts *deleteConditionOptions, conditionID string) error {
+	v := opts.View()
+
+	// First, fetch the condition to show its name in the confirmation
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	condition, err := client.GetAlertCondition(conditionID)
+	if err != nil {
+		return fmt.Errorf("failed to get condition: %w", err)
+	}
+
+	if opts.policyID != "" && condition.PolicyID != opts.policyID {
+		return fmt.Errorf("condition %s belongs to policy %s, not %s", conditionID, condition.PolicyID, opts.policyID)
+	}
+
+	if !opts.force {
+		p := &confirm.Prompter{
+			In:  opts.Stdin,
+			Out: opts.Stderr,
+		}
+		msg := fmt.Sprintf("Delete condition \"%s\" (ID: %s)?", condition.Name, conditionID)
+		if !p.Confirm(msg) {
+			v.Warning("Operation canceled")
+			return nil
+		}
+	}
+
+	if err := client.DeleteAlertCondition(conditionID); err != nil {
+		return fmt.Errorf("failed to delete condition: %w", err)
+	}
+
+	v.Success("Alert condition \"%s\" deleted", condition.Name)
+	return nil
+}
@@ -0,0 +1,56 @@
+package conditions
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+func newGetConditionCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <condition-id>",
+		Short: "Get details for a specific alert condition",
+		Long:  `Get detailed information about a NRQL alert condition including its query and thresholds.`,
+		Example: `  nrq alerts conditions get 12345
+  nrq alerts conditions get 12345 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGetCondition(opts, args[0])
+		},
+	}
+}
+
+func runGetCondition(opts *root.Options, conditionID string) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	condition, err := client.GetAlertCondition(conditionID)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		return v.JSON(condition)
+	case "plain":
+		return v.Plain([][]string{
+			{condition.ID, condition.Name, condition.PolicyID, fmt.Sprintf("%t", condition.Enabled)},
+		})
+	default:
+		v.Print("ID:        %s\n", condition.ID)
+		v.Print("Name:      %s\n", condition.Name)
+		v.Print("Policy ID: %s\n", condition.PolicyID)
+		v.Print("Enabled:   %t\n", condition.Enabled)
+		v.Print("NRQL:      %s\n", condition.NRQL)
+		for _, t := range condition.Terms {
+			v.Print("Threshold: %s %s %g for %ds (%s)\n", t.Priority, t.Operator, t.Threshold, t.ThresholdDuration, t.ThresholdOccurrences)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,81 @@
+package conditions
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+type listConditionsOptions struct {
+	*root.Options
+	policyID string
+	limit    int
+}
+
+func newListConditionsCmd(opts *root.Options) *cobra.Command {
+	listOpts := &listConditionsOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the NRQL alert conditions in a policy",
+		Long: `List the NRQL alert conditions in an alert policy.
+
+The THRESHOLD column shows the critical threshold, if the condition has one.`,
+		Example: `  nrq alerts conditions list --policy-id 111
+  nrq alerts conditions list --policy-id 111 -o json
+  nrq alerts conditions list --policy-id 111 --limit 10`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runListConditions(listOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&listOpts.policyID, "policy-id", "", "ID of the policy to list conditions for (required)")
+	cmd.Flags().IntVarP(&listOpts.limit, "limit", "l", 0, "Limit number of results (0 = no limit)")
+	_ = cmd.MarkFlagRequired("policy-id")
+
+	return cmd
+}
+
+func runListConditions(opts *listConditionsOptions) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	conditions, err := client.GetAlertConditionsForPolicy(opts.policyID)
+	if err != nil {
+		return err
+	}
+
+	// Apply limit
+	if opts.limit > 0 && len(conditions) > opts.limit {
+		conditions = conditions[:opts.limit]
+	}
+
+	v := opts.View()
+
+	if len(conditions) == 0 {
+		v.Println("No alert conditions found")
+		return nil
+	}
+
+	headers := []string{"ID", "NAME", "ENABLED", "THRESHOLD"}
+	rows := make([][]string, len(conditions))
+	for i, c := range conditions {
+		threshold := ""
+		if term, ok := c.Term("CRITICAL"); ok {
+			threshold = fmt.Sprintf("%s %g", term.Operator, term.Threshold)
+		}
+		rows[i] = []string{
+			c.ID,
+			view.Truncate(c.Name, 50),
+			fmt.Sprintf("%t", c.Enabled),
+			threshold,
+		}
+	}
+
+	return v.Render(headers, rows, conditions)
+}
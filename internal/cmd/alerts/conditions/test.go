@@ -0,0 +1,274 @@
+package conditions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+type testConditionOptions struct {
+	*root.Options
+	simulateValue float64
+	thresholdType string
+}
+
+func newTestConditionCmd(opts *root.Options) *cobra.Command {
+	testOpts := &testConditionOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "test <condition-id>",
+		Short: "Check whether a NRQL condition would currently breach",
+		Long: `Check whether a NRQL alert condition would currently breach its threshold.
+
+Runs the condition's NRQL query over the threshold duration and compares the
+result against the condition's critical (or warning) threshold. Prints
+BREACHING and exits with code 1, or prints OK and exits with code 0.
+
+This is a local simulation - it does not open incidents or send notifications.
+Use --simulate-value to test the threshold against a value of your choosing
+instead of running the query.`,
+		Example: `  nrq alerts conditions test 12345
+  nrq alerts conditions test 12345 --threshold-type warning
+  nrq alerts conditions test 12345 --simulate-value 99.5`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTestCondition(testOpts, args[0], cmd.Flags().Changed("simulate-value"))
+		},
+	}
+
+	cmd.Flags().Float64Var(&testOpts.simulateValue, "simulate-value", 0, "Evaluate this value instead of running the condition's query")
+	cmd.Flags().StringVar(&testOpts.thresholdType, "threshold-type", "critical", "Threshold to test against: critical or warning")
+
+	return cmd
+}
+
+func runTestCondition(opts *testConditionOptions, conditionID string, simulate bool) error {
+	priority := strings.ToUpper(opts.thresholdType)
+	if priority != "CRITICAL" && priority != "WARNING" {
+		return fmt.Errorf("invalid --threshold-type %q: must be critical or warning", opts.thresholdType)
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	return testCondition(opts, client, conditionID, priority, simulate)
+}
+
+// facetValue is the condition's aggregate for one facet of the query
+// result. Facet is empty for a query without FACET.
+type facetValue struct {
+	Facet     string  `json:"facet,omitempty"`
+	Value     float64 `json:"value"`
+	Breaching bool    `json:"breaching"`
+}
+
+func testCondition(opts *testConditionOptions, client *api.Client, conditionID, priority string, simulate bool) error {
+	condition, err := client.GetAlertCondition(conditionID)
+	if err != nil {
+		return err
+	}
+
+	term, ok := condition.Term(priority)
+	if !ok {
+		return fmt.Errorf("condition %s has no %s threshold", conditionID, strings.ToLower(priority))
+	}
+
+	values := []facetValue{{Value: opts.simulateValue}}
+	if !simulate {
+		query := condition.NRQL
+		if term.ThresholdDuration > 0 && !api.ContainsNRQLClause(query, "SINCE") {
+			query += fmt.Sprintf(" SINCE %d seconds ago", term.ThresholdDuration)
+		}
+
+		result, err := client.QueryNRQL(query)
+		if err != nil {
+			return fmt.Errorf("failed to run condition query: %w", err)
+		}
+		if values, err = facetValues(result.Results); err != nil {
+			return err
+		}
+	}
+
+	// Every facet is a separate signal, so any one of them breaching
+	// breaches the condition
+	var breaching []string
+	for i := range values {
+		if values[i].Breaching, err = term.IsBreached(values[i].Value); err != nil {
+			return err
+		}
+		if values[i].Breaching {
+			breaching = append(breaching, values[i].Facet)
+		}
+	}
+
+	v := opts.View()
+
+	status := "OK"
+	if len(breaching) > 0 {
+		status = "BREACHING"
+	}
+	faceted := values[0].Facet != ""
+
+	switch v.Format {
+	case "json":
+		out := map[string]interface{}{
+			"conditionId": condition.ID,
+			"name":        condition.Name,
+			"priority":    term.Priority,
+			"operator":    term.Operator,
+			"threshold":   term.Threshold,
+			"status":      status,
+		}
+		if faceted {
+			out["facets"] = values
+		} else {
+			out["value"] = values[0].Value
+		}
+		if err := v.JSON(out); err != nil {
+			return err
+		}
+	case "plain":
+		v.Println(status)
+	default:
+		v.Print("Condition: %s (%s)\n", condition.Name, condition.ID)
+		v.Print("Threshold: %s %s %g\n", term.Priority, term.Operator, term.Threshold)
+		if faceted {
+			v.Print("Facets:    %d (%d breaching)\n", len(values), len(breaching))
+			for _, fv := range values {
+				if fv.Breaching {
+					v.Print("  %s: %g\n", fv.Facet, fv.Value)
+				}
+			}
+		} else {
+			v.Print("Value:     %g\n", values[0].Value)
+		}
+		v.Println(status)
+	}
+
+	switch {
+	case len(breaching) == 0:
+		return nil
+	case !faceted:
+		return fmt.Errorf("condition %s is breaching its %s threshold", conditionID, strings.ToLower(priority))
+	default:
+		return fmt.Errorf("condition %s is breaching its %s threshold for facet(s): %s", conditionID, strings.ToLower(priority), strings.Join(breaching, ", "))
+	}
+}
+
+// nrqlMetadataColumns are result columns that never hold the aggregate
+var nrqlMetadataColumns = map[string]bool{
+	"facet":            true,
+	"beginTimeSeconds": true,
+	"endTimeSeconds":   true,
+	"timestamp":        true,
+	"comparison":       true,
+}
+
+// facetValues returns the aggregate of each result row, labelled with the
+// row's facet. The aggregate column is chosen from the first row and must
+// be the only numeric column other than facet attributes.
+func facetValues(rows []map[string]interface{}) ([]facetValue, error) {
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("condition query returned no results")
+	}
+
+	column, err := aggregateColumn(rows[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var values []facetValue
+	for _, row := range rows {
+		// Facets without data in the window have a null aggregate
+		value, ok := numericValue(row[column])
+		if !ok {
+			continue
+		}
+		values = append(values, facetValue{Facet: facetLabel(row["facet"]), Value: value})
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("condition query returned no numeric value for %s", column)
+	}
+	return values, nil
+}
+
+// aggregateColumn returns the column of a result row holding the query's
+// aggregate: its only numeric column besides metadata columns and the
+// attributes the query is faceted by
+func aggregateColumn(row map[string]interface{}) (string, error) {
+	var candidates []string
+	for k, v := range row {
+		if nrqlMetadataColumns[k] {
+			continue
+		}
+		if _, ok := numericValue(v); ok || v == nil {
+			candidates = append(candidates, k)
+		}
+	}
+
+	// Numeric facet attributes repeat the row's facet value
+	if len(candidates) > 1 {
+		facets := map[string]bool{}
+		for _, f := range strings.Split(facetLabel(row["facet"]), ", ") {
+			facets[f] = true
+		}
+		kept := candidates[:0]
+		for _, k := range candidates {
+			if row[k] == nil || !facets[fmt.Sprint(row[k])] {
+				kept = append(kept, k)
+			}
+		}
+		candidates = kept
+	}
+	sort.Strings(candidates)
+
+	switch len(candidates) {
+	case 1:
+		return candidates[0], nil
+	case 0:
+		return "", fmt.Errorf("condition query returned no numeric value")
+	default:
+		return "", fmt.Errorf("condition query returned several values (%s); a condition query must select a single aggregate", strings.Join(candidates, ", "))
+	}
+}
+
+// numericValue returns a result value as a number. Functions such as
+// percentile() return an object holding a single number.
+func numericValue(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case map[string]interface{}:
+		if len(n) == 1 {
+			for _, inner := range n {
+				f, ok := inner.(float64)
+				return f, ok
+			}
+		}
+	}
+	return 0, false
+}
+
+// facetLabel formats a row's facet value; multi-attribute facets are
+// joined with commas
+func facetLabel(facet interface{}) string {
+	switch f := facet.(type) {
+	case nil:
+		return ""
+	case []interface{}:
+		parts := make([]string, len(f))
+		for i, part := range f {
+			parts[i] = fmt.Sprint(part)
+		}
+		return strings.Join(parts, ", ")
+	default:
+		return fmt.Sprint(f)
+	}
+}
@@ -0,0 +1,154 @@
+package conditions
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+// conditionResponse is a NerdGraph condition whose critical threshold
+// breaches above 5 for 300 seconds
+const conditionResponse = `{"data": {"actor": {"account": {"alerts": {"nrqlCondition": {
+	"id": "101",
+	"name": "High error rate",
+	"enabled": true,
+	"policyId": "1",
+	"nrql": {"query": "SELECT count(*) FROM TransactionError FACET appName"},
+	"terms": [{"operator": "ABOVE", "priority": "CRITICAL", "threshold": 5, "thresholdDuration": 300, "thresholdOccurrences": "ALL"}]
+}}}}}}`
+
+// newConditionTest returns options writing to a buffer and a client whose
+// NerdGraph answers the condition lookup and then the NRQL query with results
+func newConditionTest(t *testing.T, results string) (*testConditionOptions, *api.Client, *bytes.Buffer, *string) {
+	t.Helper()
+
+	var nrql string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(req.Query, "nrqlCondition") {
+			_, _ = w.Write([]byte(conditionResponse))
+			return
+		}
+		nrql, _ = req.Variables["nrql"].(string)
+		_, _ = w.Write([]byte(`{"data": {"actor": {"account": {"nrql": {"results": ` + results + `}}}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := api.NewWithConfig(api.ClientConfig{APIKey: "NRAK-TEST", AccountID: "12345"})
+	client.NerdGraphURL = server.URL
+
+	var out bytes.Buffer
+	opts := root.DefaultOptions()
+	opts.Stdout = &out
+	opts.NoColor = true
+
+	return &testConditionOptions{Options: opts, thresholdType: "critical"}, client, &out, &nrql
+}
+
+func TestTestCondition_OK(t *testing.T) {
+	opts, client, out, nrql := newConditionTest(t, `[
+		{"facet": "checkout", "appName": "checkout", "count": 2},
+		{"facet": "payments", "appName": "payments", "count": 4}
+	]`)
+
+	require.NoError(t, testCondition(opts, client, "101", "CRITICAL", false))
+
+	assert.Equal(t, "SELECT count(*) FROM TransactionError FACET appName SINCE 300 seconds ago", *nrql)
+	assert.Contains(t, out.String(), "Facets:    2 (0 breaching)")
+	assert.Contains(t, out.String(), "OK")
+}
+
+func TestTestCondition_BreachingFacet(t *testing.T) {
+	// Only the second facet breaches; the first must not hide it
+	opts, client, out, _ := newConditionTest(t, `[
+		{"facet": "checkout", "appName": "checkout", "count": 2},
+		{"facet": "payments", "appName": "payments", "count": 9}
+	]`)
+
+	err := testCondition(opts, client, "101", "CRITICAL", false)
+
+	require.EqualError(t, err, "condition 101 is breaching its critical threshold for facet(s): payments")
+	assert.Contains(t, out.String(), "Facets:    2 (1 breaching)")
+	assert.Contains(t, out.String(), "  payments: 9")
+	assert.Contains(t, out.String(), "BREACHING")
+}
+
+func TestTestCondition_JSON(t *testing.T) {
+	opts, client, out, _ := newConditionTest(t, `[
+		{"facet": "checkout", "appName": "checkout", "count": 2},
+		{"facet": "payments", "appName": "payments", "count": 9}
+	]`)
+	opts.Output = "json"
+
+	require.Error(t, testCondition(opts, client, "101", "CRITICAL", false))
+
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &got))
+	assert.Equal(t, "BREACHING", got["status"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"facet": "checkout", "value": float64(2), "breaching": false},
+		map[string]interface{}{"facet": "payments", "value": float64(9), "breaching": true},
+	}, got["facets"])
+}
+
+func TestTestCondition_SimulateValue(t *testing.T) {
+	opts, client, out, nrql := newConditionTest(t, `[]`)
+	opts.simulateValue = 6
+
+	err := testCondition(opts, client, "101", "CRITICAL", true)
+
+	require.EqualError(t, err, "condition 101 is breaching its critical threshold")
+	assert.Empty(t, *nrql, "the query should not run")
+	assert.Contains(t, out.String(), "Value:     6")
+}
+
+func TestAggregateColumn(t *testing.T) {
+	tests := []struct {
+		name    string
+		row     map[string]interface{}
+		want    string
+		wantErr string
+	}{
+		{"single", map[string]interface{}{"count": 3.0}, "count", ""},
+		{"timeseries", map[string]interface{}{"beginTimeSeconds": 1.0, "endTimeSeconds": 2.0, "average.duration": 0.5}, "average.duration", ""},
+		{"string facet", map[string]interface{}{"facet": "web", "appName": "web", "count": 3.0}, "count", ""},
+		{"numeric facet", map[string]interface{}{"facet": "500", "httpResponseCode": 500.0, "count": 3.0}, "count", ""},
+		{"percentile", map[string]interface{}{"percentile.duration": map[string]interface{}{"95": 1.5}}, "percentile.duration", ""},
+		{"null aggregate", map[string]interface{}{"facet": "web", "appName": "web", "average.duration": nil}, "average.duration", ""},
+		{"no number", map[string]interface{}{"appName": "web"}, "", "no numeric value"},
+		{"several", map[string]interface{}{"count": 1.0, "sum.duration": 2.0}, "", "several values (count, sum.duration)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := aggregateColumn(tt.row)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFacetLabel(t *testing.T) {
+	assert.Equal(t, "", facetLabel(nil))
+	assert.Equal(t, "web", facetLabel("web"))
+	assert.Equal(t, "web, 500", facetLabel([]interface{}{"web", 500.0}))
+}
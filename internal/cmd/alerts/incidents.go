@@ -0,0 +1,105 @@
+package alerts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+type listIncidentsOptions struct {
+	*root.Options
+	policyID string
+	priority string
+	limit    int
+}
+
+func newListIncidentsCmd(opts *root.Options) *cobra.Command {
+	listOpts := &listIncidentsOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List open alert incidents",
+		Long: `List the open alert incidents in your account.
+
+Without --policy-id, open incidents from every policy are shown.`,
+		Example: `  nrq alerts incidents list
+  nrq alerts incidents list --policy-id 111
+  nrq alerts incidents list --priority critical -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runListIncidents(listOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&listOpts.policyID, "policy-id", "", "Only show incidents for this policy")
+	cmd.Flags().StringVar(&listOpts.priority, "priority", "", "Only show incidents with this priority (critical, high, medium, low)")
+	cmd.Flags().IntVarP(&listOpts.limit, "limit", "l", 0, "Limit number of results (0 = no limit)")
+
+	return cmd
+}
+
+func runListIncidents(opts *listIncidentsOptions) error {
+	priority := strings.ToUpper(opts.priority)
+	switch priority {
+	case "", "CRITICAL", "HIGH", "MEDIUM", "LOW":
+	default:
+		return fmt.Errorf("invalid --priority %q: must be critical, high, medium, or low", opts.priority)
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	incidents, err := client.ListAlertIncidents(opts.policyID)
+	if err != nil {
+		return err
+	}
+
+	incidents = filterIncidentsByPriority(incidents, priority)
+
+	// Apply limit
+	if opts.limit > 0 && len(incidents) > opts.limit {
+		incidents = incidents[:opts.limit]
+	}
+
+	v := opts.View()
+
+	if len(incidents) == 0 {
+		v.Println("No open incidents found")
+		return nil
+	}
+
+	headers := []string{"ID", "TITLE", "PRIORITY", "DESCRIPTION"}
+	rows := make([][]string, len(incidents))
+	for i, inc := range incidents {
+		rows[i] = []string{
+			inc.IncidentID,
+			view.Truncate(inc.Title, 50),
+			inc.Priority,
+			view.Truncate(inc.Description, 60),
+		}
+	}
+
+	return v.Render(headers, rows, incidents)
+}
+
+// filterIncidentsByPriority keeps incidents with the given upper-case
+// priority; an empty priority keeps them all
+func filterIncidentsByPriority(incidents []api.AlertIncident, priority string) []api.AlertIncident {
+	if priority == "" {
+		return incidents
+	}
+
+	filtered := make([]api.AlertIncident, 0, len(incidents))
+	for _, inc := range incidents {
+		if strings.EqualFold(inc.Priority, priority) {
+			filtered = append(filtered, inc)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,25 @@
+package alerts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+)
+
+func TestFilterIncidentsByPriority(t *testing.T) {
+	incidents := []api.AlertIncident{
+		{IncidentID: "1", Priority: "CRITICAL"},
+		{IncidentID: "2", Priority: "HIGH"},
+		{IncidentID: "3", Priority: "CRITICAL"},
+	}
+
+	assert.Len(t, filterIncidentsByPriority(incidents, ""), 3)
+
+	critical := filterIncidentsByPriority(incidents, "CRITICAL")
+	assert.Len(t, critical, 2)
+	assert.Equal(t, "3", critical[1].IncidentID)
+
+	assert.Empty(t, filterIncidentsByPriority(incidents, "LOW"))
+}
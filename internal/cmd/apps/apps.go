@@ -15,8 +15,12 @@ func Register(rootCmd *cobra.Command, opts *root.Options) {
 	}
 
 	appsCmd.AddCommand(newListCmd(opts))
+	appsCmd.AddCommand(newSearchCmd(opts))
 	appsCmd.AddCommand(newGetCmd(opts))
+	appsCmd.AddCommand(newHealthCmd(opts))
 	appsCmd.AddCommand(newMetricsCmd(opts))
+	appsCmd.AddCommand(newHostsCmd(opts))
+	appsCmd.AddCommand(newLabelsCmd(opts))
 
 	rootCmd.AddCommand(appsCmd)
 }
@@ -10,12 +10,16 @@ import (
 
 func newGetCmd(opts *root.Options) *cobra.Command {
 	return &cobra.Command{
-		Use:   "get <app-id>",
+		Use:   "get <app-id-or-name>",
 		Short: "Get details for a specific application",
 		Long: `Get detailed information about a specific APM application.
 
+The application can be given as a numeric app ID, an entity GUID, or an
+exact application name.
+
 Displays ID, name, language, health status, reporting status, and last reported time.`,
 		Example: `  nrq apps get 12345678
+  nrq apps get "checkout-service"
   nrq apps get 12345678 -o json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -24,12 +28,17 @@ Displays ID, name, language, health status, reporting status, and last reported
 	}
 }
 
-func runGet(opts *root.Options, appID string) error {
+func runGet(opts *root.Options, identifier string) error {
 	client, err := opts.APIClient()
 	if err != nil {
 		return err
 	}
 
+	appID, err := client.ResolveAppID(identifier)
+	if err != nil {
+		return fmt.Errorf("failed to resolve application: %w", err)
+	}
+
 	app, err := client.GetApplication(appID)
 	if err != nil {
 		return err
@@ -42,7 +51,7 @@ func runGet(opts *root.Options, appID string) error {
 		return v.JSON(app)
 	case "plain":
 		return v.Plain([][]string{
-			{fmt.Sprintf("%d", app.ID), app.Name, app.Language, app.HealthStatus},
+			{fmt.Sprintf("%d", app.ID), app.Name, app.Language, app.HealthStatus, fmt.Sprintf("%t", app.Reporting), app.LastReportedAt},
 		})
 	default:
 		v.Print("ID:              %d\n", app.ID)
@@ -0,0 +1,99 @@
+package apps
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+func newHealthCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "health <app-id-or-name>",
+		Short: "Show an application's health",
+		Long: `Show an application's alert severity and golden metrics: error rate,
+average response time and throughput.
+
+The application can be given as a numeric app ID, an entity GUID, or an
+exact application name. It must belong to the configured account.
+
+Alert severities:
+  NOT_ALERTING:   No open incidents
+  WARNING:        A warning threshold is breached
+  CRITICAL:       A critical threshold is breached
+  NOT_CONFIGURED: No alert conditions cover the application`,
+		Example: `  nrq apps health 12345678
+  nrq apps health "checkout-service"
+  nrq apps health 12345678 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHealth(opts, args[0])
+		},
+	}
+}
+
+func runHealth(opts *root.Options, identifier string) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	appID, err := client.ResolveAppID(identifier)
+	if err != nil {
+		return fmt.Errorf("failed to resolve application: %w", err)
+	}
+
+	health, err := client.GetApplicationHealthStatus(appID)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		return v.JSON(health)
+	case "plain":
+		return v.Plain([][]string{{
+			appID,
+			health.Name,
+			health.AlertSeverity,
+			fmt.Sprintf("%t", health.Reporting),
+			fmt.Sprintf("%g", health.ErrorRate),
+			fmt.Sprintf("%g", health.ResponseTime),
+			fmt.Sprintf("%g", health.Throughput),
+		}})
+	default:
+		v.Print("Name:           %s\n", health.Name)
+		v.Print("App ID:         %s\n", appID)
+		v.Print("Alert Severity: %s\n", v.HealthStatus(health.AlertSeverity))
+		if !health.Reporting {
+			v.Println("Reporting:      false")
+			v.Println(v.Dim("No recent metrics: the application is not reporting"))
+			return nil
+		}
+		v.Print("Error Rate:     %s\n", formatErrorRate(health.ErrorRate))
+		v.Print("Response Time:  %s\n", formatResponseTime(health.ResponseTime))
+		v.Print("Throughput:     %s\n", formatThroughput(health.Throughput))
+		return nil
+	}
+}
+
+// formatErrorRate renders a fraction of failed transactions as a percentage
+func formatErrorRate(rate float64) string {
+	return fmt.Sprintf("%.2f%%", rate*100)
+}
+
+// formatResponseTime renders seconds as milliseconds below one second
+func formatResponseTime(seconds float64) string {
+	if seconds < 1 {
+		return fmt.Sprintf("%.0f ms", seconds*1000)
+	}
+	return fmt.Sprintf("%.2f s", seconds)
+}
+
+// formatThroughput renders requests per minute
+func formatThroughput(rpm float64) string {
+	return fmt.Sprintf("%.1f rpm", rpm)
+}
@@ -0,0 +1,21 @@
+package apps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatErrorRate(t *testing.T) {
+	assert.Equal(t, "1.20%", formatErrorRate(0.012))
+	assert.Equal(t, "0.00%", formatErrorRate(0))
+}
+
+func TestFormatResponseTime(t *testing.T) {
+	assert.Equal(t, "245 ms", formatResponseTime(0.245))
+	assert.Equal(t, "1.50 s", formatResponseTime(1.5))
+}
+
+func TestFormatThroughput(t *testing.T) {
+	assert.Equal(t, "1520.5 rpm", formatThroughput(1520.5))
+}
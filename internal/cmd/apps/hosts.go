@@ -0,0 +1,136 @@
+package apps
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+func newHostsCmd(opts *root.Options) *cobra.Command {
+	hostsCmd := &cobra.Command{
+		Use:     "hosts",
+		Aliases: []string{"host"},
+		Short:   "View the hosts an application runs on",
+	}
+
+	hostsCmd.AddCommand(newHostsListCmd(opts))
+	hostsCmd.AddCommand(newHostsGetCmd(opts))
+
+	return hostsCmd
+}
+
+type hostsListOptions struct {
+	*root.Options
+	search       string
+	healthStatus string
+}
+
+func newHostsListCmd(opts *root.Options) *cobra.Command {
+	listOpts := &hostsListOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "list <app-id>",
+		Short: "List hosts for an application",
+		Long: `List the hosts an APM application runs on.
+
+Displays host ID, hostname, port, health status, language, and agent version.`,
+		Example: `  nrq apps hosts list 12345678
+  nrq apps hosts list 12345678 --search web-prod
+  nrq apps hosts list 12345678 --health-status red
+  nrq apps hosts list 12345678 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHostsList(listOpts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&listOpts.search, "search", "", "Only show hosts whose hostname contains this text")
+	cmd.Flags().StringVar(&listOpts.healthStatus, "health-status", "", "Only show hosts with this health status (green, orange, red, gray)")
+
+	return cmd
+}
+
+func runHostsList(opts *hostsListOptions, appID string) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	hosts, err := client.ListApplicationHosts(appID)
+	if err != nil {
+		return err
+	}
+
+	hosts = api.FilterApplicationHosts(hosts, opts.search, opts.healthStatus)
+
+	v := opts.View()
+
+	if len(hosts) == 0 {
+		v.Println("No hosts found")
+		return nil
+	}
+
+	headers := []string{"ID", "HOST", "PORT", "HEALTH", "LANGUAGE", "AGENT VERSION"}
+	rows := make([][]string, len(hosts))
+	for i, h := range hosts {
+		rows[i] = []string{
+			fmt.Sprintf("%d", h.ID),
+			view.Truncate(h.Host, 40),
+			fmt.Sprintf("%d", h.Port),
+			v.HealthStatus(h.HealthStatus),
+			h.Language,
+			h.AgentVersion,
+		}
+	}
+
+	return v.Render(headers, rows, hosts)
+}
+
+func newHostsGetCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <app-id> <host-id>",
+		Short: "Get details for an application host",
+		Example: `  nrq apps hosts get 12345678 5001
+  nrq apps hosts get 12345678 5001 -o json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHostsGet(opts, args[0], args[1])
+		},
+	}
+}
+
+func runHostsGet(opts *root.Options, appID, hostID string) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	host, err := client.GetApplicationHost(appID, hostID)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		return v.JSON(host)
+	case "plain":
+		return v.Plain([][]string{
+			{fmt.Sprintf("%d", host.ID), host.Host, host.HealthStatus},
+		})
+	default:
+		v.Print("ID:              %d\n", host.ID)
+		v.Print("Application:     %s\n", host.ApplicationName)
+		v.Print("Host:            %s\n", host.Host)
+		v.Print("Port:            %d\n", host.Port)
+		v.Print("Health Status:   %s\n", v.HealthStatus(host.HealthStatus))
+		v.Print("Language:        %s\n", host.Language)
+		v.Print("Agent Version:   %s\n", host.AgentVersion)
+		return nil
+	}
+}
@@ -0,0 +1,185 @@
+package apps
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/confirm"
+)
+
+func newLabelsCmd(opts *root.Options) *cobra.Command {
+	labelsCmd := &cobra.Command{
+		Use:     "labels",
+		Aliases: []string{"label"},
+		Short:   "View and manage application labels",
+	}
+
+	labelsCmd.AddCommand(newLabelsListCmd(opts))
+	labelsCmd.AddCommand(newLabelsAddCmd(opts))
+	labelsCmd.AddCommand(newLabelsRemoveCmd(opts))
+
+	return labelsCmd
+}
+
+func newLabelsListCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <app-id>",
+		Short: "List the labels of an application",
+		Example: `  nrq apps labels list 12345678
+  nrq apps labels list 12345678 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLabelsList(opts, args[0])
+		},
+	}
+}
+
+func runLabelsList(opts *root.Options, appID string) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	labels, err := client.ListAppLabels(appID)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	if len(labels) == 0 {
+		v.Println("No labels found")
+		return nil
+	}
+
+	headers := []string{"CATEGORY", "NAME"}
+	rows := make([][]string, len(labels))
+	for i, l := range labels {
+		rows[i] = []string{l.Category, l.Name}
+	}
+
+	return v.Render(headers, rows, labels)
+}
+
+// labelsAddOptions holds options for the labels add command
+type labelsAddOptions struct {
+	*root.Options
+	label string
+}
+
+func newLabelsAddCmd(opts *root.Options) *cobra.Command {
+	addOpts := &labelsAddOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "add <app-id>",
+		Short: "Add a label to an application",
+		Long: `Add a label to an application. The label is a key:value pair, where the
+key is the label category (e.g., env) and the value is its name (e.g., prod).`,
+		Example: `  nrq apps labels add 12345678 --label env:prod
+  nrq apps labels add 12345678 --label team:backend`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLabelsAdd(addOpts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&addOpts.label, "label", "", "Label as key:value (required)")
+	_ = cmd.MarkFlagRequired("label")
+
+	return cmd
+}
+
+func runLabelsAdd(opts *labelsAddOptions, appID string) error {
+	key, value, err := parseLabel(opts.label)
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.AddAppLabel(appID, key, value); err != nil {
+		return err
+	}
+
+	opts.View().Success("Added label %s:%s to application %s", key, value, appID)
+	return nil
+}
+
+// labelsRemoveOptions holds options for the labels remove command
+type labelsRemoveOptions struct {
+	*root.Options
+	key   string
+	force bool
+}
+
+func newLabelsRemoveCmd(opts *root.Options) *cobra.Command {
+	removeOpts := &labelsRemoveOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "remove <app-id>",
+		Short: "Remove a label from an application",
+		Long: `Remove a label from an application by key (its category).
+Requires confirmation unless --force is specified.`,
+		Example: `  nrq apps labels remove 12345678 --label env
+  nrq apps labels remove 12345678 --label env --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLabelsRemove(removeOpts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&removeOpts.key, "label", "", "Label key to remove (required)")
+	root.AddForceFlag(cmd, &removeOpts.force, "Skip confirmation prompt")
+	_ = cmd.MarkFlagRequired("label")
+
+	return cmd
+}
+
+func runLabelsRemove(opts *labelsRemoveOptions, appID string) error {
+	key := strings.TrimSpace(opts.key)
+	if key == "" {
+		return fmt.Errorf("label key cannot be empty")
+	}
+
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	if !opts.force {
+		p := &confirm.Prompter{
+			In:  opts.Stdin,
+			Out: opts.Stderr,
+		}
+		if !p.Confirm(fmt.Sprintf("Remove label %s from application %s?", key, appID)) {
+			v.Warning("Operation canceled")
+			return nil
+		}
+	}
+
+	if err := client.RemoveAppLabel(appID, key); err != nil {
+		return err
+	}
+
+	v.Success("Removed label %s from application %s", key, appID)
+	return nil
+}
+
+// parseLabel splits a key:value label flag
+func parseLabel(label string) (string, string, error) {
+	key, value, ok := strings.Cut(label, ":")
+	key = strings.TrimSpace(key)
+	value = strings.TrimSpace(value)
+	if !ok || key == "" || value == "" {
+		return "", "", fmt.Errorf("invalid --label %q: expected key:value", label)
+	}
+	return key, value, nil
+}
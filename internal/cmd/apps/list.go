@@ -5,13 +5,18 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/newrelic-cli/api"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
 	"github.com/open-cli-collective/newrelic-cli/internal/view"
 )
 
 type listOptions struct {
 	*root.Options
-	limit int
+	limit      int
+	fullOutput bool
+	sort       string
+	sortDesc   bool
+	filter     string
 }
 
 func newListCmd(opts *root.Options) *cobra.Command {
@@ -34,13 +39,28 @@ Health status values: green (healthy), orange (warning), red (critical), gray (n
   nrq apps list -o plain | cut -f1  # Get app IDs only
 
   # Limit results
-  nrq apps list --limit 5`,
+  nrq apps list --limit 5
+
+  # Sort by a column
+  nrq apps list --sort name
+  nrq apps list --sort status --sort-desc
+
+  # Only show matching rows
+  nrq apps list --filter "status=red"
+  nrq apps list --filter "name~checkout"
+
+  # Show all fields, including entity GUID
+  nrq apps list --full`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runList(listOpts)
 		},
 	}
 
 	cmd.Flags().IntVarP(&listOpts.limit, "limit", "l", 0, "Limit number of results (0 = no limit)")
+	cmd.Flags().BoolVar(&listOpts.fullOutput, "full", false, "Show all fields, including entity GUID (requires an extra lookup)")
+	cmd.Flags().StringVar(&listOpts.sort, "sort", "", "Sort rows by this column (e.g., name, status)")
+	cmd.Flags().BoolVar(&listOpts.sortDesc, "sort-desc", false, "Sort in descending order")
+	cmd.Flags().StringVar(&listOpts.filter, "filter", "", "Only show rows matching COLUMN=VALUE (exact) or COLUMN~VALUE (contains)")
 
 	return cmd
 }
@@ -56,11 +76,6 @@ func runList(opts *listOptions) error {
 		return err
 	}
 
-	// Apply limit
-	if opts.limit > 0 && len(apps) > opts.limit {
-		apps = apps[:opts.limit]
-	}
-
 	v := opts.View()
 
 	if len(apps) == 0 {
@@ -68,6 +83,37 @@ func runList(opts *listOptions) error {
 		return nil
 	}
 
+	var headers []string
+	var rows [][]string
+	if opts.fullOutput {
+		if err := resolveGUIDs(client, apps); err != nil {
+			return err
+		}
+		headers, rows = fullRows(apps)
+	} else {
+		headers, rows = summaryRows(apps)
+	}
+
+	apps, rows, err = view.FilterSort(apps, rows, headers, opts.filter, opts.sort, opts.sortDesc)
+	if err != nil {
+		return err
+	}
+
+	// Apply limit
+	if opts.limit > 0 {
+		if len(apps) > opts.limit {
+			apps = apps[:opts.limit]
+		}
+		if len(rows) > opts.limit {
+			rows = rows[:opts.limit]
+		}
+	}
+
+	return v.Render(headers, rows, apps)
+}
+
+// summaryRows returns the default columns for applications
+func summaryRows(apps []api.Application) ([]string, [][]string) {
 	headers := []string{"ID", "NAME", "LANGUAGE", "STATUS"}
 	rows := make([][]string, len(apps))
 	for i, app := range apps {
@@ -82,6 +128,44 @@ func runList(opts *listOptions) error {
 			status,
 		}
 	}
+	return headers, rows
+}
 
-	return v.Render(headers, rows, apps)
+// resolveGUIDs fills in the entity GUID for each application using a single
+// entity search, since the REST applications endpoint does not return GUIDs
+func resolveGUIDs(client *api.Client, apps []api.Application) error {
+	entities, err := client.SearchEntities("domain = 'APM' AND type = 'APPLICATION'")
+	if err != nil {
+		return fmt.Errorf("failed to resolve application GUIDs: %w", err)
+	}
+
+	guids := make(map[string]api.EntityGUID, len(entities))
+	for _, e := range entities {
+		if appID, err := e.GUID.AppID(); err == nil {
+			guids[appID] = e.GUID
+		}
+	}
+
+	for i := range apps {
+		apps[i].GUID = guids[fmt.Sprintf("%d", apps[i].ID)]
+	}
+	return nil
+}
+
+// fullRows returns the extended column set for applications
+func fullRows(apps []api.Application) ([]string, [][]string) {
+	headers := []string{"ID", "NAME", "LANGUAGE", "HEALTH_STATUS", "REPORTING", "LAST_REPORTED", "GUID"}
+	rows := make([][]string, len(apps))
+	for i, app := range apps {
+		rows[i] = []string{
+			fmt.Sprintf("%d", app.ID),
+			app.Name,
+			app.Language,
+			app.HealthStatus,
+			fmt.Sprintf("%t", app.Reporting),
+			app.LastReportedAt,
+			app.GUID.String(),
+		}
+	}
+	return headers, rows
 }
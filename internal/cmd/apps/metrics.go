@@ -2,21 +2,26 @@ package apps
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/newrelic-cli/api"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
 )
 
 func newMetricsCmd(opts *root.Options) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "metrics <app-id>",
 		Short: "List available metrics for an application",
 		Long: `List all available metric names for an APM application.
 
 Metric names follow the format: Category/Name (e.g., Apdex, HttpDispatcher,
-WebTransaction/Function/handler). Use these names with the Metric API or
-in NRQL queries with FROM Metric.`,
+WebTransaction/Function/handler). Use these names with 'apps metrics get',
+the Metric API, or in NRQL queries with FROM Metric.`,
 		Example: `  nrq apps metrics 12345678
   nrq apps metrics 12345678 -o json`,
 		Args: cobra.ExactArgs(1),
@@ -24,6 +29,10 @@ in NRQL queries with FROM Metric.`,
 			return runMetrics(opts, args[0])
 		},
 	}
+
+	cmd.AddCommand(newMetricsGetCmd(opts))
+
+	return cmd
 }
 
 func runMetrics(opts *root.Options, appID string) error {
@@ -61,3 +70,141 @@ func runMetrics(opts *root.Options, appID string) error {
 		return nil
 	}
 }
+
+// metricsGetOptions holds options for the metrics get command
+type metricsGetOptions struct {
+	*root.Options
+	metric string
+	values []string
+	since  string
+	until  string
+}
+
+func newMetricsGetCmd(opts *root.Options) *cobra.Command {
+	getOpts := &metricsGetOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "get <app-id>",
+		Short: "Get metric data for an application",
+		Long: `Get the values of an application metric over time.
+
+Use 'nrq apps metrics <app-id>' to list metric names and the values each
+provides. Without --values, all values of the metric are returned.
+
+--since and --until accept relative times ('1 hour ago'), dates
+('2025-01-15'), RFC3339 timestamps, or 'now'. Without them, the last 30
+minutes are returned.`,
+		Example: `  nrq apps metrics get 12345678 --metric HttpDispatcher
+  nrq apps metrics get 12345678 --metric HttpDispatcher --values call_count,average_response_time --since "1 hour ago"
+  nrq apps metrics get 12345678 --metric Apdex --since "2025-01-15" --until "2025-01-16" -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMetricsGet(getOpts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&getOpts.metric, "metric", "", "Metric name, e.g. HttpDispatcher (required)")
+	cmd.Flags().StringSliceVar(&getOpts.values, "values", nil, "Comma-separated value names, e.g. call_count,average_response_time")
+	cmd.Flags().StringVar(&getOpts.since, "since", "", "Start time (e.g., '1 hour ago', '2025-01-15')")
+	cmd.Flags().StringVar(&getOpts.until, "until", "", "End time (e.g., 'now', '2025-01-16')")
+	_ = cmd.MarkFlagRequired("metric")
+
+	return cmd
+}
+
+func runMetricsGet(opts *metricsGetOptions, appID string) error {
+	query := api.MetricDataQuery{
+		Names:  []string{opts.metric},
+		Values: opts.values,
+	}
+
+	var err error
+	if opts.since != "" {
+		query.From, err = api.ParseFlexibleTime(opts.since)
+		if err != nil {
+			return fmt.Errorf("invalid --since value: %w", err)
+		}
+	}
+	if opts.until != "" {
+		query.To, err = api.ParseFlexibleTime(opts.until)
+		if err != nil {
+			return fmt.Errorf("invalid --until value: %w", err)
+		}
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetMetricData(appID, query)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	if v.Format == "json" {
+		return v.JSON(data)
+	}
+
+	if len(data.Metrics) == 0 {
+		v.Println("No data found for metric " + opts.metric)
+		return nil
+	}
+
+	timeslices := data.Metrics[0].Timeslices
+	names := metricValueNames(timeslices, opts.values)
+
+	headers := []string{"TIMESTAMP"}
+	for _, name := range names {
+		headers = append(headers, strings.ToUpper(name))
+	}
+
+	rows := make([][]string, len(timeslices))
+	for i, ts := range timeslices {
+		row := []string{formatTimesliceTime(ts.From)}
+		for _, name := range names {
+			value, ok := ts.Values[name]
+			if !ok {
+				row = append(row, "")
+				continue
+			}
+			row = append(row, strconv.FormatFloat(value, 'f', -1, 64))
+		}
+		rows[i] = row
+	}
+
+	return v.Render(headers, rows, data)
+}
+
+// metricValueNames returns the value columns to show: the requested values
+// in order, or every value present in the timeslices, sorted
+func metricValueNames(timeslices []api.MetricTimeslice, requested []string) []string {
+	if len(requested) > 0 {
+		return requested
+	}
+
+	seen := map[string]bool{}
+	var names []string
+	for _, ts := range timeslices {
+		for name := range ts.Values {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// formatTimesliceTime formats a timeslice start time for display, keeping
+// the original string if it cannot be parsed
+func formatTimesliceTime(s string) string {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return s
+	}
+	return t.Local().Format("2006-01-02 15:04:05")
+}
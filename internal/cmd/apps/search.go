@@ -0,0 +1,148 @@
+package apps
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+// healthStatuses maps accepted --health-status values to New Relic's; the
+// API reports warnings as orange, but yellow is accepted too
+var healthStatuses = map[string]string{
+	"green":  "green",
+	"orange": "orange",
+	"yellow": "orange",
+	"red":    "red",
+	"gray":   "gray",
+}
+
+type searchOptions struct {
+	*root.Options
+	language     string
+	healthStatus string
+	reporting    bool
+	limit        int
+}
+
+func newSearchCmd(opts *root.Options) *cobra.Command {
+	searchOpts := &searchOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "search <name-pattern>",
+		Short: "Search APM applications by name",
+		Long: `Search APM applications by name.
+
+A pattern without wildcards matches any application whose name contains it,
+ignoring case. Use * as a wildcard to match the whole name instead, e.g.
+"prod-*" for names starting with "prod-".
+
+--language is passed to the API as a filter. --health-status and --reporting
+are applied to the results.`,
+		Example: `  # Names containing "checkout"
+  nrq apps search checkout
+
+  # Names starting with "prod-"
+  nrq apps search "prod-*"
+
+  # Java applications that are reporting and unhealthy
+  nrq apps search api --language java --health-status red --reporting`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSearch(searchOpts, args[0], cmd.Flags().Changed("reporting"))
+		},
+	}
+
+	cmd.Flags().StringVar(&searchOpts.language, "language", "", "Only applications in this language (e.g., java, ruby, nodejs)")
+	cmd.Flags().StringVar(&searchOpts.healthStatus, "health-status", "", "Only applications with this health status: green, yellow, red, or gray")
+	cmd.Flags().BoolVar(&searchOpts.reporting, "reporting", false, "Only reporting applications (--reporting=false for only non-reporting)")
+	cmd.Flags().IntVarP(&searchOpts.limit, "limit", "l", 0, "Limit number of results (0 = no limit)")
+
+	return cmd
+}
+
+func runSearch(opts *searchOptions, pattern string, filterReporting bool) error {
+	var health string
+	if opts.healthStatus != "" {
+		var ok bool
+		health, ok = healthStatuses[strings.ToLower(opts.healthStatus)]
+		if !ok {
+			return fmt.Errorf("invalid --health-status %q: must be green, yellow, red, or gray", opts.healthStatus)
+		}
+	}
+
+	match := namePattern(pattern)
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	apps, err := client.SearchApplications(map[string]string{
+		"name":     literalPart(pattern),
+		"language": strings.ToLower(opts.language),
+	})
+	if err != nil {
+		return err
+	}
+
+	filtered := make([]api.Application, 0, len(apps))
+	for _, app := range apps {
+		if match != nil && !match.MatchString(app.Name) {
+			continue
+		}
+		if health != "" && app.HealthStatus != health {
+			continue
+		}
+		if filterReporting && app.Reporting != opts.reporting {
+			continue
+		}
+		filtered = append(filtered, app)
+	}
+	apps = filtered
+
+	if opts.limit > 0 && len(apps) > opts.limit {
+		apps = apps[:opts.limit]
+	}
+
+	v := opts.View()
+
+	if len(apps) == 0 {
+		v.Println("No applications found")
+		return nil
+	}
+
+	headers, rows := summaryRows(apps)
+	return v.Render(headers, rows, apps)
+}
+
+// namePattern compiles a pattern containing * wildcards into a
+// case-insensitive regexp matching the whole name. It returns nil for
+// patterns without wildcards, which the API matches as a substring.
+func namePattern(pattern string) *regexp.Regexp {
+	if !strings.Contains(pattern, "*") {
+		return nil
+	}
+
+	parts := strings.Split(pattern, "*")
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
+	return regexp.MustCompile("(?i)^" + strings.Join(parts, ".*") + "$")
+}
+
+// literalPart returns the longest run of a pattern between wildcards, which
+// narrows the API's substring filter before wildcards are matched locally
+func literalPart(pattern string) string {
+	longest := ""
+	for _, p := range strings.Split(pattern, "*") {
+		if len(p) > len(longest) {
+			longest = p
+		}
+	}
+	return longest
+}
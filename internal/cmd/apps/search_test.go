@@ -0,0 +1,35 @@
+package apps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamePattern(t *testing.T) {
+	assert.Nil(t, namePattern("checkout"))
+
+	re := namePattern("prod-*")
+	require.NotNil(t, re)
+	assert.True(t, re.MatchString("prod-api"))
+	assert.True(t, re.MatchString("PROD-web"))
+	assert.False(t, re.MatchString("staging-prod-api"))
+
+	re = namePattern("*-api-*")
+	require.NotNil(t, re)
+	assert.True(t, re.MatchString("prod-api-v2"))
+	assert.False(t, re.MatchString("prod-web-v2"))
+
+	re = namePattern("app.v1*")
+	require.NotNil(t, re)
+	assert.True(t, re.MatchString("app.v1-beta"))
+	assert.False(t, re.MatchString("appXv1-beta"), "regexp metacharacters are literal")
+}
+
+func TestLiteralPart(t *testing.T) {
+	assert.Equal(t, "checkout", literalPart("checkout"))
+	assert.Equal(t, "prod-", literalPart("prod-*"))
+	assert.Equal(t, "-checkout-", literalPart("*-checkout-*"))
+	assert.Equal(t, "", literalPart("*"))
+}
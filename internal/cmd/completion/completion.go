@@ -1,7 +1,7 @@
 package completion
 
 import (
-	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -50,18 +50,79 @@ PowerShell:
 		ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
 		Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
 			switch args[0] {
 			case "bash":
-				return rootCmd.Root().GenBashCompletion(os.Stdout)
+				return rootCmd.Root().GenBashCompletion(out)
 			case "zsh":
-				return rootCmd.Root().GenZshCompletion(os.Stdout)
+				return rootCmd.Root().GenZshCompletion(out)
 			case "fish":
-				return rootCmd.Root().GenFishCompletion(os.Stdout, true)
+				return rootCmd.Root().GenFishCompletion(out, true)
 			case "powershell":
-				return rootCmd.Root().GenPowerShellCompletionWithDesc(os.Stdout)
+				return rootCmd.Root().GenPowerShellCompletionWithDesc(out)
 			}
 			return nil
 		},
 	}
 	rootCmd.AddCommand(cmd)
 }
+
+// flagValues lists the fixed values of enum flags, keyed by command path
+// ("" for the root command's persistent flags) and flag name
+var flagValues = []struct {
+	path   string
+	flag   string
+	values []string
+}{
+	{"", "output", []string{"table", "json", "plain", "csv", "markdown"}},
+	{"", "region", []string{"US", "EU"}},
+	{"init", "region", []string{"US", "EU"}},
+	{"config profiles add", "region", []string{"US", "EU"}},
+	{"keys list", "type", keyTypes},
+	{"keys get", "type", keyTypes},
+	{"keys create", "type", keyTypes},
+	{"keys update", "type", keyTypes},
+	{"keys delete", "type", keyTypes},
+	{"keys audit", "type", keyTypes},
+}
+
+var keyTypes = []string{"user", "ingest"}
+
+// flagListers lists flags whose values are fetched from the API
+var flagListers = []struct {
+	path string
+	flag string
+	list lister
+}{
+	{"", "account-id", listAccounts},
+	{"deployments list", "name", listAppNames},
+	{"deployments create", "name", listAppNames},
+	{"deployments delete", "name", listAppNames},
+}
+
+// registerFlagCompletions attaches value completions to enum and
+// name-lookup flags. Commands or flags that don't exist are skipped.
+func registerFlagCompletions(rootCmd *cobra.Command, opts *root.Options) {
+	for _, f := range flagValues {
+		if cmd := findCommand(rootCmd, f.path); cmd != nil {
+			_ = cmd.RegisterFlagCompletionFunc(f.flag, cobra.FixedCompletions(f.values, cobra.ShellCompDirectiveNoFileComp))
+		}
+	}
+	for _, f := range flagListers {
+		if cmd := findCommand(rootCmd, f.path); cmd != nil {
+			_ = cmd.RegisterFlagCompletionFunc(f.flag, dynamicFlag(opts, f.list))
+		}
+	}
+}
+
+// findCommand returns the command at path, or nil if there is none
+func findCommand(rootCmd *cobra.Command, path string) *cobra.Command {
+	if path == "" {
+		return rootCmd
+	}
+	cmd, rest, err := rootCmd.Find(strings.Fields(path))
+	if err != nil || cmd == rootCmd || len(rest) > 0 {
+		return nil
+	}
+	return cmd
+}
@@ -0,0 +1,82 @@
+package completion
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+func TestCompletion_Shells(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh", "fish", "powershell"} {
+		t.Run(shell, func(t *testing.T) {
+			rootCmd := &cobra.Command{Use: "nrq"}
+			Register(rootCmd, root.DefaultOptions())
+
+			var out bytes.Buffer
+			rootCmd.SetOut(&out)
+			rootCmd.SetArgs([]string{"completion", shell})
+
+			require.NoError(t, rootCmd.Execute())
+			assert.NotEmpty(t, out.String())
+		})
+	}
+}
+
+func TestCompletion_InvalidShell(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "nrq", SilenceErrors: true, SilenceUsage: true}
+	Register(rootCmd, root.DefaultOptions())
+	rootCmd.SetArgs([]string{"completion", "tcsh"})
+
+	assert.Error(t, rootCmd.Execute())
+}
+
+func TestRegisterFlagCompletions(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "nrq"}
+	rootCmd.PersistentFlags().StringP("output", "o", "table", "")
+	keysCmd := &cobra.Command{Use: "keys"}
+	listCmd := &cobra.Command{Use: "list", Run: func(*cobra.Command, []string) {}}
+	listCmd.Flags().String("type", "", "")
+	keysCmd.AddCommand(listCmd)
+	rootCmd.AddCommand(keysCmd)
+
+	RegisterDynamic(rootCmd, root.DefaultOptions())
+
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"keys", "list", "--output", ""}, []string{"table", "json", "plain", "csv", "markdown"}},
+		{[]string{"keys", "list", "--type", ""}, []string{"user", "ingest"}},
+	}
+
+	for _, tt := range tests {
+		var out bytes.Buffer
+		rootCmd.SetOut(&out)
+		rootCmd.SetArgs(append([]string{cobra.ShellCompRequestCmd}, tt.args...))
+		require.NoError(t, rootCmd.Execute())
+
+		for _, v := range tt.want {
+			assert.Contains(t, out.String(), v+"\n")
+		}
+	}
+
+	completeCmd, _, err := rootCmd.Find([]string{cobra.ShellCompRequestCmd})
+	require.NoError(t, err)
+	assert.True(t, completeCmd.Hidden)
+}
+
+func TestFindCommand(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "nrq"}
+	keysCmd := &cobra.Command{Use: "keys"}
+	rootCmd.AddCommand(keysCmd)
+
+	assert.Equal(t, rootCmd, findCommand(rootCmd, ""))
+	assert.Equal(t, keysCmd, findCommand(rootCmd, "keys"))
+	assert.Nil(t, findCommand(rootCmd, "keys audit"))
+	assert.Nil(t, findCommand(rootCmd, "users"))
+}
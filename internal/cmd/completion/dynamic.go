@@ -0,0 +1,290 @@
+package completion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+// apiTimeout bounds all the API calls made for one completion, so a slow or
+// unreachable API never hangs the shell
+const apiTimeout = 3 * time.Second
+
+// lister fetches completion candidates as "value\tdescription" strings
+type lister func(client *api.Client) ([]string, error)
+
+// RegisterDynamic attaches argument and flag completions to existing
+// commands. It must run after the commands it completes are registered.
+func RegisterDynamic(rootCmd *cobra.Command, opts *root.Options) {
+	completions := map[string]lister{
+		"apps get":                    listApps,
+		"apps health":                 listApps,
+		"synthetics get":              listMonitors,
+		"synthetics delete":           listMonitors,
+		"dashboards get":              listDashboards,
+		"dashboards update":           listDashboards,
+		"dashboards delete":           listDashboards,
+		"dashboards copy":             listDashboards,
+		"dashboards export":           listDashboards,
+		"dashboards pages list":       listDashboards,
+		"dashboards pages rename":     listDashboards,
+		"entities get":                listEntities,
+		"entities tags get":           listEntities,
+		"entities tags set":           listEntities,
+		"entities tags delete":        listEntities,
+		"entities relationships list": listEntities,
+		"users get":                   listUsers,
+		"logs rules get":              listLogRules,
+		"logs rules update":           listLogRules,
+		"workloads get":               listWorkloads,
+		"workloads status":            listWorkloads,
+		"slo get":                     listServiceLevels,
+		"accounts get":                listAccounts,
+	}
+
+	for path, list := range completions {
+		cmd := findCommand(rootCmd, path)
+		if cmd == nil {
+			continue
+		}
+		cmd.ValidArgsFunction = dynamicArgs(opts, list)
+	}
+
+	registerFlagCompletions(rootCmd, opts)
+}
+
+// dynamicFlag completes a flag value using list
+func dynamicFlag(opts *root.Options, list lister) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	complete := dynamicArgs(opts, list)
+	return func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return complete(cmd, nil, toComplete)
+	}
+}
+
+// dynamicArgs completes the first positional argument using list. Errors
+// are swallowed so that completion degrades to no suggestions.
+func dynamicArgs(opts *root.Options, list lister) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		client := opts.APIClientOrNil()
+		if client == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		// Listers may page through several requests; bound them together
+		// and give up on the first failure rather than retrying
+		parent := client.Context
+		if parent == nil {
+			parent = context.Background()
+		}
+		ctx, cancel := context.WithTimeout(parent, apiTimeout)
+		defer cancel()
+		client.Context = ctx
+		client.MaxRetries = 0
+
+		candidates, err := list(client)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return filterPrefix(candidates, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func listApps(client *api.Client) ([]string, error) {
+	apps, err := client.ListApplications()
+	if err != nil {
+		return nil, err
+	}
+	return appCompletions(apps), nil
+}
+
+func listAppNames(client *api.Client) ([]string, error) {
+	apps, err := client.ListApplications()
+	if err != nil {
+		return nil, err
+	}
+	return appNameCompletions(apps), nil
+}
+
+func listMonitors(client *api.Client) ([]string, error) {
+	monitors, err := client.ListSyntheticMonitors()
+	if err != nil {
+		return nil, err
+	}
+	return monitorCompletions(monitors), nil
+}
+
+func listDashboards(client *api.Client) ([]string, error) {
+	dashboards, err := client.ListDashboards()
+	if err != nil {
+		return nil, err
+	}
+	return dashboardCompletions(dashboards), nil
+}
+
+// listEntities offers the first page of entities in the account. GUIDs
+// can't be prefix-matched by the API, so the shell filters them.
+func listEntities(client *api.Client) ([]string, error) {
+	if err := client.RequireAccountID(); err != nil {
+		return nil, err
+	}
+	entities, _, err := client.SearchEntitiesPage("accountId = "+client.AccountID.String(), "")
+	if err != nil {
+		return nil, err
+	}
+	return entityCompletions(entities), nil
+}
+
+func listUsers(client *api.Client) ([]string, error) {
+	users, err := client.ListUsers()
+	if err != nil {
+		return nil, err
+	}
+	return userCompletions(users), nil
+}
+
+func listLogRules(client *api.Client) ([]string, error) {
+	rules, err := client.ListLogParsingRules()
+	if err != nil {
+		return nil, err
+	}
+	return logRuleCompletions(rules), nil
+}
+
+func listWorkloads(client *api.Client) ([]string, error) {
+	workloads, err := client.ListWorkloads()
+	if err != nil {
+		return nil, err
+	}
+	return workloadCompletions(workloads), nil
+}
+
+func listServiceLevels(client *api.Client) ([]string, error) {
+	slis, err := client.ListServiceLevels("")
+	if err != nil {
+		return nil, err
+	}
+	return serviceLevelCompletions(slis), nil
+}
+
+func listAccounts(client *api.Client) ([]string, error) {
+	accounts, err := client.ListAccounts()
+	if err != nil {
+		return nil, err
+	}
+	return accountCompletions(accounts), nil
+}
+
+func appCompletions(apps []api.Application) []string {
+	out := make([]string, len(apps))
+	for i, a := range apps {
+		out[i] = candidate(fmt.Sprintf("%d", a.ID), a.Name)
+	}
+	return out
+}
+
+func appNameCompletions(apps []api.Application) []string {
+	out := make([]string, len(apps))
+	for i, a := range apps {
+		out[i] = candidate(a.Name, fmt.Sprintf("%d", a.ID))
+	}
+	return out
+}
+
+func monitorCompletions(monitors []api.SyntheticMonitor) []string {
+	out := make([]string, len(monitors))
+	for i, m := range monitors {
+		out[i] = candidate(m.ID, m.Name)
+	}
+	return out
+}
+
+func dashboardCompletions(dashboards []api.Dashboard) []string {
+	out := make([]string, len(dashboards))
+	for i, d := range dashboards {
+		out[i] = candidate(d.GUID.String(), d.Name)
+	}
+	return out
+}
+
+func entityCompletions(entities []api.Entity) []string {
+	out := make([]string, len(entities))
+	for i, e := range entities {
+		out[i] = candidate(e.GUID.String(), e.Name+" ("+e.Type+")")
+	}
+	return out
+}
+
+func userCompletions(users []api.User) []string {
+	out := make([]string, len(users))
+	for i, u := range users {
+		out[i] = candidate(u.ID, u.Email)
+	}
+	return out
+}
+
+func logRuleCompletions(rules []api.LogParsingRule) []string {
+	out := make([]string, len(rules))
+	for i, r := range rules {
+		out[i] = candidate(r.ID, r.Description)
+	}
+	return out
+}
+
+func workloadCompletions(workloads []api.Workload) []string {
+	out := make([]string, len(workloads))
+	for i, w := range workloads {
+		out[i] = candidate(w.GUID.String(), w.Name)
+	}
+	return out
+}
+
+func serviceLevelCompletions(slis []api.ServiceLevelIndicator) []string {
+	out := make([]string, len(slis))
+	for i, s := range slis {
+		out[i] = candidate(s.GUID.String(), s.Name)
+	}
+	return out
+}
+
+func accountCompletions(accounts []api.Account) []string {
+	out := make([]string, len(accounts))
+	for i, a := range accounts {
+		out[i] = candidate(fmt.Sprintf("%d", a.ID), a.Name)
+	}
+	return out
+}
+
+// candidate formats a completion as cobra's "value\tdescription"
+func candidate(value, description string) string {
+	description = strings.Join(strings.Fields(description), " ")
+	if description == "" {
+		return value
+	}
+	return value + "\t" + description
+}
+
+// filterPrefix keeps candidates whose value starts with prefix
+func filterPrefix(candidates []string, prefix string) []string {
+	if prefix == "" {
+		return candidates
+	}
+	var out []string
+	for _, c := range candidates {
+		value, _, _ := strings.Cut(c, "\t")
+		if strings.HasPrefix(value, prefix) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,149 @@
+package completion
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+func TestAppCompletions(t *testing.T) {
+	apps := []api.Application{
+		{ID: 12345, Name: "production-api"},
+		{ID: 67890, Name: "staging-web"},
+	}
+
+	assert.Equal(t, []string{"12345\tproduction-api", "67890\tstaging-web"}, appCompletions(apps))
+}
+
+func TestAppNameCompletions(t *testing.T) {
+	apps := []api.Application{{ID: 12345, Name: "production-api"}}
+
+	assert.Equal(t, []string{"production-api\t12345"}, appNameCompletions(apps))
+}
+
+func TestMonitorCompletions(t *testing.T) {
+	monitors := []api.SyntheticMonitor{
+		{ID: "abc-123", Name: "Homepage Check"},
+	}
+
+	assert.Equal(t, []string{"abc-123\tHomepage Check"}, monitorCompletions(monitors))
+}
+
+func TestDashboardCompletions(t *testing.T) {
+	dashboards := []api.Dashboard{
+		{GUID: "MXxWSVp8REFTSEJPQVJEfDEyMzQ1", Name: "Production Overview"},
+	}
+
+	assert.Equal(t, []string{"MXxWSVp8REFTSEJPQVJEfDEyMzQ1\tProduction Overview"}, dashboardCompletions(dashboards))
+}
+
+func TestEntityCompletions(t *testing.T) {
+	entities := []api.Entity{
+		{GUID: "MXxBUE18QVBQTElDQVRJT058MTIzNDU", Name: "checkout-api", Type: "APPLICATION"},
+	}
+
+	assert.Equal(t, []string{"MXxBUE18QVBQTElDQVRJT058MTIzNDU\tcheckout-api (APPLICATION)"}, entityCompletions(entities))
+}
+
+func TestUserCompletions(t *testing.T) {
+	users := []api.User{
+		{ID: "1001", Name: "Jane Doe", Email: "jane@example.com"},
+		{ID: "1002", Name: "No Email"},
+	}
+
+	assert.Equal(t, []string{"1001\tjane@example.com", "1002"}, userCompletions(users))
+}
+
+func TestLogRuleCompletions(t *testing.T) {
+	rules := []api.LogParsingRule{
+		{ID: "rule-1", Description: "Parse nginx\naccess logs"},
+	}
+
+	// Descriptions are collapsed onto one line so they don't break the shell protocol
+	assert.Equal(t, []string{"rule-1\tParse nginx access logs"}, logRuleCompletions(rules))
+}
+
+func TestWorkloadCompletions(t *testing.T) {
+	workloads := []api.Workload{
+		{GUID: "MTIzNDU2N3xOUjF8V09SS0xPQUR8MTAx", Name: "Checkout"},
+	}
+
+	assert.Equal(t, []string{"MTIzNDU2N3xOUjF8V09SS0xPQUR8MTAx\tCheckout"}, workloadCompletions(workloads))
+}
+
+func TestServiceLevelCompletions(t *testing.T) {
+	slis := []api.ServiceLevelIndicator{
+		{GUID: "MTIzNDU2N3xFWFR8U0VSVklDRV9MRVZFTHwxMDE", Name: "Checkout latency"},
+	}
+
+	assert.Equal(t, []string{"MTIzNDU2N3xFWFR8U0VSVklDRV9MRVZFTHwxMDE\tCheckout latency"}, serviceLevelCompletions(slis))
+}
+
+func TestAccountCompletions(t *testing.T) {
+	accounts := []api.Account{
+		{ID: 12345, Name: "Production"},
+	}
+
+	assert.Equal(t, []string{"12345\tProduction"}, accountCompletions(accounts))
+}
+
+func TestFilterPrefix(t *testing.T) {
+	candidates := []string{"123\tapi", "124\tweb", "200\tworker"}
+
+	assert.Equal(t, candidates, filterPrefix(candidates, ""))
+	assert.Equal(t, []string{"123\tapi", "124\tweb"}, filterPrefix(candidates, "12"))
+	assert.Nil(t, filterPrefix(candidates, "9"))
+}
+
+func TestDynamicArgs_OnlyFirstArg(t *testing.T) {
+	called := false
+	fn := dynamicArgs(root.DefaultOptions(), func(*api.Client) ([]string, error) {
+		called = true
+		return nil, nil
+	})
+
+	values, directive := fn(&cobra.Command{}, []string{"already-set"}, "")
+
+	assert.Nil(t, values)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	assert.False(t, called)
+}
+
+func TestRegisterDynamic(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "nrq"}
+	appsCmd := &cobra.Command{Use: "apps"}
+	getCmd := &cobra.Command{Use: "get", Run: func(*cobra.Command, []string) {}}
+	appsCmd.AddCommand(getCmd)
+	rootCmd.AddCommand(appsCmd)
+
+	RegisterDynamic(rootCmd, root.DefaultOptions())
+
+	assert.NotNil(t, getCmd.ValidArgsFunction)
+}
+
+func TestDynamicArgs_BoundsListing(t *testing.T) {
+	opts := root.DefaultOptions()
+	opts.APIKeyOverride = "NRAK-TEST"
+	opts.AccountIDOverride = "12345"
+
+	var client *api.Client
+	complete := dynamicArgs(opts, func(c *api.Client) ([]string, error) {
+		client = c
+		return []string{"123\tapi"}, nil
+	})
+
+	candidates, _ := complete(&cobra.Command{}, nil, "")
+	assert.Equal(t, []string{"123\tapi"}, candidates)
+
+	require.NotNil(t, client)
+	assert.Equal(t, 0, client.MaxRetries)
+	deadline, ok := client.Context.Deadline()
+	require.True(t, ok, "completion requests should share a deadline")
+	assert.WithinDuration(t, time.Now().Add(apiTimeout), deadline, time.Second)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/newrelic-cli/api"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
 	"github.com/open-cli-collective/newrelic-cli/internal/config"
 	"github.com/open-cli-collective/newrelic-cli/internal/confirm"
@@ -28,19 +29,24 @@ func Register(rootCmd *cobra.Command, opts *root.Options) {
 	configCmd.AddCommand(newSetRegionCmd(opts))
 	configCmd.AddCommand(newShowCmd(opts))
 	configCmd.AddCommand(newTestCmd(opts))
+	configCmd.AddCommand(newDoctorCmd(opts))
 	configCmd.AddCommand(newClearCmd(opts))
 	configCmd.AddCommand(newFixPermissionsCmd(opts))
+	configCmd.AddCommand(newExportCmd(opts))
+	configCmd.AddCommand(newImportCmd(opts))
+	configCmd.AddCommand(newProfilesCmd(opts))
 
 	rootCmd.AddCommand(configCmd)
 }
 
 func newSetAPIKeyCmd(opts *root.Options) *cobra.Command {
-	return &cobra.Command{
+	return root.DisablePager(&cobra.Command{
 		Use:   "set-api-key [key]",
 		Short: "Set the New Relic API key",
 		Long: `Set the New Relic API key for authentication.
 
 On macOS: Key is stored securely in the system Keychain.
+On Windows: Key is stored securely in the Windows Credential Manager.
 On Linux: Key is stored in ~/.config/newrelic-cli/credentials (file permissions 0600).
 
 If no key is provided as an argument, you will be prompted to enter it.`,
@@ -48,7 +54,7 @@ If no key is provided as an argument, you will be prompted to enter it.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runSetAPIKey(opts, args)
 		},
-	}
+	})
 }
 
 func runSetAPIKey(opts *root.Options, args []string) error {
@@ -57,7 +63,7 @@ func runSetAPIKey(opts *root.Options, args []string) error {
 	if !config.IsSecureStorage() {
 		v.Warning("Warning: On Linux, your API key will be stored in a config file")
 		v.Println("         (~/.config/newrelic-cli/credentials) with restricted permissions (0600).")
-		v.Println("         This is less secure than macOS Keychain storage.")
+		v.Println("         This is less secure than the macOS Keychain or Windows Credential Manager.")
 		v.Println("")
 	}
 
@@ -88,7 +94,7 @@ func runSetAPIKey(opts *root.Options, args []string) error {
 	}
 
 	if config.IsSecureStorage() {
-		v.Success("API key stored securely in Keychain")
+		v.Success("API key stored securely in %s", config.SecureStorageName())
 	} else {
 		v.Success("API key stored in ~/.config/newrelic-cli/credentials")
 	}
@@ -112,7 +118,7 @@ func newDeleteAPIKeyCmd(opts *root.Options) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().BoolVarP(&deleteOpts.force, "force", "f", false, "Skip confirmation prompt")
+	root.AddForceFlag(cmd, &deleteOpts.force, "Skip confirmation prompt")
 
 	return cmd
 }
@@ -136,7 +142,7 @@ func runDeleteAPIKey(opts *deleteAPIKeyOptions) error {
 	}
 
 	if config.IsSecureStorage() {
-		v.Success("API key deleted from Keychain")
+		v.Success("API key deleted from %s", config.SecureStorageName())
 	} else {
 		v.Success("API key deleted from config file")
 	}
@@ -167,7 +173,7 @@ func runSetAccountID(opts *root.Options, accountID string) error {
 	}
 
 	if config.IsSecureStorage() {
-		v.Success("Account ID stored securely in Keychain")
+		v.Success("Account ID stored securely in %s", config.SecureStorageName())
 	} else {
 		v.Success("Account ID stored in config file")
 	}
@@ -191,7 +197,7 @@ func newDeleteAccountIDCmd(opts *root.Options) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().BoolVarP(&deleteOpts.force, "force", "f", false, "Skip confirmation prompt")
+	root.AddForceFlag(cmd, &deleteOpts.force, "Skip confirmation prompt")
 
 	return cmd
 }
@@ -215,7 +221,7 @@ func runDeleteAccountID(opts *deleteAccountIDOptions) error {
 	}
 
 	if config.IsSecureStorage() {
-		v.Success("Account ID deleted from Keychain")
+		v.Success("Account ID deleted from %s", config.SecureStorageName())
 	} else {
 		v.Success("Account ID deleted from config file")
 	}
@@ -264,6 +270,7 @@ func newShowCmd(opts *root.Options) *cobra.Command {
 // ConfigStatus represents configuration status for JSON output
 // NOTE: API key value is intentionally NOT included for security
 type ConfigStatus struct {
+	Profile          string `json:"profile"`
 	APIKeyConfigured bool   `json:"api_key_configured"`
 	APIKeySource     string `json:"api_key_source,omitempty"`
 	AccountID        string `json:"account_id,omitempty"`
@@ -275,7 +282,11 @@ type ConfigStatus struct {
 
 func runShow(opts *root.Options) error {
 	v := opts.View()
-	status := config.GetCredentialStatus()
+
+	if err := config.CheckProfile(opts.Profile); err != nil {
+		return err
+	}
+	status := config.GetCredentialStatus(opts.Profile)
 
 	// Check for permission warnings (Linux only)
 	if warning := config.CheckPermissions(); warning != "" {
@@ -286,33 +297,25 @@ func runShow(opts *root.Options) error {
 
 	// Build configuration status
 	configStatus := ConfigStatus{
-		Region:      config.GetRegion(),
-		StorageType: "config_file",
-	}
-
-	if config.IsSecureStorage() {
-		configStatus.StorageType = "keychain"
+		Profile:     activeProfile(opts),
+		Region:      config.GetRegion(opts.Profile),
+		StorageType: config.StorageType(),
 	}
 
 	// API Key
 	var apiKeyMasked string
-	if apiKey, err := config.GetAPIKey(); err == nil {
+	if apiKey, err := config.GetAPIKey(opts.Profile); err == nil {
 		configStatus.APIKeyConfigured = true
 		if status["api_key_env"] {
 			configStatus.APIKeySource = "environment"
 		} else {
 			configStatus.APIKeySource = "stored"
 		}
-		// Mask API key for display (first 8 + last 4)
-		if len(apiKey) > 12 {
-			apiKeyMasked = apiKey[:8] + strings.Repeat("*", len(apiKey)-12) + apiKey[len(apiKey)-4:]
-		} else {
-			apiKeyMasked = strings.Repeat("*", len(apiKey))
-		}
+		apiKeyMasked = maskAPIKey(apiKey)
 	}
 
 	// Account ID
-	if accountID, err := config.GetAccountID(); err == nil {
+	if accountID, err := config.GetAccountID(opts.Profile); err == nil {
 		configStatus.AccountID = accountID
 		if status["account_id_env"] {
 			configStatus.AccountIDSource = "environment"
@@ -339,6 +342,8 @@ func runShow(opts *root.Options) error {
 	v.Println("Configuration Status:")
 	v.Println("")
 
+	v.Print("  Profile:    %s\n", configStatus.Profile)
+
 	// API Key
 	if configStatus.APIKeyConfigured {
 		v.Print("  API Key:    %s (%s)\n", apiKeyMasked, configStatus.APIKeySource)
@@ -358,12 +363,7 @@ func runShow(opts *root.Options) error {
 
 	v.Println("")
 
-	// Storage type
-	if config.IsSecureStorage() {
-		v.Println("Storage: macOS Keychain (secure)")
-	} else {
-		v.Println("Storage: Config file (~/.config/newrelic-cli/credentials)")
-	}
+	v.Println("Storage: " + config.ProfileStorageDescription(opts.Profile))
 
 	return nil
 }
@@ -375,7 +375,8 @@ func newFixPermissionsCmd(opts *root.Options) *cobra.Command {
 		Long: `Fix the permissions on the credentials file to ensure they are secure.
 
 On Linux, the credentials file should have permissions 0600 (owner read/write only).
-On macOS, this command has no effect as credentials are stored in the Keychain.`,
+On macOS and Windows, this command has no effect as credentials are stored in
+the Keychain or Credential Manager.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runFixPermissions(opts)
 		},
@@ -386,7 +387,7 @@ func runFixPermissions(opts *root.Options) error {
 	v := opts.View()
 
 	if config.IsSecureStorage() {
-		v.Println("On macOS, credentials are stored in the Keychain - no file permissions to fix")
+		v.Print("Credentials are stored in the %s - no file permissions to fix\n", config.SecureStorageName())
 		return nil
 	}
 
@@ -407,8 +408,13 @@ func newTestCmd(opts *root.Options) *cobra.Command {
 Verifies:
   - API key is valid
   - Account is accessible (if account ID is configured)
-  - NerdGraph API is responding`,
-		Example: `  nrq config test`,
+  - NerdGraph API is responding
+
+With --verbose, also lists up to 20 accounts the API key can access.
+JSON output always includes them as accessible_accounts.`,
+		Example: `  nrq config test
+  nrq config test --verbose
+  nrq config test -o json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runTest(opts)
 		},
@@ -425,6 +431,8 @@ type ConnectionTestStatus struct {
 	UserEmail     string `json:"user_email,omitempty"`
 	Region        string `json:"region"`
 	Error         string `json:"error,omitempty"`
+
+	AccessibleAccounts []api.AccessibleAccount `json:"accessible_accounts,omitempty"`
 }
 
 func runTest(opts *root.Options) error {
@@ -454,6 +462,8 @@ func runTest(opts *root.Options) error {
 		AccountName:   result.AccountName,
 		UserEmail:     result.UserEmail,
 		Region:        result.Region,
+
+		AccessibleAccounts: result.AccessibleAccounts,
 	}
 
 	if result.Error != nil {
@@ -465,7 +475,7 @@ func runTest(opts *root.Options) error {
 	}
 
 	// Table output
-	region := config.GetRegion()
+	region := config.GetRegion(opts.Profile)
 	v.Print("Region: %s\n", region)
 	v.Println("")
 
@@ -474,6 +484,12 @@ func runTest(opts *root.Options) error {
 		if result.UserEmail != "" {
 			v.Print("  User: %s\n", result.UserEmail)
 		}
+		if opts.Verbose && len(result.AccessibleAccounts) > 0 {
+			v.Println("  Accessible accounts:")
+			for _, a := range result.AccessibleAccounts {
+				v.Print("    %d  %s\n", a.ID, a.Name)
+			}
+		}
 	} else {
 		v.Error("API key invalid or expired")
 		if result.ErrorMessage != "" {
@@ -487,8 +503,7 @@ func runTest(opts *root.Options) error {
 	}
 
 	// Check account access if configured
-	accountID, accountErr := config.GetAccountID()
-	if accountErr == nil && accountID != "" {
+	if accountID := client.AccountID.String(); accountID != "" {
 		if result.AccountAccess {
 			v.Success("Account %d accessible", result.AccountID)
 			if result.AccountName != "" {
@@ -537,7 +552,7 @@ Note: Environment variables (NEWRELIC_*) will still be used if set.`,
 		},
 	}
 
-	cmd.Flags().BoolVarP(&clearOpts.force, "force", "f", false, "Skip confirmation prompt")
+	root.AddForceFlag(cmd, &clearOpts.force, "Skip confirmation prompt")
 
 	return cmd
 }
@@ -566,8 +581,8 @@ func runClear(opts *clearOptions) error {
 	}
 
 	if config.IsSecureStorage() {
-		v.Success("Cleared API key from Keychain")
-		v.Success("Cleared account ID from Keychain")
+		v.Success("Cleared API key from %s", config.SecureStorageName())
+		v.Success("Cleared account ID from %s", config.SecureStorageName())
 	} else {
 		v.Success("Cleared API key from config file")
 		v.Success("Cleared account ID from config file")
@@ -579,3 +594,11 @@ func runClear(opts *clearOptions) error {
 
 	return nil
 }
+
+// maskAPIKey hides all but the first 8 and last 4 characters of an API key
+func maskAPIKey(apiKey string) string {
+	if len(apiKey) > 12 {
+		return apiKey[:8] + strings.Repeat("*", len(apiKey)-12) + apiKey[len(apiKey)-4:]
+	}
+	return strings.Repeat("*", len(apiKey))
+}
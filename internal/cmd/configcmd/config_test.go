@@ -0,0 +1,46 @@
+package configcmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/config"
+)
+
+func TestProfilesDeleteCmd_NoConfirmSkipsPrompt(t *testing.T) {
+	if config.IsSecureStorage() {
+		t.Skip("profile credentials are stored in the OS credential store on macOS and Windows")
+	}
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv(config.ProfileEnvVar, "")
+	require.NoError(t, config.AddProfile("staging", config.ProfileCredentials{APIKey: "NRAK-STAGING"}))
+
+	var stderr bytes.Buffer
+	opts := root.DefaultOptions()
+	opts.Stdin = strings.NewReader("")
+	opts.Stdout = &bytes.Buffer{}
+	opts.Stderr = &stderr
+
+	// Without the flag, the prompt reads no answer and nothing is deleted
+	cmd := newProfilesDeleteCmd(opts)
+	cmd.SetArgs([]string{"staging"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stderr.String(), "[y/N]")
+	names, err := config.ListProfiles()
+	require.NoError(t, err)
+	assert.Contains(t, names, "staging")
+
+	stderr.Reset()
+	cmd = newProfilesDeleteCmd(opts)
+	cmd.SetArgs([]string{"staging", "--no-confirm"})
+	require.NoError(t, cmd.Execute())
+	assert.NotContains(t, stderr.String(), "[y/N]")
+	names, err = config.ListProfiles()
+	require.NoError(t, err)
+	assert.NotContains(t, names, "staging")
+}
@@ -0,0 +1,301 @@
+package configcmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/config"
+	"github.com/open-cli-collective/newrelic-cli/internal/validate"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+// Doctor check statuses
+const (
+	checkOK   = "ok"
+	checkWarn = "warn"
+	checkFail = "fail"
+)
+
+// doctorNetworkTimeout bounds the connectivity check
+const doctorNetworkTimeout = 10 * time.Second
+
+// doctorCheck is the outcome of one config doctor check
+type doctorCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	Fix    string `json:"fix,omitempty"`
+}
+
+func newDoctorCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose credential and connection problems",
+		Long: `Run a series of checks on the configured credentials and print the result
+of each, with a suggested fix for anything that fails:
+
+  - API key is present, where it is read from, and its format
+  - Account ID is present and numeric
+  - Region is valid
+  - Credentials file permissions (Linux)
+  - NerdGraph is reachable
+  - API key is accepted by New Relic
+
+Unlike 'config test', doctor always exits 0 so the full report is printed
+even when checks fail.`,
+		Example: `  nrq config doctor
+  nrq config doctor --profile staging
+  nrq config doctor -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDoctor(opts)
+		},
+	}
+}
+
+func runDoctor(opts *root.Options) error {
+	v := opts.View()
+	checks := doctorChecks(opts)
+
+	if v.Format == view.FormatJSON {
+		return v.JSON(checks)
+	}
+
+	for _, c := range checks {
+		line := fmt.Sprintf("%s %s", checkSymbol(c.Status), c.Name)
+		if c.Detail != "" {
+			line += ": " + c.Detail
+		}
+		v.Println(line)
+		if c.Fix != "" {
+			v.Print("    Fix: %s\n", c.Fix)
+		}
+	}
+	return nil
+}
+
+// doctorChecks runs every check in order. Checks that depend on an earlier
+// one passing are skipped when it did not.
+func doctorChecks(opts *root.Options) []doctorCheck {
+	if err := config.CheckProfile(opts.Profile); err != nil {
+		return []doctorCheck{{
+			Name:   "Profile",
+			Status: checkFail,
+			Detail: err.Error(),
+			Fix:    "Create it with 'nrq config profiles add' or pass an existing --profile",
+		}}
+	}
+
+	var checks []doctorCheck
+	status := config.GetCredentialStatus(opts.Profile)
+
+	apiKey := opts.APIKeyOverride
+	var keyErr error
+	if apiKey == "" {
+		apiKey, keyErr = config.GetAPIKey(opts.Profile)
+	}
+	checks = append(checks, checkAPIKeyPresent(keyErr))
+	if keyErr == nil {
+		if opts.APIKeyOverride != "" {
+			checks = append(checks, doctorCheck{Name: "API key source", Status: checkOK, Detail: "--api-key flag"})
+		} else {
+			checks = append(checks, checkAPIKeySource(status, apiKey))
+		}
+		checks = append(checks, checkAPIKeyFormat(apiKey))
+	}
+
+	accountID := opts.AccountIDOverride
+	if accountID == "" {
+		accountID, _ = config.GetAccountID(opts.Profile)
+	}
+	checks = append(checks, checkAccountID(accountID))
+
+	region := opts.RegionOverride
+	if region == "" {
+		region = config.GetRegion(opts.Profile)
+	}
+	checks = append(checks, checkRegion(region))
+
+	if !config.IsSecureStorage() {
+		checks = append(checks, checkPermissions())
+	}
+
+	client := api.NewWithConfig(api.ClientConfig{
+		APIKey:    apiKey,
+		AccountID: accountID,
+		Region:    region,
+		Timeout:   doctorNetworkTimeout,
+	})
+	network := checkNetwork(client)
+	checks = append(checks, network)
+
+	if keyErr == nil && network.Status == checkOK {
+		checks = append(checks, checkAPIKeyValid(client))
+	}
+
+	return checks
+}
+
+func checkAPIKeyPresent(err error) doctorCheck {
+	if err != nil {
+		return doctorCheck{
+			Name:   "API key present",
+			Status: checkFail,
+			Detail: "no API key found",
+			Fix:    "Run 'nrq config set-api-key' or set NEWRELIC_API_KEY",
+		}
+	}
+	return doctorCheck{Name: "API key present", Status: checkOK}
+}
+
+// checkAPIKeySource reports where the API key is read from. A stored key
+// wins over NEWRELIC_API_KEY, which surprises users who set the variable.
+func checkAPIKeySource(status map[string]bool, apiKey string) doctorCheck {
+	c := doctorCheck{Name: "API key source", Status: checkOK}
+
+	if !status["api_key_stored"] {
+		c.Detail = "environment (NEWRELIC_API_KEY)"
+		return c
+	}
+
+	c.Detail = storageDescription()
+	if env := os.Getenv("NEWRELIC_API_KEY"); env != "" && env != apiKey {
+		c.Status = checkWarn
+		c.Detail += "; NEWRELIC_API_KEY is set but ignored"
+		c.Fix = "Run 'nrq config delete-api-key' to use NEWRELIC_API_KEY, or unset it"
+	}
+	return c
+}
+
+func checkAPIKeyFormat(apiKey string) doctorCheck {
+	c := doctorCheck{Name: "API key format"}
+
+	warning, err := validate.APIKey(apiKey)
+	switch {
+	case err != nil:
+		c.Status = checkFail
+		c.Detail = err.Error()
+		c.Fix = "Create a User API key at https://one.newrelic.com/api-keys and run 'nrq config set-api-key'"
+	case warning != "":
+		c.Status = checkWarn
+		c.Detail = warning
+		c.Fix = "Use a User API key (NRAK-...); other key types cannot call NerdGraph"
+	default:
+		c.Status = checkOK
+		c.Detail = maskAPIKey(apiKey)
+	}
+	return c
+}
+
+func checkAccountID(accountID string) doctorCheck {
+	c := doctorCheck{Name: "Account ID"}
+
+	switch {
+	case accountID == "":
+		c.Status = checkWarn
+		c.Detail = "not configured (required by most commands)"
+		c.Fix = "Run 'nrq config set-account-id <id>' or set NEWRELIC_ACCOUNT_ID"
+	case validate.AccountID(accountID) != nil:
+		c.Status = checkFail
+		c.Detail = fmt.Sprintf("%q is not a numeric account ID", accountID)
+		c.Fix = "Run 'nrq config set-account-id <id>' with the numeric ID from the New Relic URL"
+	default:
+		c.Status = checkOK
+		c.Detail = accountID
+	}
+	return c
+}
+
+func checkRegion(region string) doctorCheck {
+	if err := validate.Region(region); err != nil {
+		return doctorCheck{
+			Name:   "Region",
+			Status: checkFail,
+			Detail: err.Error(),
+			Fix:    "Run 'nrq config set-region US' (or EU)",
+		}
+	}
+	return doctorCheck{Name: "Region", Status: checkOK, Detail: region}
+}
+
+func checkPermissions() doctorCheck {
+	if warning := config.CheckPermissions(); warning != "" {
+		return doctorCheck{
+			Name:   "Credentials file permissions",
+			Status: checkWarn,
+			Detail: warning,
+			Fix:    "Run 'nrq config fix-permissions'",
+		}
+	}
+	return doctorCheck{Name: "Credentials file permissions", Status: checkOK}
+}
+
+// checkNetwork reports whether the NerdGraph endpoint answers at all; any
+// HTTP response means it is reachable
+func checkNetwork(client *api.Client) doctorCheck {
+	c := doctorCheck{Name: "NerdGraph reachable", Detail: client.NerdGraphURL}
+
+	req, err := http.NewRequest(http.MethodHead, client.NerdGraphURL, nil)
+	if err == nil {
+		var resp *http.Response
+		resp, err = client.HTTPClient.Do(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+	}
+	if err != nil {
+		c.Status = checkFail
+		c.Detail = err.Error()
+		c.Fix = "Check your network connection, proxy settings (HTTPS_PROXY), and firewall"
+		return c
+	}
+
+	c.Status = checkOK
+	return c
+}
+
+func checkAPIKeyValid(client *api.Client) doctorCheck {
+	c := doctorCheck{Name: "API key accepted"}
+
+	result, err := client.TestConnection()
+	switch {
+	case err != nil:
+		c.Status = checkFail
+		c.Detail = err.Error()
+	case !result.APIKeyValid:
+		c.Status = checkFail
+		c.Detail = result.ErrorMessage
+	default:
+		c.Status = checkOK
+		c.Detail = result.UserEmail
+		return c
+	}
+
+	c.Fix = "The key may be revoked or for another region; create a new User API key and run 'nrq config set-api-key'"
+	return c
+}
+
+// storageDescription names where stored credentials live
+func storageDescription() string {
+	if config.IsSecureStorage() {
+		return config.SecureStorageName()
+	}
+	return "config file (" + config.GetConfigFilePath() + ")"
+}
+
+// checkSymbol returns the marker printed for a check status
+func checkSymbol(status string) string {
+	switch status {
+	case checkOK:
+		return "✓"
+	case checkWarn:
+		return "⚠"
+	default:
+		return "✗"
+	}
+}
@@ -0,0 +1,92 @@
+package configcmd
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+)
+
+func TestCheckAPIKeyPresent(t *testing.T) {
+	assert.Equal(t, checkOK, checkAPIKeyPresent(nil).Status)
+
+	c := checkAPIKeyPresent(errors.New("no API key found"))
+	assert.Equal(t, checkFail, c.Status)
+	assert.Contains(t, c.Fix, "set-api-key")
+}
+
+func TestCheckAPIKeySource(t *testing.T) {
+	t.Setenv("NEWRELIC_API_KEY", "NRAK-FROMENVIRONMENT00")
+
+	c := checkAPIKeySource(map[string]bool{}, "NRAK-FROMENVIRONMENT00")
+	assert.Equal(t, checkOK, c.Status)
+	assert.Contains(t, c.Detail, "NEWRELIC_API_KEY")
+
+	// A stored key shadows a different key in the environment
+	c = checkAPIKeySource(map[string]bool{"api_key_stored": true}, "NRAK-STOREDKEY0000000")
+	assert.Equal(t, checkWarn, c.Status)
+	assert.Contains(t, c.Detail, "ignored")
+	assert.Contains(t, c.Fix, "delete-api-key")
+}
+
+func TestCheckAPIKeyFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    string
+		status string
+	}{
+		{"user key", "NRAK-ABCDEFGHIJKLMNOPQRST", checkOK},
+		{"other key type", "NRII-ABCDEFGHIJKLMNOPQRST", checkWarn},
+		{"too short", "NRAK-SHORT", checkFail},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := checkAPIKeyFormat(tt.key)
+			assert.Equal(t, tt.status, c.Status)
+			if tt.status == checkOK {
+				assert.NotContains(t, c.Detail, tt.key, "key must be masked")
+			} else {
+				assert.NotEmpty(t, c.Fix)
+			}
+		})
+	}
+}
+
+func TestCheckAccountID(t *testing.T) {
+	assert.Equal(t, checkOK, checkAccountID("12345").Status)
+	assert.Equal(t, checkWarn, checkAccountID("").Status)
+	assert.Equal(t, checkFail, checkAccountID("abc").Status)
+}
+
+func TestCheckRegion(t *testing.T) {
+	assert.Equal(t, checkOK, checkRegion("EU").Status)
+	assert.Equal(t, checkFail, checkRegion("APAC").Status)
+}
+
+func TestCheckNetwork(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// NerdGraph rejects HEAD, but any response means it is reachable
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+	defer server.Close()
+
+	client := api.NewWithConfig(api.ClientConfig{})
+	client.NerdGraphURL = server.URL
+	assert.Equal(t, checkOK, checkNetwork(client).Status)
+
+	server.Close()
+	c := checkNetwork(client)
+	assert.Equal(t, checkFail, c.Status)
+	assert.NotEmpty(t, c.Fix)
+}
+
+func TestCheckSymbol(t *testing.T) {
+	assert.Equal(t, "✓", checkSymbol(checkOK))
+	assert.Equal(t, "⚠", checkSymbol(checkWarn))
+	assert.Equal(t, "✗", checkSymbol(checkFail))
+}
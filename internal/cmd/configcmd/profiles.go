@@ -0,0 +1,271 @@
+package configcmd
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/config"
+	"github.com/open-cli-collective/newrelic-cli/internal/confirm"
+	"github.com/open-cli-collective/newrelic-cli/internal/validate"
+)
+
+func newProfilesCmd(opts *root.Options) *cobra.Command {
+	profilesCmd := &cobra.Command{
+		Use:     "profiles",
+		Aliases: []string{"profile"},
+		Short:   "Manage named credential profiles",
+		Long: `Manage named credential profiles for switching between accounts.
+
+The "default" profile is the one configured with 'nrq config set-api-key',
+'set-account-id', and 'set-region'. Additional profiles each hold their own
+API key, account ID, and region.
+
+On macOS: Profile credentials are stored in the Keychain under the service
+"newrelic-cli.<name>".
+On Windows: Profile credentials are stored in the Credential Manager under
+the targets "newrelic-cli.<name>:<key>".
+On Linux: Profiles are stored in ~/.config/newrelic-cli/profiles (file
+permissions 0600).
+
+Use 'nrq config profiles use <name>' to switch the active profile, or pass
+--profile <name> to any command to use a profile once. NEWRELIC_PROFILE also
+selects a profile; --profile takes precedence over it.`,
+	}
+
+	profilesCmd.AddCommand(newProfilesListCmd(opts))
+	profilesCmd.AddCommand(newProfilesShowCmd(opts))
+	profilesCmd.AddCommand(newProfilesAddCmd(opts))
+	profilesCmd.AddCommand(newProfilesUseCmd(opts))
+	profilesCmd.AddCommand(newProfilesDeleteCmd(opts))
+
+	return profilesCmd
+}
+
+// profileInfo is a profile entry for JSON output
+type profileInfo struct {
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+}
+
+// activeProfile returns the profile commands will use: --profile if given,
+// then NEWRELIC_PROFILE, otherwise the active profile
+func activeProfile(opts *root.Options) string {
+	return config.ResolveProfile(opts.Profile)
+}
+
+func newProfilesListCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List credential profiles",
+		Example: `  nrq config profiles list
+  nrq config profiles list -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProfilesList(opts)
+		},
+	}
+}
+
+func runProfilesList(opts *root.Options) error {
+	names, err := config.ListProfiles()
+	if err != nil {
+		return fmt.Errorf("failed to read profiles: %w", err)
+	}
+
+	active := activeProfile(opts)
+
+	profiles := make([]profileInfo, len(names))
+	rows := make([][]string, len(names))
+	for i, name := range names {
+		profiles[i] = profileInfo{Name: name, Active: name == active}
+		marker := ""
+		if name == active {
+			marker = "*"
+		}
+		rows[i] = []string{marker, name}
+	}
+
+	return opts.View().Render([]string{"ACTIVE", "NAME"}, rows, profiles)
+}
+
+func newProfilesShowCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show",
+		Short: "Show the active profile",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProfilesShow(opts)
+		},
+	}
+}
+
+func runProfilesShow(opts *root.Options) error {
+	v := opts.View()
+	name := activeProfile(opts)
+
+	switch v.Format {
+	case "json":
+		return v.JSON(profileInfo{Name: name, Active: true})
+	default:
+		v.Println(name)
+		return nil
+	}
+}
+
+// profilesAddOptions holds options for the profiles add command
+type profilesAddOptions struct {
+	*root.Options
+	apiKey    string
+	accountID string
+	region    string
+	use       bool
+}
+
+func newProfilesAddCmd(opts *root.Options) *cobra.Command {
+	addOpts := &profilesAddOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "add <name>",
+		Short: "Add or replace a credential profile",
+		Long: `Add a named credential profile, replacing any existing profile with the
+same name.
+
+If --api-key is not provided, you will be prompted to enter it.`,
+		Example: `  nrq config profiles add staging --account-id 1234567
+  nrq config profiles add eu-prod --api-key NRAK-XXXX --account-id 7654321 --region EU --use`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProfilesAdd(addOpts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&addOpts.apiKey, "api-key", "", "New Relic API key")
+	cmd.Flags().StringVar(&addOpts.accountID, "account-id", "", "New Relic account ID")
+	cmd.Flags().StringVar(&addOpts.region, "region", "US", "New Relic region (US or EU)")
+	cmd.Flags().BoolVar(&addOpts.use, "use", false, "Make the new profile active")
+
+	return root.DisablePager(cmd)
+}
+
+func runProfilesAdd(opts *profilesAddOptions, name string) error {
+	v := opts.View()
+
+	apiKey := opts.apiKey
+	if apiKey == "" {
+		fmt.Fprint(opts.Stdout, "Enter New Relic API key: ")
+		reader := bufio.NewReader(opts.Stdin)
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read input: %w", err)
+		}
+		apiKey = strings.TrimSpace(input)
+	}
+
+	warning, err := validate.APIKey(apiKey)
+	if err != nil {
+		return err
+	}
+	if warning != "" {
+		v.Warning("Warning: " + warning)
+	}
+
+	if opts.accountID != "" {
+		if err := validate.AccountID(opts.accountID); err != nil {
+			return err
+		}
+	}
+
+	region := strings.ToUpper(opts.region)
+	if err := validate.Region(region); err != nil {
+		return err
+	}
+
+	err = config.AddProfile(name, config.ProfileCredentials{
+		APIKey:    apiKey,
+		AccountID: opts.accountID,
+		Region:    region,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store profile: %w", err)
+	}
+	v.Success("Profile %q saved", name)
+
+	if opts.use {
+		if err := config.UseProfile(name); err != nil {
+			return err
+		}
+		v.Success("Switched to profile %q", name)
+	}
+	return nil
+}
+
+func newProfilesUseCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "use <name>",
+		Short: "Switch the active profile",
+		Example: `  nrq config profiles use staging
+  nrq config profiles use default`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProfilesUse(opts, args[0])
+		},
+	}
+}
+
+func runProfilesUse(opts *root.Options, name string) error {
+	if err := config.UseProfile(name); err != nil {
+		return err
+	}
+
+	opts.View().Success("Switched to profile %q", name)
+	return nil
+}
+
+// profilesDeleteOptions holds options for the profiles delete command
+type profilesDeleteOptions struct {
+	*root.Options
+	force bool
+}
+
+func newProfilesDeleteCmd(opts *root.Options) *cobra.Command {
+	deleteOpts := &profilesDeleteOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a credential profile",
+		Long: `Delete a named credential profile and its stored credentials.
+If it is the active profile, the default profile becomes active.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProfilesDelete(deleteOpts, args[0])
+		},
+	}
+
+	root.AddForceFlag(cmd, &deleteOpts.force, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runProfilesDelete(opts *profilesDeleteOptions, name string) error {
+	v := opts.View()
+
+	if !opts.force {
+		p := &confirm.Prompter{
+			In:  opts.Stdin,
+			Out: opts.Stderr,
+		}
+		if !p.Confirm(fmt.Sprintf("Delete profile %q?", name)) {
+			v.Warning("Operation canceled")
+			return nil
+		}
+	}
+
+	if err := config.DeleteProfile(name); err != nil {
+		return err
+	}
+
+	v.Success("Profile %q deleted", name)
+	return nil
+}
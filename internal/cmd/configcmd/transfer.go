@@ -0,0 +1,252 @@
+package configcmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/config"
+	"github.com/open-cli-collective/newrelic-cli/internal/confirm"
+	"github.com/open-cli-collective/newrelic-cli/internal/validate"
+)
+
+// credentialsFileVersion is the current version of the export format
+const credentialsFileVersion = 1
+
+// credentialsFile is the portable form of the stored credentials
+type credentialsFile struct {
+	Version        int    `json:"version"`
+	APIKey         string `json:"api_key,omitempty"`
+	APIKeyRedacted bool   `json:"api_key_redacted,omitempty"`
+	AccountID      string `json:"account_id,omitempty"`
+	Region         string `json:"region,omitempty"`
+}
+
+// exportOptions holds options for the export command
+type exportOptions struct {
+	*root.Options
+	file     string
+	noRedact bool
+	force    bool
+}
+
+func newExportCmd(opts *root.Options) *cobra.Command {
+	exportOpts := &exportOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export stored credentials to a file",
+		Long: `Export the stored credentials of the default profile (API key, account ID,
+and region) to a JSON file for use with 'nrq config import' on another machine.
+
+The API key is masked unless --no-redact is specified. Environment variables
+are not exported. The file is written with permissions 0600. An existing file
+is only replaced when --force is specified.`,
+		Example: `  nrq config export --file credentials.json
+  nrq config export --file credentials.json --no-redact
+  nrq config export --file credentials.json --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExport(exportOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&exportOpts.file, "file", "", "Path of the file to write (required)")
+	cmd.Flags().BoolVar(&exportOpts.noRedact, "no-redact", false, "Include the unmasked API key")
+	root.AddForceFlag(cmd, &exportOpts.force, "Overwrite an existing file")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runExport(opts *exportOptions) error {
+	v := opts.View()
+
+	creds, err := config.GetStoredCredentials(config.DefaultProfile)
+	if err != nil {
+		return err
+	}
+	if creds.APIKey == "" && creds.AccountID == "" && creds.Region == "" {
+		return fmt.Errorf("no stored credentials to export - run 'nrq config set-api-key' first")
+	}
+
+	export := credentialsFile{
+		Version:   credentialsFileVersion,
+		APIKey:    creds.APIKey,
+		AccountID: creds.AccountID,
+		Region:    creds.Region,
+	}
+	if !opts.noRedact && export.APIKey != "" {
+		export.APIKey = maskAPIKey(export.APIKey)
+		export.APIKeyRedacted = true
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode credentials: %w", err)
+	}
+
+	if err := writeCredentialsFile(opts.file, append(data, '\n'), opts.force); err != nil {
+		return err
+	}
+
+	if opts.noRedact && creds.APIKey != "" {
+		v.Warning("Warning: %s contains your API key in plain text.", opts.file)
+		v.Warning("         Anyone with this file can access your New Relic account.")
+		v.Warning("         Store it securely and delete it once imported.")
+	}
+
+	v.Success("Exported credentials to %s", opts.file)
+	return nil
+}
+
+// writeCredentialsFile writes data to path, readable only by the owner. An
+// existing file is refused unless force is set, and is then truncated and
+// restricted to 0600 before anything is written to it, since the mode given
+// to OpenFile only applies to new files.
+func writeCredentialsFile(path string, data []byte, force bool) error {
+	flags := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	if force {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+
+	f, err := os.OpenFile(path, flags, 0600)
+	if err != nil {
+		if os.IsExist(err) {
+			return fmt.Errorf("%s already exists - use --force to overwrite it", path)
+		}
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	defer f.Close()
+
+	if err := f.Chmod(0600); err != nil {
+		return fmt.Errorf("failed to restrict permissions on %s: %w", path, err)
+	}
+	if _, err := f.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return f.Close()
+}
+
+// importOptions holds options for the import command
+type importOptions struct {
+	*root.Options
+	file  string
+	force bool
+}
+
+func newImportCmd(opts *root.Options) *cobra.Command {
+	importOpts := &importOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import credentials from an exported file",
+		Long: `Import credentials written by 'nrq config export' into the default profile.
+
+A masked API key is skipped; set it afterwards with 'nrq config set-api-key'.
+Requires confirmation before replacing stored credentials unless --force is
+specified.`,
+		Example: `  nrq config import --file credentials.json
+  nrq config import --file credentials.json --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runImport(importOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&importOpts.file, "file", "", "Path of the exported credentials file (required)")
+	root.AddForceFlag(cmd, &importOpts.force, "Skip confirmation prompt")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runImport(opts *importOptions) error {
+	v := opts.View()
+
+	data, err := os.ReadFile(opts.file)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", opts.file, err)
+	}
+
+	creds, err := parseCredentialsFile(data)
+	if err != nil {
+		return err
+	}
+
+	if !opts.force {
+		stored, err := config.GetStoredCredentials(config.DefaultProfile)
+		if err != nil {
+			return err
+		}
+		if stored.APIKey != "" || stored.AccountID != "" || stored.Region != "" {
+			p := &confirm.Prompter{
+				In:  opts.Stdin,
+				Out: opts.Stderr,
+			}
+			if !p.Confirm("Replace stored credentials?") {
+				v.Warning("Operation canceled")
+				return nil
+			}
+		}
+	}
+
+	if creds.APIKeyRedacted {
+		v.Warning("Warning: API key was masked on export and was not imported")
+		v.Warning("         Run 'nrq config set-api-key' to set it")
+	} else if creds.APIKey != "" {
+		if err := config.SetAPIKey(creds.APIKey); err != nil {
+			return fmt.Errorf("failed to store API key: %w", err)
+		}
+		v.Success("Imported API key")
+	}
+
+	if creds.AccountID != "" {
+		if err := config.SetAccountID(creds.AccountID); err != nil {
+			return fmt.Errorf("failed to store account ID: %w", err)
+		}
+		v.Success("Imported account ID: %s", creds.AccountID)
+	}
+
+	if creds.Region != "" {
+		if err := config.SetRegion(creds.Region); err != nil {
+			return fmt.Errorf("failed to store region: %w", err)
+		}
+		v.Success("Imported region: %s", creds.Region)
+	}
+
+	return nil
+}
+
+// parseCredentialsFile decodes and validates an exported credentials file
+func parseCredentialsFile(data []byte) (*credentialsFile, error) {
+	var creds credentialsFile
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, fmt.Errorf("invalid credentials file: %w", err)
+	}
+
+	if creds.Version != credentialsFileVersion {
+		return nil, fmt.Errorf("unsupported credentials file version %d (expected %d)", creds.Version, credentialsFileVersion)
+	}
+
+	if creds.APIKey != "" && !creds.APIKeyRedacted {
+		if _, err := validate.APIKey(creds.APIKey); err != nil {
+			return nil, err
+		}
+	}
+	if creds.AccountID != "" {
+		if err := validate.AccountID(creds.AccountID); err != nil {
+			return nil, err
+		}
+	}
+	if creds.Region != "" {
+		creds.Region = strings.ToUpper(creds.Region)
+		if err := validate.Region(creds.Region); err != nil {
+			return nil, err
+		}
+	}
+
+	return &creds, nil
+}
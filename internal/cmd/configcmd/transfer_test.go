@@ -0,0 +1,89 @@
+package configcmd
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCredentialsFile(t *testing.T) {
+	creds, err := parseCredentialsFile([]byte(`{
+		"version": 1,
+		"api_key": "NRAK-ABCDEFGHIJKLMNOPQRSTUVWXYZ1",
+		"account_id": "1234567",
+		"region": "eu"
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "NRAK-ABCDEFGHIJKLMNOPQRSTUVWXYZ1", creds.APIKey)
+	assert.Equal(t, "1234567", creds.AccountID)
+	assert.Equal(t, "EU", creds.Region)
+}
+
+func TestParseCredentialsFile_RedactedKeySkipsValidation(t *testing.T) {
+	creds, err := parseCredentialsFile([]byte(`{"version": 1, "api_key": "NRAK-ABC****XYZ1", "api_key_redacted": true}`))
+	require.NoError(t, err)
+	assert.True(t, creds.APIKeyRedacted)
+}
+
+func TestParseCredentialsFile_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"invalid json", `{`, "invalid credentials file"},
+		{"missing version", `{"account_id": "1234567"}`, "unsupported credentials file version 0"},
+		{"future version", `{"version": 2}`, "unsupported credentials file version 2"},
+		{"bad account id", `{"version": 1, "account_id": "abc"}`, "account"},
+		{"bad region", `{"version": 1, "region": "APAC"}`, "region"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseCredentialsFile([]byte(tt.data))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestMaskAPIKey(t *testing.T) {
+	assert.Equal(t, "NRAK-ABC****WXYZ", maskAPIKey("NRAK-ABCDEFGWXYZ"))
+	assert.Equal(t, "*****", maskAPIKey("short"))
+}
+
+func TestWriteCredentialsFile_New(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+
+	require.NoError(t, writeCredentialsFile(path, []byte("{}\n"), false))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "{}\n", string(data))
+}
+
+func TestWriteCredentialsFile_ExistingNeedsForce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, os.WriteFile(path, []byte("keep"), 0644))
+
+	err := writeCredentialsFile(path, []byte("{}\n"), false)
+	assert.EqualError(t, err, path+" already exists - use --force to overwrite it")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "keep", string(data))
+
+	require.NoError(t, writeCredentialsFile(path, []byte("{}\n"), true))
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "{}\n", string(data))
+
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm(), "an overwritten file is restricted too")
+	}
+}
@@ -0,0 +1,95 @@
+package dashboards
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/validate"
+)
+
+// copyOptions holds options for the copy command
+type copyOptions struct {
+	*root.Options
+	name      string
+	accountID string
+}
+
+func newCopyCmd(opts *root.Options) *cobra.Command {
+	copyOpts := &copyOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "copy <guid>",
+		Short: "Copy a dashboard",
+		Long: `Create a copy of a dashboard with all of its pages and widgets.
+
+The copy is named "Copy of <original name>" unless --name is given. Use
+--account-id to create the copy in another account; widget queries are
+copied unchanged and still query the accounts they referenced.`,
+		Example: `  nrq dashboards copy "MXxWSVp8REFTSEJPQVJEfDEyMzQ1"
+  nrq dashboards copy "MXxWSVp8REFTSEJPQVJEfDEyMzQ1" --name "Staging Overview"
+  nrq dashboards copy "MXxWSVp8REFTSEJPQVJEfDEyMzQ1" --account-id 99999`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCopy(copyOpts, api.EntityGUID(args[0]))
+		},
+	}
+
+	cmd.Flags().StringVar(&copyOpts.name, "name", "", "Name of the copy (default: \"Copy of <name>\")")
+	cmd.Flags().StringVar(&copyOpts.accountID, "account-id", "", "Account to create the copy in (default: configured account)")
+
+	return cmd
+}
+
+func runCopy(opts *copyOptions, guid api.EntityGUID) error {
+	if opts.accountID != "" {
+		if err := validate.AccountID(opts.accountID); err != nil {
+			return err
+		}
+	}
+
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	dashboard, err := client.GetDashboard(guid)
+	if err != nil {
+		return err
+	}
+
+	input := dashboard.ToCreateInput()
+	input.Name = copyName(dashboard.Name, opts.name)
+
+	if opts.accountID != "" {
+		client.AccountID = api.AccountID(opts.accountID)
+	}
+
+	created, err := client.CreateDashboard(input)
+	if err != nil {
+		return fmt.Errorf("failed to create dashboard: %w", err)
+	}
+
+	switch v.Format {
+	case "json":
+		return v.JSON(created)
+	case "plain":
+		return v.Plain([][]string{{created.GUID.String(), created.Name}})
+	default:
+		v.Success("Dashboard \"%s\" copied to \"%s\"", dashboard.Name, created.Name)
+		v.Print("GUID: %s\n", created.GUID.String())
+		return nil
+	}
+}
+
+// copyName returns the name for a dashboard copy
+func copyName(original, name string) string {
+	if name != "" {
+		return name
+	}
+	return "Copy of " + original
+}
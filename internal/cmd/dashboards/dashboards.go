@@ -22,17 +22,25 @@ func Register(rootCmd *cobra.Command, opts *root.Options) {
 	}
 
 	dashboardsCmd.AddCommand(newListCmd(opts))
+	dashboardsCmd.AddCommand(newSearchCmd(opts))
 	dashboardsCmd.AddCommand(newGetCmd(opts))
 	dashboardsCmd.AddCommand(newCreateCmd(opts))
 	dashboardsCmd.AddCommand(newUpdateCmd(opts))
 	dashboardsCmd.AddCommand(newDeleteCmd(opts))
+	dashboardsCmd.AddCommand(newPagesCmd(opts))
+	dashboardsCmd.AddCommand(newExportCmd(opts))
+	dashboardsCmd.AddCommand(newCopyCmd(opts))
 
 	rootCmd.AddCommand(dashboardsCmd)
 }
 
 type listOptions struct {
 	*root.Options
-	limit int
+	limit      int
+	noPaginate bool
+	sort       string
+	sortDesc   bool
+	filter     string
 }
 
 func newListCmd(opts *root.Options) *cobra.Command {
@@ -44,16 +52,26 @@ func newListCmd(opts *root.Options) *cobra.Command {
 		Long: `List all dashboards in your account.
 
 Displays dashboard GUID, name, and account ID. The GUID is a base64-encoded
-entity identifier that can be used with 'dashboards get'.`,
+entity identifier that can be used with 'dashboards get'.
+
+All pages of results are fetched; use --no-paginate to fetch only the first
+page (up to 200 dashboards).`,
 		Example: `  nrq dashboards list
   nrq dashboards list -o json
-  nrq dashboards list --limit 10`,
+  nrq dashboards list --limit 10
+  nrq dashboards list --no-paginate
+  nrq dashboards list --sort name
+  nrq dashboards list --filter "name~prod"`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runList(listOpts)
 		},
 	}
 
 	cmd.Flags().IntVarP(&listOpts.limit, "limit", "l", 0, "Limit number of results (0 = no limit)")
+	cmd.Flags().BoolVar(&listOpts.noPaginate, "no-paginate", false, "Fetch only the first page of results")
+	cmd.Flags().StringVar(&listOpts.sort, "sort", "", "Sort rows by this column (e.g., name, account_id)")
+	cmd.Flags().BoolVar(&listOpts.sortDesc, "sort-desc", false, "Sort in descending order")
+	cmd.Flags().StringVar(&listOpts.filter, "filter", "", "Only show rows matching COLUMN=VALUE (exact) or COLUMN~VALUE (contains)")
 
 	return cmd
 }
@@ -64,23 +82,57 @@ func runList(opts *listOptions) error {
 		return err
 	}
 
-	dashboards, err := client.ListDashboards()
+	var dashboards []api.Dashboard
+	if opts.noPaginate {
+		dashboards, _, err = client.ListDashboardsPage("")
+	} else {
+		spinner := opts.Spinner("Fetching dashboards")
+		dashboards, err = client.ListDashboardsAll()
+		spinner.Stop()
+	}
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	if len(dashboards) == 0 {
+		v.Println("No dashboards found")
+		return nil
+	}
+
+	headers, rows := dashboardRows(dashboards)
+	dashboards, rows, err = view.FilterSort(dashboards, rows, headers, opts.filter, opts.sort, opts.sortDesc)
 	if err != nil {
 		return err
 	}
 
 	// Apply limit
-	if opts.limit > 0 && len(dashboards) > opts.limit {
-		dashboards = dashboards[:opts.limit]
+	if opts.limit > 0 {
+		if len(dashboards) > opts.limit {
+			dashboards = dashboards[:opts.limit]
+		}
+		if len(rows) > opts.limit {
+			rows = rows[:opts.limit]
+		}
 	}
 
-	v := opts.View()
+	return v.Render(headers, rows, dashboards)
+}
 
+// renderDashboards renders a list of dashboards
+func renderDashboards(v *view.View, dashboards []api.Dashboard) error {
 	if len(dashboards) == 0 {
 		v.Println("No dashboards found")
 		return nil
 	}
 
+	headers, rows := dashboardRows(dashboards)
+	return v.Render(headers, rows, dashboards)
+}
+
+// dashboardRows returns the table columns for dashboards
+func dashboardRows(dashboards []api.Dashboard) ([]string, [][]string) {
 	headers := []string{"GUID", "NAME", "ACCOUNT ID"}
 	rows := make([][]string, len(dashboards))
 	for i, d := range dashboards {
@@ -90,8 +142,52 @@ func runList(opts *listOptions) error {
 			fmt.Sprintf("%d", d.AccountID),
 		}
 	}
+	return headers, rows
+}
 
-	return v.Render(headers, rows, dashboards)
+// searchOptions holds options for the search command
+type searchOptions struct {
+	*root.Options
+	limit int
+}
+
+func newSearchCmd(opts *root.Options) *cobra.Command {
+	searchOpts := &searchOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "search <pattern>",
+		Short: "Search dashboards by name",
+		Long:  "Search the dashboards in your account whose names contain a pattern.",
+		Example: `  nrq dashboards search "Production"
+  nrq dashboards search "API" -o json
+  nrq dashboards search "Overview" --limit 5`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSearch(searchOpts, args[0])
+		},
+	}
+
+	cmd.Flags().IntVarP(&searchOpts.limit, "limit", "l", 0, "Limit number of results (0 = no limit)")
+
+	return cmd
+}
+
+func runSearch(opts *searchOptions, pattern string) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	dashboards, err := client.SearchDashboards(pattern)
+	if err != nil {
+		return err
+	}
+
+	if opts.limit > 0 && len(dashboards) > opts.limit {
+		dashboards = dashboards[:opts.limit]
+	}
+
+	return renderDashboards(opts.View(), dashboards)
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
@@ -194,6 +290,7 @@ Permissions: PUBLIC_READ_WRITE, PUBLIC_READ_ONLY, PRIVATE`,
 
 	cmd.Flags().StringVarP(&createOpts.fromFile, "from-file", "f", "", "Path to JSON file containing dashboard definition (required)")
 	_ = cmd.MarkFlagRequired("from-file")
+	root.AddDryRunFlag(cmd, opts)
 
 	return cmd
 }
@@ -220,6 +317,10 @@ func runCreate(opts *createOptions) error {
 		return fmt.Errorf("at least one page is required")
 	}
 
+	if opts.DryRun {
+		return opts.PrintDryRun(fmt.Sprintf("create dashboard %q", input.Name), input)
+	}
+
 	client, err := opts.APIClient()
 	if err != nil {
 		return err
@@ -249,6 +350,7 @@ func runCreate(opts *createOptions) error {
 type updateOptions struct {
 	*root.Options
 	fromFile string
+	showDiff bool
 }
 
 func newUpdateCmd(opts *root.Options) *cobra.Command {
@@ -260,12 +362,18 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 		Long: `Update an existing dashboard from a JSON file.
 
 The JSON file format is the same as for 'dashboards create'.
-The GUID identifies which dashboard to update.`,
+The GUID identifies which dashboard to update.
+
+With --show-diff or --verbose, the dashboard is fetched before and after
+the update and the changes are printed to stderr as a diff.`,
 		Example: `  # Update a dashboard from a JSON file
   nrq dashboards update "MjcxMjY0MHxWSVp8REFTSEJPQVJEXDI5Mjg=" --from-file dashboard.json
 
   # Update and output result as JSON
-  nrq dashboards update "MjcxMjY0MHxWSVp8REFTSEJPQVJEXDI5Mjg=" --from-file dashboard.json -o json`,
+  nrq dashboards update "MjcxMjY0MHxWSVp8REFTSEJPQVJEXDI5Mjg=" --from-file dashboard.json -o json
+
+  # Show what changed
+  nrq dashboards update "MjcxMjY0MHxWSVp8REFTSEJPQVJEXDI5Mjg=" --from-file dashboard.json --show-diff`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runUpdate(updateOpts, api.EntityGUID(args[0]))
@@ -273,7 +381,9 @@ The GUID identifies which dashboard to update.`,
 	}
 
 	cmd.Flags().StringVarP(&updateOpts.fromFile, "from-file", "f", "", "Path to JSON file containing dashboard definition (required)")
+	cmd.Flags().BoolVar(&updateOpts.showDiff, "show-diff", false, "Print the changes made to the dashboard to stderr")
 	_ = cmd.MarkFlagRequired("from-file")
+	root.AddDryRunFlag(cmd, opts)
 
 	return cmd
 }
@@ -300,16 +410,38 @@ func runUpdate(opts *updateOptions, guid api.EntityGUID) error {
 		return fmt.Errorf("at least one page is required")
 	}
 
+	if opts.DryRun {
+		return opts.PrintDryRun(fmt.Sprintf("update dashboard %s", guid), input)
+	}
+
 	client, err := opts.APIClient()
 	if err != nil {
 		return err
 	}
 
+	showDiff := opts.showDiff || opts.Verbose
+	var before *api.DashboardDetail
+	if showDiff {
+		if before, err = client.GetDashboard(guid); err != nil {
+			return err
+		}
+	}
+
 	dashboard, err := client.UpdateDashboard(guid, &input)
 	if err != nil {
 		return fmt.Errorf("failed to update dashboard: %w", err)
 	}
 
+	if showDiff {
+		after, err := client.GetDashboard(guid)
+		if err != nil {
+			return err
+		}
+		if err := opts.PrintChanges(before, after); err != nil {
+			return err
+		}
+	}
+
 	switch v.Format {
 	case "json":
 		return v.JSON(dashboard)
@@ -354,7 +486,8 @@ WARNING: This action cannot be undone.`,
 		},
 	}
 
-	cmd.Flags().BoolVarP(&deleteOpts.force, "force", "f", false, "Skip confirmation prompt")
+	root.AddForceFlag(cmd, &deleteOpts.force, "Skip confirmation prompt")
+	root.AddDryRunFlag(cmd, opts)
 
 	return cmd
 }
@@ -368,11 +501,15 @@ func runDelete(opts *deleteOptions, guid api.EntityGUID) error {
 		return err
 	}
 
-	dashboard, err := client.GetDashboard(guid)
+	dashboard, err := client.GetEntity(guid)
 	if err != nil {
 		return fmt.Errorf("failed to get dashboard: %w", err)
 	}
 
+	if opts.DryRun {
+		return opts.PrintDryRun(fmt.Sprintf("delete dashboard %q (GUID: %s)", dashboard.Name, guid), nil)
+	}
+
 	if !opts.force {
 		p := &confirm.Prompter{
 			In:  opts.Stdin,
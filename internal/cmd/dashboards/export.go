@@ -0,0 +1,82 @@
+package dashboards
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+// exportOptions holds options for the export command
+type exportOptions struct {
+	*root.Options
+	file           string
+	stripAccountID bool
+}
+
+func newExportCmd(opts *root.Options) *cobra.Command {
+	exportOpts := &exportOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "export <guid>",
+		Short: "Export a dashboard definition to a JSON file",
+		Long: `Export a dashboard definition in the format accepted by 'dashboards create'.
+
+Page GUIDs and widget IDs are removed so the file can be edited and used to
+create a new dashboard. Without --file, the definition is written to stdout.
+
+Use --strip-account-id to replace the account IDs in widget NRQL queries
+with 0, making the export portable between accounts.`,
+		Example: `  nrq dashboards export "MXxWSVp8REFTSEJPQVJEfDEyMzQ1" --file dashboard.json
+  nrq dashboards export "MXxWSVp8REFTSEJPQVJEfDEyMzQ1" --file dashboard.json --strip-account-id
+  nrq dashboards export "MXxWSVp8REFTSEJPQVJEfDEyMzQ1" > dashboard.json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExport(exportOpts, api.EntityGUID(args[0]))
+		},
+	}
+
+	cmd.Flags().StringVar(&exportOpts.file, "file", "", "Path of the file to write (default: stdout)")
+	cmd.Flags().BoolVar(&exportOpts.stripAccountID, "strip-account-id", false, "Replace account IDs in widget queries with 0")
+
+	return cmd
+}
+
+func runExport(opts *exportOptions, guid api.EntityGUID) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	dashboard, err := client.GetDashboard(guid)
+	if err != nil {
+		return err
+	}
+
+	input := dashboard.ToCreateInput()
+	if opts.stripAccountID {
+		input.SetQueryAccountID(0)
+	}
+
+	data, err := json.MarshalIndent(input, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode dashboard: %w", err)
+	}
+	data = append(data, '\n')
+
+	if opts.file == "" {
+		_, err := opts.Stdout.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(opts.file, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", opts.file, err)
+	}
+
+	opts.View().Success("Exported dashboard \"%s\" to %s", dashboard.Name, opts.file)
+	return nil
+}
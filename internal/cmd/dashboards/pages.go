@@ -0,0 +1,157 @@
+package dashboards
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+func newPagesCmd(opts *root.Options) *cobra.Command {
+	pagesCmd := &cobra.Command{
+		Use:     "pages",
+		Aliases: []string{"page"},
+		Short:   "Manage dashboard pages",
+	}
+
+	pagesCmd.AddCommand(newPagesListCmd(opts))
+	pagesCmd.AddCommand(newPagesRenameCmd(opts))
+
+	return pagesCmd
+}
+
+func newPagesListCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <dashboard-guid>",
+		Short: "List the pages of a dashboard",
+		Long: `List the pages of a dashboard with their GUIDs and widget counts.
+
+Page GUIDs can be used with 'dashboards pages rename'.`,
+		Example: `  nrq dashboards pages list "MjcxMjY0MHxWSVp8REFTSEJPQVJEXDI5Mjg="
+  nrq dashboards pages list "MjcxMjY0MHxWSVp8REFTSEJPQVJEXDI5Mjg=" -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPagesList(opts, api.EntityGUID(args[0]))
+		},
+	}
+}
+
+func runPagesList(opts *root.Options, guid api.EntityGUID) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	dashboard, err := client.GetDashboard(guid)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	if len(dashboard.Pages) == 0 {
+		v.Println("No pages found")
+		return nil
+	}
+
+	headers := []string{"GUID", "NAME", "WIDGETS"}
+	rows := make([][]string, len(dashboard.Pages))
+	for i, p := range dashboard.Pages {
+		rows[i] = []string{
+			p.GUID.String(),
+			view.Truncate(p.Name, 40),
+			fmt.Sprintf("%d", len(p.Widgets)),
+		}
+	}
+
+	return v.Render(headers, rows, dashboard.Pages)
+}
+
+// pagesRenameOptions holds options for the pages rename command
+type pagesRenameOptions struct {
+	*root.Options
+	name     string
+	pageName string
+}
+
+func newPagesRenameCmd(opts *root.Options) *cobra.Command {
+	renameOpts := &pagesRenameOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "rename <dashboard-guid> [page-guid]",
+		Short: "Rename a dashboard page",
+		Long: `Rename a single dashboard page without changing its widgets.
+
+Identify the page by its GUID (from 'dashboards pages list') or by its
+current name with --page-name. All other pages are left unchanged.`,
+		Example: `  # Rename a page by GUID
+  nrq dashboards pages rename "MjcxMjY0MHxWSVp8REFTSEJPQVJEXDI5Mjg=" "MjcxMjY0MHxWSVp8REFTSEJPQVJEfDI5Mjk=" --name "Errors"
+
+  # Rename a page by its current name
+  nrq dashboards pages rename "MjcxMjY0MHxWSVp8REFTSEJPQVJEXDI5Mjg=" --page-name "Page 1" --name "Errors"`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var pageGUID api.EntityGUID
+			if len(args) == 2 {
+				pageGUID = api.EntityGUID(args[1])
+			}
+			return runPagesRename(renameOpts, api.EntityGUID(args[0]), pageGUID)
+		},
+	}
+
+	cmd.Flags().StringVar(&renameOpts.name, "name", "", "New page name (required)")
+	cmd.Flags().StringVar(&renameOpts.pageName, "page-name", "", "Current page name (alternative to page GUID)")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func runPagesRename(opts *pagesRenameOptions, dashboardGUID, pageGUID api.EntityGUID) error {
+	if pageGUID == "" && opts.pageName == "" {
+		return fmt.Errorf("either a page GUID or --page-name is required")
+	}
+	if pageGUID != "" && opts.pageName != "" {
+		return fmt.Errorf("specify either a page GUID or --page-name, not both")
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	dashboard, err := client.GetDashboard(dashboardGUID)
+	if err != nil {
+		return err
+	}
+
+	if opts.pageName != "" {
+		page, ok := dashboard.PageByName(opts.pageName)
+		if !ok {
+			return fmt.Errorf("page not found in dashboard: %s", opts.pageName)
+		}
+		pageGUID = page.GUID
+	}
+
+	updated, err := client.RenameDashboardPage(dashboard, pageGUID, opts.name)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		return v.JSON(updated)
+	case "plain":
+		rows := [][]string{
+			{pageGUID.String(), opts.name},
+		}
+		return v.Plain(rows)
+	default:
+		v.Success("Page renamed to \"%s\"", opts.name)
+		return nil
+	}
+}
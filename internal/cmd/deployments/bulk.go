@@ -0,0 +1,169 @@
+package deployments
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+// BulkDeploymentInput is one deployment in a bulk-create file
+type BulkDeploymentInput struct {
+	AppID       json.Number `json:"app_id"`
+	Revision    string      `json:"revision"`
+	Description string      `json:"description,omitempty"`
+	User        string      `json:"user,omitempty"`
+	Changelog   string      `json:"changelog,omitempty"`
+}
+
+// bulkResult is the outcome of creating one deployment
+type bulkResult struct {
+	AppID        string `json:"app_id"`
+	Revision     string `json:"revision"`
+	Status       string `json:"status"`
+	DeploymentID int    `json:"deployment_id,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+type bulkCreateOptions struct {
+	*root.Options
+	file        string
+	concurrency int
+}
+
+func newBulkCreateCmd(opts *root.Options) *cobra.Command {
+	bulkOpts := &bulkCreateOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "bulk-create",
+		Short: "Create deployment markers for several applications",
+		Long: `Create deployment markers from a JSON file containing an array of
+deployments, for example after a monorepo release:
+
+  [
+    {"app_id": 12345678, "revision": "v1.2.3", "description": "Release", "user": "ci"},
+    {"app_id": 23456789, "revision": "v1.2.3", "changelog": "See CHANGELOG.md"}
+  ]
+
+app_id and revision are required. Deployments are created in order, or
+--concurrency at a time. A failed deployment does not stop the others; the
+summary shows the status of each, and the command exits with an error if
+any failed.`,
+		Example: `  nrq deployments bulk-create --file deployments.json
+  nrq deployments bulk-create --file deployments.json --concurrency 4 -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBulkCreate(bulkOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&bulkOpts.file, "file", "", "JSON file of deployments to create (required)")
+	cmd.Flags().IntVar(&bulkOpts.concurrency, "concurrency", 1, "Number of deployments to create at once")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runBulkCreate(opts *bulkCreateOptions) error {
+	if opts.concurrency < 1 {
+		return fmt.Errorf("invalid --concurrency %d: must be at least 1", opts.concurrency)
+	}
+
+	data, err := os.ReadFile(opts.file)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", opts.file, err)
+	}
+
+	inputs, err := parseBulkDeployments(data)
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	progress := opts.ProgressBar(len(inputs))
+
+	results := make([]bulkResult, len(inputs))
+	sem := make(chan struct{}, opts.concurrency)
+	var wg sync.WaitGroup
+	for i, in := range inputs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, in BulkDeploymentInput) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result := bulkResult{AppID: in.AppID.String(), Revision: in.Revision, Status: "created"}
+			deployment, err := client.CreateDeployment(in.AppID.String(), in.Revision, in.Description, in.User, in.Changelog)
+			if err != nil {
+				result.Status = "failed"
+				result.Error = err.Error()
+			} else {
+				result.DeploymentID = deployment.ID
+			}
+			results[i] = result
+			progress.Increment()
+		}(i, in)
+	}
+	wg.Wait()
+	progress.Done()
+
+	v := opts.View()
+
+	failed := 0
+	headers := []string{"APP ID", "REVISION", "STATUS", "DEPLOYMENT ID"}
+	rows := make([][]string, len(results))
+	for i, r := range results {
+		status, id := r.Status, ""
+		if r.Error != "" {
+			failed++
+			status = view.Truncate(r.Status+": "+r.Error, 60)
+		} else {
+			id = strconv.Itoa(r.DeploymentID)
+		}
+		rows[i] = []string{r.AppID, view.Truncate(r.Revision, 20), status, id}
+	}
+
+	if err := v.Render(headers, rows, results); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d deployments failed", failed, len(results))
+	}
+	return nil
+}
+
+// parseBulkDeployments decodes and validates a bulk-create file
+func parseBulkDeployments(data []byte) ([]BulkDeploymentInput, error) {
+	var inputs []BulkDeploymentInput
+	if err := json.Unmarshal(data, &inputs); err != nil {
+		return nil, fmt.Errorf("invalid deployments file: %w", err)
+	}
+
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("deployments file contains no deployments")
+	}
+
+	for i, in := range inputs {
+		if in.AppID == "" {
+			return nil, fmt.Errorf("deployment %d: app_id is required", i+1)
+		}
+		if _, err := in.AppID.Int64(); err != nil {
+			return nil, fmt.Errorf("deployment %d: invalid app_id %q: must be numeric", i+1, in.AppID)
+		}
+		if in.Revision == "" {
+			return nil, fmt.Errorf("deployment %d: revision is required", i+1)
+		}
+	}
+
+	return inputs, nil
+}
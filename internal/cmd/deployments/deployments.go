@@ -8,6 +8,8 @@ import (
 
 	"github.com/open-cli-collective/newrelic-cli/api"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/confirm"
+	"github.com/open-cli-collective/newrelic-cli/internal/validate"
 	"github.com/open-cli-collective/newrelic-cli/internal/view"
 )
 
@@ -22,6 +24,8 @@ func Register(rootCmd *cobra.Command, opts *root.Options) {
 	deploymentsCmd.AddCommand(newListCmd(opts))
 	deploymentsCmd.AddCommand(newCreateCmd(opts))
 	deploymentsCmd.AddCommand(newSearchCmd(opts))
+	deploymentsCmd.AddCommand(newBulkCreateCmd(opts))
+	deploymentsCmd.AddCommand(newDeleteCmd(opts))
 
 	rootCmd.AddCommand(deploymentsCmd)
 }
@@ -80,17 +84,9 @@ Examples:
 }
 
 func runList(opts *listOptions, args []string) error {
-	// Determine the app identifier from flags or positional arg
-	var identifier string
-	switch {
-	case opts.name != "":
-		identifier = opts.name
-	case opts.guid != "":
-		identifier = opts.guid
-	case len(args) > 0:
-		identifier = args[0]
-	default:
-		return fmt.Errorf("application must be specified via positional argument, --name, or --guid")
+	identifier, err := appIdentifier(opts.name, opts.guid, args)
+	if err != nil {
+		return err
 	}
 
 	client, err := opts.APIClient()
@@ -152,6 +148,21 @@ func runList(opts *listOptions, args []string) error {
 	return v.Render(headers, rows, deployments)
 }
 
+// appIdentifier returns the application given by --name, --guid, or the
+// positional argument, in that order of precedence
+func appIdentifier(name, guid string, args []string) (string, error) {
+	switch {
+	case name != "":
+		return name, nil
+	case guid != "":
+		return guid, nil
+	case len(args) > 0:
+		return args[0], nil
+	default:
+		return "", fmt.Errorf("application must be specified via positional argument, --name, or --guid")
+	}
+}
+
 type createOptions struct {
 	*root.Options
 	name        string
@@ -196,17 +207,9 @@ Examples:
 }
 
 func runCreate(opts *createOptions, args []string) error {
-	// Determine the app identifier from flags or positional arg
-	var identifier string
-	switch {
-	case opts.name != "":
-		identifier = opts.name
-	case opts.guid != "":
-		identifier = opts.guid
-	case len(args) > 0:
-		identifier = args[0]
-	default:
-		return fmt.Errorf("application must be specified via positional argument, --name, or --guid")
+	identifier, err := appIdentifier(opts.name, opts.guid, args)
+	if err != nil {
+		return err
 	}
 
 	client, err := opts.APIClient()
@@ -243,6 +246,89 @@ func runCreate(opts *createOptions, args []string) error {
 	}
 }
 
+type deleteOptions struct {
+	*root.Options
+	name         string
+	guid         string
+	deploymentID string
+	force        bool
+}
+
+func newDeleteCmd(opts *root.Options) *cobra.Command {
+	deleteOpts := &deleteOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "delete [app-id]",
+		Short: "Delete a deployment marker",
+		Long: `Delete a deployment marker from an application.
+
+The application can be specified by:
+  - Numeric app ID (positional argument)
+  - Application name (--name flag)
+  - Entity GUID (--guid flag)
+
+Requires confirmation unless --force is specified.
+
+Examples:
+  nrq deployments delete 12345678 --deployment-id 9001
+  nrq deployments delete --name "my-app" --deployment-id 9001 --force`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDelete(deleteOpts, args)
+		},
+	}
+
+	cmd.Flags().StringVarP(&deleteOpts.name, "name", "n", "", "Application name to look up")
+	cmd.Flags().StringVarP(&deleteOpts.guid, "guid", "g", "", "Entity GUID to look up")
+	cmd.Flags().StringVar(&deleteOpts.deploymentID, "deployment-id", "", "ID of the deployment to delete (required)")
+	root.AddForceFlag(cmd, &deleteOpts.force, "Skip confirmation prompt")
+	_ = cmd.MarkFlagRequired("deployment-id")
+
+	return cmd
+}
+
+func runDelete(opts *deleteOptions, args []string) error {
+	identifier, err := appIdentifier(opts.name, opts.guid, args)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	appID, err := client.ResolveAppID(identifier)
+	if err != nil {
+		return fmt.Errorf("failed to resolve application: %w", err)
+	}
+
+	deployment, err := client.GetDeployment(appID, opts.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to get deployment: %w", err)
+	}
+
+	if !opts.force {
+		p := &confirm.Prompter{
+			In:  opts.Stdin,
+			Out: opts.Stderr,
+		}
+		if !p.Confirm(fmt.Sprintf("Delete deployment %d (revision %s, %s)?", deployment.ID, deployment.Revision, deployment.Timestamp)) {
+			v.Warning("Operation canceled")
+			return nil
+		}
+	}
+
+	if err := client.DeleteDeployment(appID, opts.deploymentID); err != nil {
+		return err
+	}
+
+	v.Success("Deployment %d deleted", deployment.ID)
+	return nil
+}
+
 type searchOptions struct {
 	*root.Options
 	since string
@@ -316,6 +402,14 @@ func runSearch(opts *searchOptions, whereClause string) error {
 		nrql += fmt.Sprintf(" LIMIT %d", opts.limit)
 	}
 
+	warning, err := validate.NRQL(nrql)
+	if err != nil {
+		return err
+	}
+	if warning != "" {
+		opts.View().Warning("Warning: %s", warning)
+	}
+
 	result, err := client.QueryNRQL(nrql)
 	if err != nil {
 		return err
@@ -0,0 +1,55 @@
+package deployments
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBulkDeployments(t *testing.T) {
+	inputs, err := parseBulkDeployments([]byte(`[
+		{"app_id": 12345678, "revision": "v1.2.3", "user": "ci"},
+		{"app_id": "23456789", "revision": "v1.2.3", "changelog": "Fixes"}
+	]`))
+	require.NoError(t, err)
+	require.Len(t, inputs, 2)
+	assert.Equal(t, "12345678", inputs[0].AppID.String())
+	assert.Equal(t, "ci", inputs[0].User)
+	assert.Equal(t, "23456789", inputs[1].AppID.String())
+}
+
+func TestParseBulkDeployments_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"not an array", `{"app_id": 1}`, "invalid deployments file"},
+		{"empty", `[]`, "no deployments"},
+		{"missing app id", `[{"revision": "v1"}]`, "deployment 1: app_id is required"},
+		{"name as app id", `[{"app_id": "my-app", "revision": "v1"}]`, "invalid deployments file"},
+		{"missing revision", `[{"app_id": 1, "revision": "v1"}, {"app_id": 2}]`, "deployment 2: revision is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseBulkDeployments([]byte(tt.data))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestAppIdentifier(t *testing.T) {
+	id, err := appIdentifier("my-app", "", []string{"123"})
+	require.NoError(t, err)
+	assert.Equal(t, "my-app", id)
+
+	id, err = appIdentifier("", "", []string{"123"})
+	require.NoError(t, err)
+	assert.Equal(t, "123", id)
+
+	_, err = appIdentifier("", "", nil)
+	assert.Error(t, err)
+}
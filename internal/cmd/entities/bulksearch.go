@@ -0,0 +1,124 @@
+package entities
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+// bulkSearchResult is the entities found by one query of a bulk search
+type bulkSearchResult struct {
+	Query    string       `json:"query"`
+	Entities []api.Entity `json:"entities"`
+}
+
+type bulkSearchOptions struct {
+	*root.Options
+	file string
+}
+
+func newBulkSearchCmd(opts *root.Options) *cobra.Command {
+	bulkOpts := &bulkSearchOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "bulk-search",
+		Short: "Run many entity searches at once",
+		Long: `Run the entity searches in a file, one query per line, using the same
+syntax as 'entities search'. Blank lines and lines starting with # are
+skipped.
+
+Up to 10 searches are sent in each request, which is much faster than
+running 'entities search' once per query.`,
+		Example: `  # queries.txt:
+  #   name = 'checkout-api'
+  #   name = 'payments-api'
+  nrq entities bulk-search --file queries.txt
+
+  # Read queries from stdin
+  printf "name = 'a'\nname = 'b'\n" | nrq entities bulk-search --file -
+
+  nrq entities bulk-search --file queries.txt -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBulkSearch(bulkOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&bulkOpts.file, "file", "", "File of queries, one per line, or - for stdin (required)")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runBulkSearch(opts *bulkSearchOptions) error {
+	var (
+		data []byte
+		err  error
+	)
+	if opts.file == "-" {
+		data, err = io.ReadAll(opts.Stdin)
+	} else {
+		data, err = os.ReadFile(opts.file)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read queries: %w", err)
+	}
+
+	queries := parseQueries(string(data))
+	if len(queries) == 0 {
+		return fmt.Errorf("no queries found in %s", opts.file)
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	spinner := opts.Spinner(fmt.Sprintf("Running %d searches", len(queries)))
+	found, err := client.BulkSearchEntities(queries)
+	spinner.Stop()
+	if err != nil {
+		return err
+	}
+
+	results := make([]bulkSearchResult, len(queries))
+	var rows [][]string
+	for i, q := range queries {
+		results[i] = bulkSearchResult{Query: q, Entities: found[i]}
+		if len(found[i]) == 0 {
+			rows = append(rows, []string{view.Truncate(q, 40), "-", "(no matches)", "", ""})
+			continue
+		}
+		for _, e := range found[i] {
+			rows = append(rows, []string{
+				view.Truncate(q, 40),
+				view.Truncate(e.GUID.String(), 40),
+				view.Truncate(e.Name, 30),
+				e.Type,
+				e.Domain,
+			})
+		}
+	}
+
+	headers := []string{"QUERY", "GUID", "NAME", "TYPE", "DOMAIN"}
+	return opts.View().Render(headers, rows, results)
+}
+
+// parseQueries returns the non-blank lines of data that are not # comments
+func parseQueries(data string) []string {
+	var queries []string
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		queries = append(queries, line)
+	}
+	return queries
+}
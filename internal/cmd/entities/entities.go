@@ -1,11 +1,16 @@
 package entities
 
 import (
+	"bufio"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/newrelic-cli/api"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/validate"
 	"github.com/open-cli-collective/newrelic-cli/internal/view"
 )
 
@@ -18,15 +23,36 @@ func Register(rootCmd *cobra.Command, opts *root.Options) {
 	}
 
 	entitiesCmd.AddCommand(newSearchCmd(opts))
+	entitiesCmd.AddCommand(newBulkSearchCmd(opts))
+	entitiesCmd.AddCommand(newGetCmd(opts))
+	entitiesCmd.AddCommand(newTagAuditCmd(opts))
+	entitiesCmd.AddCommand(newTagsCmd(opts))
+	entitiesCmd.AddCommand(newRelationshipsCmd(opts))
 
 	rootCmd.AddCommand(entitiesCmd)
 }
 
+// searchOptions holds options for the search command
+type searchOptions struct {
+	*root.Options
+	domain     string
+	entityType string
+	account    string
+	tags       []string
+	reporting  string
+	nameLike   string
+	limit      int
+	noPaginate bool
+	guidsOnly  bool
+}
+
 func newSearchCmd(opts *root.Options) *cobra.Command {
-	return &cobra.Command{
-		Use:   "search <query>",
+	searchOpts := &searchOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "search [query]",
 		Short: "Search for entities",
-		Long: `Search for entities using NRQL-style query syntax.
+		Long: `Search for entities using NRQL-style query syntax, filter flags, or both.
 
 Query syntax supports:
   - Equality:         type = 'APPLICATION'
@@ -40,7 +66,13 @@ Common domains and types:
   INFRA:    HOST, AWSLAMBDAFUNCTION
   BROWSER:  BROWSER_APPLICATION
   SYNTH:    MONITOR
-  VIZ:      DASHBOARD`,
+  VIZ:      DASHBOARD
+
+Each filter flag adds a condition that is ANDed with the query and with the
+other flags. --tag can be repeated; entities must match every tag.
+
+All pages of results are fetched; use --no-paginate to fetch only the first
+page (up to 200 entities) for speed.`,
 		Example: `  # Find all APM applications
   nrq entities search "type = 'APPLICATION'"
 
@@ -54,25 +86,76 @@ Common domains and types:
   nrq entities search "domain = 'APM' AND name LIKE 'api%'"
 
   # Find dashboards
-  nrq entities search "type = 'DASHBOARD'"`,
-		Args: cobra.ExactArgs(1),
+  nrq entities search "type = 'DASHBOARD'"
+
+  # Filter with flags instead of query syntax
+  nrq entities search --domain APM --tag env:production --reporting true
+
+  # Combine a query with flags
+  nrq entities search "name LIKE 'api%'" --account 12345 --limit 10
+
+  # Fetch only the first page of results
+  nrq entities search "domain = 'INFRA'" --no-paginate
+
+  # Print only GUIDs, one per line, for use in scripts
+  nrq entities search "name LIKE '%prod%'" --output-guids | xargs -I{} nrq entities tags get {}`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runSearch(opts, args[0])
+			if searchOpts.guidsOnly && cmd.Flags().Changed("output") {
+				return fmt.Errorf("--output-guids cannot be combined with --output")
+			}
+			query := ""
+			if len(args) > 0 {
+				query = args[0]
+			}
+			return runSearch(searchOpts, query)
 		},
 	}
+
+	cmd.Flags().StringVar(&searchOpts.domain, "domain", "", "Entity domain (e.g., APM, INFRA, BROWSER)")
+	cmd.Flags().StringVar(&searchOpts.entityType, "type", "", "Entity type (e.g., APPLICATION, HOST)")
+	cmd.Flags().StringVar(&searchOpts.account, "account", "", "Account ID")
+	cmd.Flags().StringArrayVar(&searchOpts.tags, "tag", nil, "Tag as key:value (repeatable)")
+	cmd.Flags().StringVar(&searchOpts.reporting, "reporting", "", "Filter by reporting status: true or false")
+	cmd.Flags().StringVar(&searchOpts.nameLike, "name-like", "", "Name pattern, using % as a wildcard (e.g., 'api%')")
+	cmd.Flags().IntVarP(&searchOpts.limit, "limit", "l", 0, "Limit number of results (0 = no limit)")
+	cmd.Flags().BoolVar(&searchOpts.noPaginate, "no-paginate", false, "Fetch only the first page of results")
+	cmd.Flags().BoolVar(&searchOpts.guidsOnly, "output-guids", false, "Print only entity GUIDs, one per line")
+
+	return cmd
 }
 
-func runSearch(opts *root.Options, query string) error {
+func runSearch(opts *searchOptions, query string) error {
+	query, err := buildSearchQuery(query, opts)
+	if err != nil {
+		return err
+	}
+
 	client, err := opts.APIClient()
 	if err != nil {
 		return err
 	}
 
-	entities, err := client.SearchEntities(query)
+	var entities []api.Entity
+	if opts.noPaginate {
+		entities, _, err = client.SearchEntitiesPage(query, "")
+	} else {
+		spinner := opts.Spinner("Searching entities")
+		entities, err = client.SearchEntities(query)
+		spinner.Stop()
+	}
 	if err != nil {
 		return err
 	}
 
+	if opts.limit > 0 && len(entities) > opts.limit {
+		entities = entities[:opts.limit]
+	}
+
+	if opts.guidsOnly {
+		return writeGUIDs(opts.Stdout, entities)
+	}
+
 	v := opts.View()
 
 	if len(entities) == 0 {
@@ -94,3 +177,289 @@ func runSearch(opts *root.Options, query string) error {
 
 	return v.Render(headers, rows, entities)
 }
+
+// writeGUIDs writes each entity's full GUID on its own line
+func writeGUIDs(w io.Writer, entities []api.Entity) error {
+	for _, e := range entities {
+		if _, err := fmt.Fprintln(w, e.GUID.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildSearchQuery ANDs the query with a condition for each filter flag
+func buildSearchQuery(query string, opts *searchOptions) (string, error) {
+	var clauses []string
+	if query = strings.TrimSpace(query); query != "" {
+		clauses = append(clauses, "("+query+")")
+	}
+
+	if opts.domain != "" {
+		clauses = append(clauses, fmt.Sprintf("domain = '%s'", api.EscapeSearchValue(strings.ToUpper(opts.domain))))
+	}
+	if opts.entityType != "" {
+		clauses = append(clauses, fmt.Sprintf("type = '%s'", api.EscapeSearchValue(strings.ToUpper(opts.entityType))))
+	}
+	if opts.account != "" {
+		if err := validate.AccountID(opts.account); err != nil {
+			return "", err
+		}
+		clauses = append(clauses, "accountId = "+opts.account)
+	}
+	for _, tag := range opts.tags {
+		key, value, ok := strings.Cut(tag, ":")
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		if !ok || key == "" || value == "" {
+			return "", fmt.Errorf("invalid --tag %q: expected key:value", tag)
+		}
+		clauses = append(clauses, fmt.Sprintf("tags.%s = '%s'", tagKey(key), api.EscapeSearchValue(value)))
+	}
+	if opts.reporting != "" {
+		reporting := strings.ToLower(opts.reporting)
+		if reporting != "true" && reporting != "false" {
+			return "", fmt.Errorf("invalid --reporting %q: must be true or false", opts.reporting)
+		}
+		clauses = append(clauses, fmt.Sprintf("reporting = '%s'", reporting))
+	}
+	if opts.nameLike != "" {
+		clauses = append(clauses, fmt.Sprintf("name LIKE '%s'", api.EscapeSearchValue(opts.nameLike)))
+	}
+
+	if len(clauses) == 0 {
+		return "", fmt.Errorf("a query or at least one filter flag is required")
+	}
+	return strings.Join(clauses, " AND "), nil
+}
+
+// tagKey quotes a tag key with backticks unless it is a plain identifier
+func tagKey(key string) string {
+	for _, r := range key {
+		if !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return "`" + strings.ReplaceAll(key, "`", "") + "`"
+		}
+	}
+	return key
+}
+
+func newGetCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <guid>",
+		Short: "Get details for a specific entity",
+		Long: `Get the full context of an entity by its GUID in a single request.
+
+Displays the entity name, type, domain, account, alert severity,
+permalink, and tags.`,
+		Example: `  nrq entities get "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg="
+  nrq entities get "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=" -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGet(opts, api.EntityGUID(args[0]))
+		},
+	}
+}
+
+func runGet(opts *root.Options, guid api.EntityGUID) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	entity, err := client.GetEntityMetadata(guid)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		return v.JSON(entity)
+	case "plain":
+		return v.Plain([][]string{
+			{entity.GUID.String(), entity.Name, entity.Type, entity.Domain, fmt.Sprintf("%d", entity.AccountID)},
+		})
+	default:
+		v.Print("GUID:           %s\n", entity.GUID.String())
+		v.Print("Name:           %s\n", entity.Name)
+		v.Print("Type:           %s\n", entity.Type)
+		v.Print("Entity Type:    %s\n", entity.EntityType)
+		v.Print("Domain:         %s\n", entity.Domain)
+		v.Print("Account ID:     %d\n", entity.AccountID)
+		if entity.AlertSeverity != "" {
+			v.Print("Alert Severity: %s\n", entity.AlertSeverity)
+		}
+		if entity.Permalink != "" {
+			v.Print("Permalink:      %s\n", entity.Permalink)
+		}
+		if len(entity.Tags) > 0 {
+			v.Println("Tags:")
+			for _, tag := range entity.Tags {
+				v.Print("  %s: %s\n", tag.Key, strings.Join(tag.Values, ", "))
+			}
+		}
+		return nil
+	}
+}
+
+type tagAuditOptions struct {
+	*root.Options
+	requiredTags     []string
+	entityType       string
+	fixInteractively bool
+}
+
+// tagAuditResult is an entity that is missing one or more required tags
+type tagAuditResult struct {
+	GUID        api.EntityGUID `json:"guid"`
+	Name        string         `json:"name"`
+	Type        string         `json:"type"`
+	MissingTags []string       `json:"missingTags"`
+}
+
+func newTagAuditCmd(opts *root.Options) *cobra.Command {
+	auditOpts := &tagAuditOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "tag-audit",
+		Short: "Find entities missing required tags",
+		Long: `Find entities that are missing any of a set of required tags.
+
+Searches entities (optionally filtered by --type) and reports each entity
+missing one or more of the --required-tags keys.
+
+With --fix-interactively, you are prompted for a value for each missing tag
+and the tag is added to the entity. Leave a value empty to skip that tag.`,
+		Example: `  # Audit all APM applications for team and environment tags
+  nrq entities tag-audit --required-tags team,environment --type APPLICATION
+
+  # Audit and fill in missing tags interactively
+  nrq entities tag-audit --required-tags team --type HOST --fix-interactively
+
+  # Output as JSON for scripting
+  nrq entities tag-audit --required-tags team -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTagAudit(auditOpts)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&auditOpts.requiredTags, "required-tags", nil, "Comma-separated tag keys every entity must have (required)")
+	cmd.Flags().StringVar(&auditOpts.entityType, "type", "", "Only audit entities of this type (e.g., APPLICATION, HOST)")
+	cmd.Flags().BoolVar(&auditOpts.fixInteractively, "fix-interactively", false, "Prompt for missing tag values and add them")
+	_ = cmd.MarkFlagRequired("required-tags")
+
+	return root.DisablePager(cmd)
+}
+
+func runTagAudit(opts *tagAuditOptions) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	entities, err := client.SearchEntities(tagAuditQuery(opts.entityType))
+	if err != nil {
+		return err
+	}
+
+	var results []tagAuditResult
+	for _, e := range entities {
+		if missing := e.MissingTags(opts.requiredTags); len(missing) > 0 {
+			results = append(results, tagAuditResult{
+				GUID:        e.GUID,
+				Name:        e.Name,
+				Type:        e.Type,
+				MissingTags: missing,
+			})
+		}
+	}
+
+	v := opts.View()
+
+	if len(results) == 0 {
+		v.Success("All %d entities have the required tags", len(entities))
+		return nil
+	}
+
+	if opts.fixInteractively {
+		return fixMissingTags(opts, client, results)
+	}
+
+	headers := []string{"NAME", "GUID", "TYPE", "MISSING TAGS"}
+	rows := make([][]string, len(results))
+	for i, r := range results {
+		rows[i] = []string{
+			view.Truncate(r.Name, 30),
+			r.GUID.String(),
+			r.Type,
+			strings.Join(r.MissingTags, ","),
+		}
+	}
+
+	return v.Render(headers, rows, results)
+}
+
+// tagAuditQuery selects the entities to audit: entityType, or every entity
+// type that can carry tags
+func tagAuditQuery(entityType string) string {
+	if entityType == "" {
+		return "domain IN ('APM', 'BROWSER', 'INFRA', 'MOBILE', 'SYNTH', 'EXT')"
+	}
+	return fmt.Sprintf("type = '%s'", api.EscapeSearchValue(strings.ToUpper(entityType)))
+}
+
+// fixMissingTags prompts for a value for each missing tag and adds it. When
+// the input ends, the values read so far are added and prompting stops.
+func fixMissingTags(opts *tagAuditOptions, client *api.Client, results []tagAuditResult) error {
+	v := opts.View()
+	reader := bufio.NewReader(opts.Stdin)
+
+	var fixed int
+	var eof bool
+	for _, r := range results {
+		if eof {
+			break
+		}
+
+		v.Println("")
+		v.Print("%s (%s)\n", r.Name, r.GUID.String())
+
+		var tags []api.EntityTag
+		for _, key := range r.MissingTags {
+			fmt.Fprintf(opts.Stdout, "  %s: ", key)
+			input, err := reader.ReadString('\n')
+			if err == io.EOF {
+				eof = true
+				fmt.Fprintln(opts.Stdout)
+			} else if err != nil {
+				return fmt.Errorf("failed to read input: %w", err)
+			}
+			if value := strings.TrimSpace(input); value != "" {
+				tags = append(tags, api.EntityTag{Key: key, Values: []string{value}})
+			}
+			if eof {
+				break
+			}
+		}
+
+		if len(tags) == 0 {
+			v.Warning("  Skipped")
+			continue
+		}
+
+		if err := client.AddEntityTags(r.GUID, tags); err != nil {
+			v.Error("  Failed to add tags: %v", err)
+			continue
+		}
+		v.Success("  Added %d tag(s)", len(tags))
+		fixed++
+	}
+
+	v.Println("")
+	if eof {
+		v.Warning("Input ended; stopped prompting for tags")
+	}
+	v.Success("Updated %d of %d entities", fixed, len(results))
+	return nil
+}
@@ -0,0 +1,190 @@
+package entities
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+func TestBuildSearchQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		opts  searchOptions
+		want  string
+	}{
+		{
+			name:  "query only",
+			query: "type = 'APPLICATION'",
+			want:  "(type = 'APPLICATION')",
+		},
+		{
+			name: "domain and type are uppercased",
+			opts: searchOptions{domain: "apm", entityType: "application"},
+			want: "domain = 'APM' AND type = 'APPLICATION'",
+		},
+		{
+			name:  "query combined with flags",
+			query: "name LIKE 'api%' OR name LIKE 'web%'",
+			opts:  searchOptions{account: "12345"},
+			want:  "(name LIKE 'api%' OR name LIKE 'web%') AND accountId = 12345",
+		},
+		{
+			name: "multiple tags are ANDed",
+			opts: searchOptions{tags: []string{"env:production", "team:backend"}},
+			want: "tags.env = 'production' AND tags.team = 'backend'",
+		},
+		{
+			name: "tag key with special characters is quoted",
+			opts: searchOptions{tags: []string{"aws.region:us-east-1"}},
+			want: "tags.`aws.region` = 'us-east-1'",
+		},
+		{
+			name: "reporting and name pattern",
+			opts: searchOptions{reporting: "FALSE", nameLike: "checkout%"},
+			want: "reporting = 'false' AND name LIKE 'checkout%'",
+		},
+		{
+			name: "values are escaped",
+			opts: searchOptions{nameLike: "Bob's app", tags: []string{"owner:o'brien"}},
+			want: `tags.owner = 'o\'brien' AND name LIKE 'Bob\'s app'`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildSearchQuery(tt.query, &tt.opts)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBuildSearchQuery_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    searchOptions
+		wantErr string
+	}{
+		{"no query or flags", searchOptions{}, "a query or at least one filter flag is required"},
+		{"invalid tag", searchOptions{tags: []string{"env"}}, "expected key:value"},
+		{"invalid reporting", searchOptions{reporting: "yes"}, "must be true or false"},
+		{"invalid account", searchOptions{account: "abc"}, "account"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := buildSearchQuery("", &tt.opts)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestTagAuditQuery(t *testing.T) {
+	assert.Equal(t, "domain IN ('APM', 'BROWSER', 'INFRA', 'MOBILE', 'SYNTH', 'EXT')", tagAuditQuery(""))
+	assert.Equal(t, "type = 'APPLICATION'", tagAuditQuery("application"))
+	assert.Equal(t, `type = 'HOST\' OR NAME = \'X'`, tagAuditQuery("host' or name = 'x"))
+}
+
+func TestWriteGUIDs(t *testing.T) {
+	entities := []api.Entity{
+		{GUID: "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg5MDEyMzQ1Njc4OTA", Name: "production-api", Type: "APPLICATION", Domain: "APM"},
+		{GUID: "MXxJTkZSQXxOQXwxMjM", Name: "prod-host", Type: "HOST", Domain: "INFRA"},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, writeGUIDs(&out, entities))
+
+	assert.Equal(t, "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg5MDEyMzQ1Njc4OTA\nMXxJTkZSQXxOQXwxMjM\n", out.String())
+	assert.NotContains(t, out.String(), "GUID")
+	assert.NotContains(t, out.String(), "production-api")
+	assert.NotContains(t, out.String(), "APPLICATION")
+}
+
+func TestWriteGUIDs_Empty(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, writeGUIDs(&out, nil))
+	assert.Empty(t, out.String())
+}
+
+func TestSearchCmd_OutputGUIDsExcludesOutput(t *testing.T) {
+	opts := root.DefaultOptions()
+	parent := &cobra.Command{Use: "nrq"}
+	parent.PersistentFlags().StringVarP(&opts.Output, "output", "o", "table", "")
+	parent.AddCommand(newSearchCmd(opts))
+	parent.SetArgs([]string{"search", "--output-guids", "-o", "json"})
+	parent.SetOut(&bytes.Buffer{})
+	parent.SetErr(&bytes.Buffer{})
+
+	err := parent.Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--output-guids cannot be combined with --output")
+}
+
+func TestParseQueries(t *testing.T) {
+	data := "name = 'a'\n\n  # a comment\n  name = 'b'  \r\n"
+	assert.Equal(t, []string{"name = 'a'", "name = 'b'"}, parseQueries(data))
+	assert.Empty(t, parseQueries("\n# only comments\n"))
+}
+
+// newFixTagsTest returns audit options reading input and a client that
+// records the tags added to each entity
+func newFixTagsTest(t *testing.T, input string) (*tagAuditOptions, *api.Client, map[string]interface{}) {
+	t.Helper()
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	added := map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req api.NerdGraphRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		added[req.Variables["guid"].(string)] = req.Variables["tags"]
+		_, _ = w.Write([]byte(`{"data": {"taggingAddTagsToEntity": {"errors": []}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := api.NewWithConfig(api.ClientConfig{APIKey: "NRAK-TEST", AccountID: "12345"})
+	client.NerdGraphURL = server.URL
+
+	opts := root.DefaultOptions()
+	opts.Stdin = strings.NewReader(input)
+	opts.Stdout = &bytes.Buffer{}
+	opts.Stderr = &bytes.Buffer{}
+
+	return &tagAuditOptions{Options: opts}, client, added
+}
+
+var fixTagsResults = []tagAuditResult{
+	{GUID: "GUID-1", Name: "checkout", MissingTags: []string{"team"}},
+	{GUID: "GUID-2", Name: "payments", MissingTags: []string{"team"}},
+}
+
+func TestFixMissingTags_LastLineWithoutNewline(t *testing.T) {
+	opts, client, added := newFixTagsTest(t, "core\nbilling")
+
+	require.NoError(t, fixMissingTags(opts, client, fixTagsResults))
+
+	assert.Len(t, added, 2)
+	assert.Equal(t, []interface{}{map[string]interface{}{"key": "team", "values": []interface{}{"billing"}}}, added["GUID-2"])
+}
+
+func TestFixMissingTags_StopsAtEndOfInput(t *testing.T) {
+	opts, client, added := newFixTagsTest(t, "core\n")
+
+	require.NoError(t, fixMissingTags(opts, client, fixTagsResults))
+
+	assert.Len(t, added, 1)
+	assert.Contains(t, added, "GUID-1")
+	assert.Contains(t, opts.Stderr.(*bytes.Buffer).String(), "Input ended")
+}
@@ -0,0 +1,90 @@
+package entities
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+func newRelationshipsCmd(opts *root.Options) *cobra.Command {
+	relationshipsCmd := &cobra.Command{
+		Use:     "relationships",
+		Aliases: []string{"rels"},
+		Short:   "View entity relationships",
+	}
+
+	relationshipsCmd.AddCommand(newRelationshipsListCmd(opts))
+
+	return relationshipsCmd
+}
+
+// relationshipsListOptions holds options for the relationships list command
+type relationshipsListOptions struct {
+	*root.Options
+	direction string
+}
+
+func newRelationshipsListCmd(opts *root.Options) *cobra.Command {
+	listOpts := &relationshipsListOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "list <guid>",
+		Short: "List the relationships of an entity",
+		Long: `List the relationships of an entity, such as the hosts an application
+runs on or the services it calls.
+
+--direction limits results to upstream relationships (pointing at the entity),
+downstream relationships (from the entity), or both.`,
+		Example: `  nrq entities relationships list "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg="
+  nrq entities relationships list "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=" --direction downstream
+  nrq entities relationships list "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=" -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRelationshipsList(listOpts, api.EntityGUID(args[0]))
+		},
+	}
+
+	cmd.Flags().StringVar(&listOpts.direction, "direction", "both", "Relationship direction: upstream, downstream, or both")
+
+	return cmd
+}
+
+func runRelationshipsList(opts *relationshipsListOptions, guid api.EntityGUID) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	relationships, err := client.ListEntityRelationships(guid, opts.direction)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	if len(relationships) == 0 {
+		v.Println("No relationships found")
+		return nil
+	}
+
+	headers := []string{"SOURCE", "TARGET", "TYPE"}
+	rows := make([][]string, len(relationships))
+	for i, r := range relationships {
+		rows[i] = []string{
+			relationshipEndpoint(r.Source),
+			relationshipEndpoint(r.Target),
+			r.Type,
+		}
+	}
+
+	return v.Render(headers, rows, relationships)
+}
+
+// relationshipEndpoint formats an entity as "name (TYPE)"
+func relationshipEndpoint(e api.Entity) string {
+	return fmt.Sprintf("%s (%s)", view.Truncate(e.Name, 40), e.Type)
+}
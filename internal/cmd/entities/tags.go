@@ -0,0 +1,205 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/confirm"
+)
+
+func newTagsCmd(opts *root.Options) *cobra.Command {
+	tagsCmd := &cobra.Command{
+		Use:     "tags",
+		Aliases: []string{"tag"},
+		Short:   "View and manage entity tags",
+	}
+
+	tagsCmd.AddCommand(newTagsGetCmd(opts))
+	tagsCmd.AddCommand(newTagsSetCmd(opts))
+	tagsCmd.AddCommand(newTagsDeleteCmd(opts))
+
+	return tagsCmd
+}
+
+func newTagsGetCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <guid>",
+		Short: "List the tags of an entity",
+		Example: `  nrq entities tags get "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg="
+  nrq entities tags get "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=" -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTagsGet(opts, api.EntityGUID(args[0]))
+		},
+	}
+}
+
+func runTagsGet(opts *root.Options, guid api.EntityGUID) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	tags, err := client.GetEntityTags(guid)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	if len(tags) == 0 {
+		v.Println("No tags found")
+		return nil
+	}
+
+	headers := []string{"KEY", "VALUE"}
+	rows := make([][]string, len(tags))
+	for i, t := range tags {
+		rows[i] = []string{t.Key, strings.Join(t.Values, ", ")}
+	}
+
+	return v.Render(headers, rows, tags)
+}
+
+// tagsSetOptions holds options for the tags set command
+type tagsSetOptions struct {
+	*root.Options
+	tags []string
+}
+
+func newTagsSetCmd(opts *root.Options) *cobra.Command {
+	setOpts := &tagsSetOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "set <guid>",
+		Short: "Add tags to an entity",
+		Long: `Add tags to an entity. Each --tag is a key:value pair; repeat the
+flag to add several tags or several values for the same key.
+
+Existing values for a key are kept, so adding a value never removes one.`,
+		Example: `  nrq entities tags set "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=" --tag env:production
+  nrq entities tags set "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=" --tag env:production --tag team:backend`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTagsSet(setOpts, api.EntityGUID(args[0]))
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&setOpts.tags, "tag", nil, "Tag as key:value (repeatable, required)")
+	_ = cmd.MarkFlagRequired("tag")
+
+	return cmd
+}
+
+func runTagsSet(opts *tagsSetOptions, guid api.EntityGUID) error {
+	tags, err := parseTagFlags(opts.tags)
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.AddEntityTags(guid, tags); err != nil {
+		return err
+	}
+
+	opts.View().Success("Added %d tag(s) to entity %s", len(opts.tags), guid)
+	return nil
+}
+
+// tagsDeleteOptions holds options for the tags delete command
+type tagsDeleteOptions struct {
+	*root.Options
+	keys  []string
+	force bool
+}
+
+func newTagsDeleteCmd(opts *root.Options) *cobra.Command {
+	deleteOpts := &tagsDeleteOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "delete <guid>",
+		Short: "Remove tags from an entity",
+		Long: `Remove tags from an entity by key. All values of each key are removed.
+Requires confirmation unless --force is specified.`,
+		Example: `  nrq entities tags delete "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=" --tag env
+  nrq entities tags delete "MXxBUE18QVBQTElDQVRJT058MTIzNDU2Nzg=" --tag env --tag team --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTagsDelete(deleteOpts, api.EntityGUID(args[0]))
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&deleteOpts.keys, "tag", nil, "Tag key to remove (repeatable, required)")
+	root.AddForceFlag(cmd, &deleteOpts.force, "Skip confirmation prompt")
+	_ = cmd.MarkFlagRequired("tag")
+
+	return cmd
+}
+
+func runTagsDelete(opts *tagsDeleteOptions, guid api.EntityGUID) error {
+	for _, key := range opts.keys {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("tag key cannot be empty")
+		}
+	}
+
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	entity, err := client.GetEntityMetadata(guid)
+	if err != nil {
+		return fmt.Errorf("failed to get entity: %w", err)
+	}
+
+	if !opts.force {
+		p := &confirm.Prompter{
+			In:  opts.Stdin,
+			Out: opts.Stderr,
+		}
+		if !p.Confirm(fmt.Sprintf("Delete %d tag(s) from entity %s?", len(opts.keys), entity.Name)) {
+			v.Warning("Operation canceled")
+			return nil
+		}
+	}
+
+	if err := client.DeleteEntityTags(guid, opts.keys); err != nil {
+		return err
+	}
+
+	v.Success("Deleted %d tag(s) from entity %s", len(opts.keys), entity.Name)
+	return nil
+}
+
+// parseTagFlags parses key:value pairs, grouping values that share a key
+func parseTagFlags(values []string) ([]api.EntityTag, error) {
+	var tags []api.EntityTag
+	index := map[string]int{}
+	for _, value := range values {
+		key, tagValue, ok := strings.Cut(value, ":")
+		key = strings.TrimSpace(key)
+		tagValue = strings.TrimSpace(tagValue)
+		if !ok || key == "" || tagValue == "" {
+			return nil, fmt.Errorf("invalid --tag %q: expected key:value", value)
+		}
+
+		if i, ok := index[key]; ok {
+			tags[i].Values = append(tags[i].Values, tagValue)
+			continue
+		}
+		index[key] = len(tags)
+		tags = append(tags, api.EntityTag{Key: key, Values: []string{tagValue}})
+	}
+	return tags, nil
+}
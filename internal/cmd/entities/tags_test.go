@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+)
+
+func TestParseTagFlags(t *testing.T) {
+	tags, err := parseTagFlags([]string{"env:production", "team:backend", "team:platform", "url:https://example.com"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []api.EntityTag{
+		{Key: "env", Values: []string{"production"}},
+		{Key: "team", Values: []string{"backend", "platform"}},
+		{Key: "url", Values: []string{"https://example.com"}},
+	}, tags)
+}
+
+func TestParseTagFlags_Invalid(t *testing.T) {
+	for _, value := range []string{"env", "env:", ":production", " : "} {
+		_, err := parseTagFlags([]string{value})
+		assert.Error(t, err, value)
+	}
+}
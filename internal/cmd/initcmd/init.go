@@ -30,7 +30,8 @@ func Register(rootCmd *cobra.Command, opts *root.Options) {
 		Long: `Configure the New Relic CLI with your credentials.
 
 This interactive wizard will guide you through setting up:
-  - API key (stored securely in Keychain on macOS, config file on Linux)
+  - API key (stored securely in Keychain on macOS or Credential Manager on
+    Windows, config file on Linux)
   - Account ID
   - Region (US or EU)
 
@@ -53,7 +54,7 @@ After configuration, the connection is tested automatically.`,
 	cmd.Flags().StringVar(&initOpts.region, "region", "", "Region: US or EU (for non-interactive setup)")
 	cmd.Flags().BoolVar(&initOpts.noVerify, "no-verify", false, "Skip connection verification")
 
-	rootCmd.AddCommand(cmd)
+	rootCmd.AddCommand(root.DisablePager(cmd))
 }
 
 func runInit(opts *initOptions) error {
@@ -63,7 +64,7 @@ func runInit(opts *initOptions) error {
 	v.Println("")
 
 	// Check for existing config
-	status := config.GetCredentialStatus()
+	status := config.GetCredentialStatus(config.DefaultProfile)
 	if status["api_key_stored"] || status["account_id_stored"] {
 		v.Warning("Existing configuration detected.")
 		v.Println("This will overwrite your current settings.")
@@ -3,6 +3,7 @@ package keys
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -29,6 +30,8 @@ and delete API keys without hand-crafting GraphQL.`,
 	keysCmd.AddCommand(newCreateCmd(opts))
 	keysCmd.AddCommand(newUpdateCmd(opts))
 	keysCmd.AddCommand(newDeleteCmd(opts))
+	keysCmd.AddCommand(newRotateCmd(opts))
+	keysCmd.AddCommand(newAuditCmd(opts))
 
 	rootCmd.AddCommand(keysCmd)
 }
@@ -37,9 +40,12 @@ and delete API keys without hand-crafting GraphQL.`,
 
 type listOptions struct {
 	*root.Options
-	keyType string
-	account int
-	limit   int
+	keyType  string
+	account  int
+	limit    int
+	sort     string
+	sortDesc bool
+	filter   string
 }
 
 func newListCmd(opts *root.Options) *cobra.Command {
@@ -54,6 +60,8 @@ By default lists both user and ingest keys. Use --type to filter.`,
 		Example: `  nrq keys list
   nrq keys list --type user
   nrq keys list --type ingest --account 12345
+  nrq keys list --sort name
+  nrq keys list --filter "type=INGEST"
   nrq keys list -o json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runList(listOpts)
@@ -63,6 +71,9 @@ By default lists both user and ingest keys. Use --type to filter.`,
 	cmd.Flags().StringVarP(&listOpts.keyType, "type", "t", "", "Filter by key type: user or ingest")
 	cmd.Flags().IntVar(&listOpts.account, "account", 0, "Filter by account ID")
 	cmd.Flags().IntVarP(&listOpts.limit, "limit", "l", 0, "Limit number of results (0 = no limit)")
+	cmd.Flags().StringVar(&listOpts.sort, "sort", "", "Sort rows by this column (e.g., name, type)")
+	cmd.Flags().BoolVar(&listOpts.sortDesc, "sort-desc", false, "Sort in descending order")
+	cmd.Flags().StringVar(&listOpts.filter, "filter", "", "Only show rows matching COLUMN=VALUE (exact) or COLUMN~VALUE (contains)")
 
 	return cmd
 }
@@ -87,10 +98,6 @@ func runList(opts *listOptions) error {
 		return err
 	}
 
-	if opts.limit > 0 && len(keys) > opts.limit {
-		keys = keys[:opts.limit]
-	}
-
 	v := opts.View()
 
 	if len(keys) == 0 {
@@ -110,6 +117,20 @@ func runList(opts *listOptions) error {
 		}
 	}
 
+	keys, rows, err = view.FilterSort(keys, rows, headers, opts.filter, opts.sort, opts.sortDesc)
+	if err != nil {
+		return err
+	}
+
+	if opts.limit > 0 {
+		if len(keys) > opts.limit {
+			keys = keys[:opts.limit]
+		}
+		if len(rows) > opts.limit {
+			rows = rows[:opts.limit]
+		}
+	}
+
 	return v.Render(headers, rows, keys)
 }
 
@@ -238,6 +259,7 @@ For ingest keys, --ingest-type is required (license or browser).`,
 	cmd.Flags().StringVar(&createOpts.ingestType, "ingest-type", "", "Ingest type for ingest keys: license or browser")
 	cmd.MarkFlagRequired("type")
 	cmd.MarkFlagRequired("name")
+	root.AddDryRunFlag(cmd, opts)
 
 	return cmd
 }
@@ -273,12 +295,28 @@ func runCreate(opts *createOptions) error {
 				return fmt.Errorf("could not determine current user ID: %w", err)
 			}
 		}
+		if opts.DryRun {
+			return opts.PrintDryRun("create user API key", map[string]interface{}{
+				"accountId": accountID,
+				"userId":    userID,
+				"name":      opts.name,
+				"notes":     opts.notes,
+			})
+		}
 		key, err = client.CreateUserAPIKey(accountID, userID, opts.name, opts.notes)
 	case "INGEST":
 		ingestType := strings.ToUpper(opts.ingestType)
 		if ingestType != "LICENSE" && ingestType != "BROWSER" {
 			return fmt.Errorf("--ingest-type is required for ingest keys: license or browser")
 		}
+		if opts.DryRun {
+			return opts.PrintDryRun("create ingest API key", map[string]interface{}{
+				"accountId":  accountID,
+				"ingestType": ingestType,
+				"name":       opts.name,
+				"notes":      opts.notes,
+			})
+		}
 		key, err = client.CreateIngestAPIKey(accountID, ingestType, opts.name, opts.notes)
 	}
 	if err != nil {
@@ -425,7 +463,8 @@ Otherwise, each key is looked up to determine its type.`,
 	}
 
 	cmd.Flags().StringVarP(&deleteOpts.keyType, "type", "t", "", "Key type: user or ingest (auto-detected if omitted)")
-	cmd.Flags().BoolVarP(&deleteOpts.force, "force", "f", false, "Skip confirmation prompt")
+	root.AddForceFlag(cmd, &deleteOpts.force, "Skip confirmation prompt")
+	root.AddDryRunFlag(cmd, opts)
 
 	return cmd
 }
@@ -433,7 +472,7 @@ Otherwise, each key is looked up to determine its type.`,
 func runDelete(opts *deleteOptions, keyIDs []string) error {
 	v := opts.View()
 
-	if !opts.force {
+	if !opts.force && !opts.DryRun {
 		msg := fmt.Sprintf("Delete %d API key(s)?", len(keyIDs))
 		if len(keyIDs) == 1 {
 			msg = fmt.Sprintf("Delete API key %s?", keyIDs[0])
@@ -484,6 +523,13 @@ func runDelete(opts *deleteOptions, keyIDs []string) error {
 		}
 	}
 
+	if opts.DryRun {
+		return opts.PrintDryRun(fmt.Sprintf("delete %d API key(s)", len(keyIDs)), map[string]interface{}{
+			"userKeyIds":   userKeyIDs,
+			"ingestKeyIds": ingestKeyIDs,
+		})
+	}
+
 	deletedIDs, err := client.DeleteAPIAccessKeys(userKeyIDs, ingestKeyIDs)
 	if err != nil {
 		return err
@@ -496,3 +542,308 @@ func runDelete(opts *deleteOptions, keyIDs []string) error {
 	}
 	return nil
 }
+
+// --- rotate ---
+
+type rotateOptions struct {
+	*root.Options
+	name    string
+	account int
+	force   bool
+}
+
+func newRotateCmd(opts *root.Options) *cobra.Command {
+	rotateOpts := &rotateOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "rotate <key-id>",
+		Short: "Replace an API key with a new one",
+		Long: `Replace an API key with a newly created key of the same type.
+
+The new key keeps the old key's name (unless --name is given), notes,
+ingest type, and account, and a user key stays with the user who owns the
+old one. Rotation is refused if the old key's account or user cannot be
+determined. The new key is created and printed before the old key is deleted, so the old key is only
+removed once its replacement exists. Deleting the old key requires
+confirmation unless --force is specified; if deletion fails, the new key
+is kept and a warning is shown.`,
+		Example: `  nrq keys rotate NRAK-XXXXXXXXXXXX
+  nrq keys rotate NRAK-XXXXXXXXXXXX --name "Rotated Key"
+  nrq keys rotate NRAK-XXXXXXXXXXXX --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRotate(rotateOpts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&rotateOpts.name, "name", "n", "", "Name for the new key (default: name of the old key)")
+	cmd.Flags().IntVar(&rotateOpts.account, "account", 0, "Account ID of the old key; rotation fails if it differs")
+	_ = cmd.Flags().MarkDeprecated("account", "the new key is always created in the old key's account")
+	root.AddForceFlag(cmd, &rotateOpts.force, "Delete the old key without confirmation")
+
+	return root.DisablePager(cmd)
+}
+
+func runRotate(opts *rotateOptions, keyID string) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	old, err := client.FindAPIAccessKey(keyID)
+	if err != nil {
+		return fmt.Errorf("failed to find key %s: %w", keyID, err)
+	}
+
+	if err := checkRotateOwner(old); err != nil {
+		return err
+	}
+	if opts.account != 0 && opts.account != old.AccountID {
+		return fmt.Errorf("key %s belongs to account %d, not %d", old.ID, old.AccountID, opts.account)
+	}
+
+	name := opts.name
+	if name == "" {
+		name = old.Name
+	}
+
+	var key *api.ApiAccessKey
+
+	switch old.Type {
+	case "USER":
+		key, err = client.CreateUserAPIKey(old.AccountID, old.UserID, name, old.Notes)
+	case "INGEST":
+		key, err = client.CreateIngestAPIKey(old.AccountID, old.IngestType, name, old.Notes)
+	}
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		if err := v.JSON(key); err != nil {
+			return err
+		}
+	case "plain":
+		if err := v.Plain([][]string{{key.ID, key.Name, key.Type, key.Key}}); err != nil {
+			return err
+		}
+	default:
+		v.Success("New API key created")
+		v.Print("ID:   %s\n", key.ID)
+		v.Print("Name: %s\n", key.Name)
+		v.Print("Type: %s\n", key.Type)
+		if key.Key != "" {
+			v.Print("Key:  %s\n", key.Key)
+		}
+	}
+
+	if !opts.force {
+		p := &confirm.Prompter{
+			In:  opts.Stdin,
+			Out: opts.Stderr,
+		}
+		if !p.Confirm(fmt.Sprintf("Delete old key %s?", old.ID)) {
+			v.Warning("Old key %s was not deleted", old.ID)
+			return nil
+		}
+	}
+
+	var userKeyIDs, ingestKeyIDs []string
+	if old.Type == "USER" {
+		userKeyIDs = []string{old.ID}
+	} else {
+		ingestKeyIDs = []string{old.ID}
+	}
+
+	if _, err := client.DeleteAPIAccessKeys(userKeyIDs, ingestKeyIDs); err != nil {
+		v.Warning("Warning: failed to delete old key %s: %v", old.ID, err)
+		v.Warning("         The new key is active; delete the old key with 'nrq keys delete %s'", old.ID)
+		return nil
+	}
+
+	v.Success("Old key %s deleted", old.ID)
+	return nil
+}
+
+// checkRotateOwner returns an error unless the account (and, for a user
+// key, the user) that owns key is known, so that its replacement is never
+// created for someone else
+func checkRotateOwner(key *api.ApiAccessKey) error {
+	switch key.Type {
+	case "USER":
+		if key.UserID == 0 {
+			return fmt.Errorf("cannot rotate key %s: its user could not be determined", key.ID)
+		}
+	case "INGEST":
+	default:
+		return fmt.Errorf("unexpected key type %q for key %s", key.Type, key.ID)
+	}
+	if key.AccountID == 0 {
+		return fmt.Errorf("cannot rotate key %s: its account could not be determined", key.ID)
+	}
+	return nil
+}
+
+// --- audit ---
+
+type auditOptions struct {
+	*root.Options
+	olderThan string
+	keyType   string
+	account   int
+}
+
+// auditEntry is a key with its creation and usage times. NerdGraph only
+// tracks last use for user keys, so UsageTracked is false for ingest keys.
+type auditEntry struct {
+	api.ApiAccessKey
+	CreatedAt    time.Time  `json:"createdAt"`
+	LastUsed     *time.Time `json:"lastUsed,omitempty"`
+	UsageTracked bool       `json:"usageTracked"`
+}
+
+func newAuditCmd(opts *root.Options) *cobra.Command {
+	auditOpts := &auditOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "List API keys with their age and last use",
+		Long: `List API keys with when they were created and last used, to find old
+or unused keys.
+
+--older-than keeps only keys not used since then: a duration such as 90d,
+12w, or 48h, or any time accepted by --since elsewhere ("3 months ago",
+"2025-01-01"). Keys that were never used are compared by creation time.
+Last use is only tracked for user keys: ingest keys show n/a and are left
+out when --older-than is given.`,
+		Example: `  nrq keys audit
+  nrq keys audit --older-than 90d
+  nrq keys audit --older-than "6 months ago" --type user
+  nrq keys audit -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAudit(auditOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&auditOpts.olderThan, "older-than", "", "Only show keys not used within this period (e.g., 90d, '6 months ago')")
+	cmd.Flags().StringVarP(&auditOpts.keyType, "type", "t", "", "Filter by key type: user or ingest")
+	cmd.Flags().IntVar(&auditOpts.account, "account", 0, "Filter by account ID")
+
+	return cmd
+}
+
+func runAudit(opts *auditOptions) error {
+	var cutoff time.Time
+	if opts.olderThan != "" {
+		var err error
+		cutoff, err = api.ParseFlexibleTime(opts.olderThan)
+		if err != nil {
+			return fmt.Errorf("invalid --older-than value: %w", err)
+		}
+	}
+
+	var keyTypes []string
+	if opts.keyType != "" {
+		t := strings.ToUpper(opts.keyType)
+		if t != "USER" && t != "INGEST" {
+			return fmt.Errorf("invalid key type %q: must be user or ingest", opts.keyType)
+		}
+		keyTypes = []string{t}
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	keys, err := client.SearchAPIKeys(keyTypes, opts.account)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]auditEntry, 0, len(keys))
+	untracked := 0
+	for _, k := range keys {
+		usageTracked := k.Type == "USER"
+		if !cutoff.IsZero() && !usageTracked {
+			untracked++
+			continue
+		}
+
+		meta, err := client.GetAPIKeyLastUsed(k.ID, k.Type)
+		if err != nil {
+			return fmt.Errorf("failed to get usage for key %s: %w", k.ID, err)
+		}
+
+		// Never include key values in audit output
+		k.Key = ""
+		entry := auditEntry{ApiAccessKey: k, CreatedAt: meta.CreatedAt, UsageTracked: usageTracked}
+		if !meta.LastUsed.IsZero() {
+			lastUsed := meta.LastUsed
+			entry.LastUsed = &lastUsed
+		}
+
+		if !cutoff.IsZero() && !entry.usedBefore(cutoff) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	v := opts.View()
+
+	if untracked > 0 {
+		v.Warning("%d ingest key(s) left out: last use is only tracked for user keys", untracked)
+	}
+
+	if len(entries) == 0 {
+		v.Println("No API keys found")
+		return nil
+	}
+
+	now := time.Now()
+	headers := []string{"ID", "NAME", "TYPE", "CREATED", "LAST USED", "AGE"}
+	rows := make([][]string, len(entries))
+	for i, e := range entries {
+		created, age := "-", "-"
+		if !e.CreatedAt.IsZero() {
+			created = e.CreatedAt.Local().Format("2006-01-02")
+			age = formatAge(now.Sub(e.CreatedAt))
+		}
+		rows[i] = []string{e.ID, view.Truncate(e.Name, 30), e.Type, created, e.lastUsedColumn(), age}
+	}
+
+	return v.Render(headers, rows, entries)
+}
+
+// lastUsedColumn formats the LAST USED column: n/a when usage isn't
+// tracked, - when the key was never used
+func (e auditEntry) lastUsedColumn() string {
+	switch {
+	case !e.UsageTracked:
+		return "n/a"
+	case e.LastUsed != nil:
+		return e.LastUsed.Local().Format("2006-01-02")
+	}
+	return "-"
+}
+
+// usedBefore reports whether the key was last used (or, if never used,
+// created) before t. Keys with no known times are treated as old.
+func (e auditEntry) usedBefore(t time.Time) bool {
+	last := e.CreatedAt
+	if e.LastUsed != nil {
+		last = *e.LastUsed
+	}
+	return last.IsZero() || last.Before(t)
+}
+
+// formatAge formats a duration in whole days
+func formatAge(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	return fmt.Sprintf("%dd", int(d.Hours()/24))
+}
@@ -0,0 +1,47 @@
+package keys
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+)
+
+func TestAuditEntryUsedBefore(t *testing.T) {
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	old := cutoff.AddDate(0, -1, 0)
+	recent := cutoff.AddDate(0, 1, 0)
+
+	assert.True(t, auditEntry{CreatedAt: old}.usedBefore(cutoff), "never used, created before cutoff")
+	assert.False(t, auditEntry{CreatedAt: recent}.usedBefore(cutoff), "never used, created after cutoff")
+	assert.False(t, auditEntry{CreatedAt: old, LastUsed: &recent}.usedBefore(cutoff), "used recently")
+	assert.True(t, auditEntry{CreatedAt: old, LastUsed: &old}.usedBefore(cutoff), "not used recently")
+	assert.True(t, auditEntry{}.usedBefore(cutoff), "no known times")
+}
+
+func TestAuditEntryLastUsedColumn(t *testing.T) {
+	used := time.Date(2025, 1, 15, 12, 0, 0, 0, time.Local)
+
+	assert.Equal(t, "2025-01-15", auditEntry{UsageTracked: true, LastUsed: &used}.lastUsedColumn())
+	assert.Equal(t, "-", auditEntry{UsageTracked: true}.lastUsedColumn(), "user key never used")
+	assert.Equal(t, "n/a", auditEntry{}.lastUsedColumn(), "ingest key")
+}
+
+func TestCheckRotateOwner(t *testing.T) {
+	assert.NoError(t, checkRotateOwner(&api.ApiAccessKey{ID: "NRAK-1", Type: "USER", AccountID: 12345, UserID: 1001}))
+	assert.NoError(t, checkRotateOwner(&api.ApiAccessKey{ID: "NRII-1", Type: "INGEST", AccountID: 12345}))
+
+	assert.EqualError(t, checkRotateOwner(&api.ApiAccessKey{ID: "NRAK-1", Type: "USER", AccountID: 12345}),
+		"cannot rotate key NRAK-1: its user could not be determined")
+	assert.EqualError(t, checkRotateOwner(&api.ApiAccessKey{ID: "NRII-1", Type: "INGEST"}),
+		"cannot rotate key NRII-1: its account could not be determined")
+	assert.EqualError(t, checkRotateOwner(&api.ApiAccessKey{ID: "X-1", Type: "OTHER", AccountID: 12345}),
+		`unexpected key type "OTHER" for key X-1`)
+}
+
+func TestFormatAge(t *testing.T) {
+	assert.Equal(t, "90d", formatAge(90*24*time.Hour+time.Hour))
+	assert.Equal(t, "0d", formatAge(-time.Hour))
+}
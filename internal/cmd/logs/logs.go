@@ -25,11 +25,15 @@ func Register(rootCmd *cobra.Command, opts *root.Options) {
 	}
 
 	rulesCmd.AddCommand(newListRulesCmd(opts))
+	rulesCmd.AddCommand(newGetRuleCmd(opts))
 	rulesCmd.AddCommand(newCreateRuleCmd(opts))
 	rulesCmd.AddCommand(newUpdateRuleCmd(opts))
 	rulesCmd.AddCommand(newDeleteRuleCmd(opts))
 
 	logsCmd.AddCommand(rulesCmd)
+	logsCmd.AddCommand(newObfuscationCmd(opts))
+	logsCmd.AddCommand(newPartitionsCmd(opts))
+	logsCmd.AddCommand(newSearchCmd(opts))
 	rootCmd.AddCommand(logsCmd)
 }
 
@@ -98,6 +102,53 @@ func runListRules(opts *listRulesOptions) error {
 	return v.Render(headers, rows, rules)
 }
 
+func newGetRuleCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <rule-id>",
+		Short: "Get details for a log parsing rule",
+		Long: `Get the full definition of a log parsing rule, including its
+grok pattern, NRQL matching condition, and lucene filter.`,
+		Example: `  nrq logs rules get abc-123-def-456
+  nrq logs rules get abc-123-def-456 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGetRule(opts, args[0])
+		},
+	}
+}
+
+func runGetRule(opts *root.Options, ruleID string) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	rule, err := client.GetLogParsingRule(ruleID)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		return v.JSON(rule)
+	case "plain":
+		return v.Plain([][]string{
+			{rule.ID, rule.Description, fmt.Sprintf("%t", rule.Enabled), rule.Grok, rule.NRQL, rule.Lucene, rule.UpdatedAt},
+		})
+	default:
+		v.Print("ID:          %s\n", rule.ID)
+		v.Print("Description: %s\n", rule.Description)
+		v.Print("Enabled:     %t\n", rule.Enabled)
+		v.Print("Grok:        %s\n", rule.Grok)
+		v.Print("NRQL:        %s\n", rule.NRQL)
+		v.Print("Lucene:      %s\n", rule.Lucene)
+		v.Print("Updated:     %s\n", rule.UpdatedAt)
+		return nil
+	}
+}
+
 type createRuleOptions struct {
 	*root.Options
 	description string
@@ -215,11 +266,22 @@ IMPORTANT:
 	cmd.MarkFlagRequired("description")
 	cmd.MarkFlagRequired("grok")
 	cmd.MarkFlagRequired("nrql")
+	root.AddDryRunFlag(cmd, opts)
 
 	return cmd
 }
 
 func runCreateRule(opts *createRuleOptions) error {
+	if opts.DryRun {
+		return opts.PrintDryRun("create log parsing rule", map[string]interface{}{
+			"description": opts.description,
+			"enabled":     opts.enabled,
+			"grok":        opts.grok,
+			"lucene":      opts.lucene,
+			"nrql":        opts.nrql,
+		})
+	}
+
 	client, err := opts.APIClient()
 	if err != nil {
 		return err
@@ -256,6 +318,7 @@ type updateRuleOptions struct {
 	lucene      string
 	enabled     bool
 	disabled    bool
+	showDiff    bool
 }
 
 func newUpdateRuleCmd(opts *root.Options) *cobra.Command {
@@ -335,7 +398,10 @@ IMPORTANT:
 
   # Update with custom regex capture
   nrq logs rules update rule-123 \
-    --grok "%{GREEDYDATA}(?<custom_id>[A-Z]{3}-[0-9]{4})"`,
+    --grok "%{GREEDYDATA}(?<custom_id>[A-Z]{3}-[0-9]{4})"
+
+  # Show what changed
+  nrq logs rules update rule-123 --disabled --show-diff`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runUpdateRule(updateOpts, args[0], cmd)
@@ -348,17 +414,14 @@ IMPORTANT:
 	cmd.Flags().StringVarP(&updateOpts.lucene, "lucene", "l", "", "Lucene filter")
 	cmd.Flags().BoolVarP(&updateOpts.enabled, "enabled", "e", false, "Enable the rule")
 	cmd.Flags().BoolVar(&updateOpts.disabled, "disabled", false, "Disable the rule")
+	cmd.Flags().BoolVar(&updateOpts.showDiff, "show-diff", false, "Print the changes made to the rule to stderr")
 	cmd.MarkFlagsMutuallyExclusive("enabled", "disabled")
+	root.AddDryRunFlag(cmd, opts)
 
 	return cmd
 }
 
 func runUpdateRule(opts *updateRuleOptions, ruleID string, cmd *cobra.Command) error {
-	client, err := opts.APIClient()
-	if err != nil {
-		return err
-	}
-
 	// Build the update struct with only changed flags
 	update := api.LogParsingRuleUpdate{}
 
@@ -383,11 +446,34 @@ func runUpdateRule(opts *updateRuleOptions, ruleID string, cmd *cobra.Command) e
 		update.Enabled = &enabled
 	}
 
+	if opts.DryRun {
+		return opts.PrintDryRun(fmt.Sprintf("update log parsing rule %s (changed fields only)", ruleID), update)
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	showDiff := opts.showDiff || opts.Verbose
+	var before *api.LogParsingRule
+	if showDiff {
+		if before, err = client.GetLogParsingRule(ruleID); err != nil {
+			return err
+		}
+	}
+
 	rule, err := client.UpdateLogParsingRule(ruleID, update)
 	if err != nil {
 		return err
 	}
 
+	if showDiff {
+		if err := opts.PrintChanges(before, rule); err != nil {
+			return err
+		}
+	}
+
 	v := opts.View()
 
 	switch v.Format {
@@ -424,7 +510,8 @@ func newDeleteRuleCmd(opts *root.Options) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().BoolVarP(&deleteOpts.force, "force", "f", false, "Skip confirmation prompt")
+	root.AddForceFlag(cmd, &deleteOpts.force, "Skip confirmation prompt")
+	root.AddDryRunFlag(cmd, opts)
 
 	return cmd
 }
@@ -432,6 +519,10 @@ func newDeleteRuleCmd(opts *root.Options) *cobra.Command {
 func runDeleteRule(opts *deleteRuleOptions, ruleID string) error {
 	v := opts.View()
 
+	if opts.DryRun {
+		return opts.PrintDryRun(fmt.Sprintf("delete log parsing rule %s", ruleID), nil)
+	}
+
 	if !opts.force {
 		p := &confirm.Prompter{
 			In:  opts.Stdin,
@@ -0,0 +1,251 @@
+package logs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/confirm"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+func newObfuscationCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "obfuscation",
+		Short: "Manage log obfuscation rules",
+		Long: `Manage log obfuscation rules, which mask or hash sensitive data such as
+credit card or social security numbers in logs as they are ingested.`,
+	}
+
+	cmd.AddCommand(newListObfuscationCmd(opts))
+	cmd.AddCommand(newCreateObfuscationCmd(opts))
+	cmd.AddCommand(newDeleteObfuscationCmd(opts))
+
+	return cmd
+}
+
+type listObfuscationOptions struct {
+	*root.Options
+	limit int
+}
+
+func newListObfuscationCmd(opts *root.Options) *cobra.Command {
+	listOpts := &listObfuscationOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List log obfuscation rules",
+		Example: `  nrq logs obfuscation list
+  nrq logs obfuscation list -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runListObfuscation(listOpts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&listOpts.limit, "limit", "l", 0, "Limit number of results (0 = no limit)")
+
+	return cmd
+}
+
+func runListObfuscation(opts *listObfuscationOptions) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	rules, err := client.ListObfuscationRules()
+	if err != nil {
+		return err
+	}
+
+	// Apply limit
+	if opts.limit > 0 && len(rules) > opts.limit {
+		rules = rules[:opts.limit]
+	}
+
+	v := opts.View()
+
+	if len(rules) == 0 {
+		v.Println("No log obfuscation rules found")
+		return nil
+	}
+
+	headers := []string{"ID", "NAME", "ENABLED", "METHOD", "REGEX"}
+	rows := make([][]string, len(rules))
+	for i, r := range rules {
+		var methods, regexes []string
+		for _, a := range r.Actions {
+			methods = append(methods, a.Method)
+			regexes = append(regexes, a.Regex)
+		}
+		rows[i] = []string{
+			r.ID,
+			view.Truncate(r.Name, 40),
+			fmt.Sprintf("%t", r.Enabled),
+			strings.Join(methods, ","),
+			view.Truncate(strings.Join(regexes, ", "), 40),
+		}
+	}
+
+	return v.Render(headers, rows, rules)
+}
+
+type createObfuscationOptions struct {
+	*root.Options
+	name        string
+	description string
+	regex       string
+	method      string
+	attributes  []string
+	filter      string
+	enabled     bool
+}
+
+func newCreateObfuscationCmd(opts *root.Options) *cobra.Command {
+	createOpts := &createObfuscationOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a log obfuscation rule",
+		Long: `Create a log obfuscation rule that applies a regex to log attributes.
+
+Matches are replaced according to --method:
+  MASK         Replace each match with X characters
+  HASH_SHA256  Replace each match with its SHA-256 hash
+
+The regex is stored as a separate obfuscation expression, which is created
+along with the rule. Rules only apply to newly ingested logs.`,
+		Example: `  # Mask social security numbers in the message
+  nrq logs obfuscation create --name "Mask SSN" --regex "\d{3}-\d{2}-\d{4}" --method MASK
+
+  # Hash emails in two attributes, only for one service's logs
+  nrq logs obfuscation create --name "Hash emails" \
+    --regex "[^@\s]+@[^@\s]+" --method HASH_SHA256 \
+    --attribute message --attribute user.email \
+    --filter "SELECT * FROM Log WHERE service = 'billing'"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCreateObfuscation(createOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&createOpts.name, "name", "", "Rule name (required)")
+	cmd.Flags().StringVarP(&createOpts.description, "description", "d", "", "Rule description")
+	cmd.Flags().StringVar(&createOpts.regex, "regex", "", "Regex matching the data to obfuscate (required)")
+	cmd.Flags().StringVar(&createOpts.method, "method", "MASK", "Obfuscation method: MASK or HASH_SHA256")
+	cmd.Flags().StringSliceVar(&createOpts.attributes, "attribute", []string{"message"}, "Log attribute to obfuscate (repeatable)")
+	cmd.Flags().StringVar(&createOpts.filter, "filter", "", "NRQL condition selecting the logs the rule applies to")
+	cmd.Flags().BoolVarP(&createOpts.enabled, "enabled", "e", true, "Enable the rule")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("regex")
+	root.AddDryRunFlag(cmd, opts)
+
+	return cmd
+}
+
+func runCreateObfuscation(opts *createObfuscationOptions) error {
+	method := strings.ToUpper(opts.method)
+	if method != "MASK" && method != "HASH_SHA256" {
+		return fmt.Errorf("invalid --method %q: must be MASK or HASH_SHA256", opts.method)
+	}
+
+	input := api.LogObfuscationRuleInput{
+		Name:        opts.name,
+		Description: opts.description,
+		Enabled:     opts.enabled,
+		Filter:      opts.filter,
+		Regex:       opts.regex,
+		Method:      method,
+		Attributes:  opts.attributes,
+	}
+
+	if opts.DryRun {
+		return opts.PrintDryRun("create log obfuscation rule", input)
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	rule, err := client.CreateObfuscationRule(input)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		return v.JSON(rule)
+	case "plain":
+		return v.Plain([][]string{
+			{rule.ID, rule.Name, fmt.Sprintf("%t", rule.Enabled)},
+		})
+	default:
+		v.Success("Log obfuscation rule created successfully")
+		v.Print("ID:      %s\n", rule.ID)
+		v.Print("Name:    %s\n", rule.Name)
+		v.Print("Enabled: %t\n", rule.Enabled)
+		return nil
+	}
+}
+
+type deleteObfuscationOptions struct {
+	*root.Options
+	force bool
+}
+
+func newDeleteObfuscationCmd(opts *root.Options) *cobra.Command {
+	deleteOpts := &deleteObfuscationOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "delete <rule-id>",
+		Short: "Delete a log obfuscation rule",
+		Long: `Delete a log obfuscation rule.
+
+The rule's obfuscation expressions are kept, since other rules may use them.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDeleteObfuscation(deleteOpts, args[0])
+		},
+	}
+
+	root.AddForceFlag(cmd, &deleteOpts.force, "Skip confirmation prompt")
+	root.AddDryRunFlag(cmd, opts)
+
+	return cmd
+}
+
+func runDeleteObfuscation(opts *deleteObfuscationOptions, ruleID string) error {
+	v := opts.View()
+
+	if opts.DryRun {
+		return opts.PrintDryRun(fmt.Sprintf("delete log obfuscation rule %s", ruleID), nil)
+	}
+
+	if !opts.force {
+		p := &confirm.Prompter{
+			In:  opts.Stdin,
+			Out: opts.Stderr,
+		}
+		if !p.Confirm(fmt.Sprintf("Delete log obfuscation rule %s?", ruleID)) {
+			v.Warning("Operation canceled")
+			return nil
+		}
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteObfuscationRule(ruleID); err != nil {
+		return err
+	}
+
+	v.Success("Log obfuscation rule %s deleted", ruleID)
+	return nil
+}
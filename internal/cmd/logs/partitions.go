@@ -0,0 +1,244 @@
+package logs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/confirm"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+// partitionPrefix starts every log data partition name
+const partitionPrefix = "Log_"
+
+func newPartitionsCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "partitions",
+		Aliases: []string{"partition"},
+		Short:   "Manage log data partition rules",
+		Long: `Manage log data partition rules, which route matching logs to a data
+partition. Partitions can be queried separately (FROM Log_Security) and can
+use a different retention policy from the default Log partition.`,
+	}
+
+	cmd.AddCommand(newListPartitionsCmd(opts))
+	cmd.AddCommand(newCreatePartitionCmd(opts))
+	cmd.AddCommand(newDeletePartitionCmd(opts))
+
+	return cmd
+}
+
+type listPartitionsOptions struct {
+	*root.Options
+	limit int
+}
+
+func newListPartitionsCmd(opts *root.Options) *cobra.Command {
+	listOpts := &listPartitionsOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List log data partition rules",
+		Example: `  nrq logs partitions list
+  nrq logs partitions list -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runListPartitions(listOpts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&listOpts.limit, "limit", "l", 0, "Limit number of results (0 = no limit)")
+
+	return cmd
+}
+
+func runListPartitions(opts *listPartitionsOptions) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	rules, err := client.ListDataPartitionRules()
+	if err != nil {
+		return err
+	}
+
+	// Apply limit
+	if opts.limit > 0 && len(rules) > opts.limit {
+		rules = rules[:opts.limit]
+	}
+
+	v := opts.View()
+
+	if len(rules) == 0 {
+		v.Println("No log data partition rules found")
+		return nil
+	}
+
+	headers := []string{"ID", "PARTITION", "ENABLED", "RETENTION", "NRQL"}
+	rows := make([][]string, len(rules))
+	for i, r := range rules {
+		rows[i] = []string{
+			r.ID,
+			r.TargetDataPartition,
+			fmt.Sprintf("%t", r.Enabled),
+			r.RetentionPolicy,
+			view.Truncate(r.NRQL, 50),
+		}
+	}
+
+	return v.Render(headers, rows, rules)
+}
+
+type createPartitionOptions struct {
+	*root.Options
+	name        string
+	nrql        string
+	description string
+	retention   string
+	enabled     bool
+}
+
+func newCreatePartitionCmd(opts *root.Options) *cobra.Command {
+	createOpts := &createPartitionOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a log data partition rule",
+		Long: `Create a rule routing logs that match a NRQL WHERE clause to a data
+partition. Partition names must start with "Log_".
+
+Retention policies:
+  STANDARD   Same retention as the account's default Log data
+  SECONDARY  Shorter, cheaper retention
+
+Rules only apply to newly ingested logs.`,
+		Example: `  nrq logs partitions create --name Log_Security --nrql "WHERE category = 'security'"
+  nrq logs partitions create --name Log_Debug --nrql "WHERE level = 'debug'" --retention SECONDARY`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCreatePartition(createOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&createOpts.name, "name", "", "Target partition name, starting with Log_ (required)")
+	cmd.Flags().StringVar(&createOpts.nrql, "nrql", "", "NRQL WHERE clause selecting the logs to route (required)")
+	cmd.Flags().StringVarP(&createOpts.description, "description", "d", "", "Rule description")
+	cmd.Flags().StringVar(&createOpts.retention, "retention", "STANDARD", "Retention policy: STANDARD or SECONDARY")
+	cmd.Flags().BoolVarP(&createOpts.enabled, "enabled", "e", true, "Enable the rule")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("nrql")
+	root.AddDryRunFlag(cmd, opts)
+
+	return cmd
+}
+
+func runCreatePartition(opts *createPartitionOptions) error {
+	if !strings.HasPrefix(opts.name, partitionPrefix) {
+		return fmt.Errorf("invalid --name %q: partition names must start with %s", opts.name, partitionPrefix)
+	}
+	retention := strings.ToUpper(opts.retention)
+	if retention != "STANDARD" && retention != "SECONDARY" {
+		return fmt.Errorf("invalid --retention %q: must be STANDARD or SECONDARY", opts.retention)
+	}
+
+	input := api.DataPartitionRuleInput{
+		TargetDataPartition: opts.name,
+		Description:         opts.description,
+		Enabled:             opts.enabled,
+		NRQL:                opts.nrql,
+		RetentionPolicy:     retention,
+	}
+
+	if opts.DryRun {
+		return opts.PrintDryRun("create log data partition rule", input)
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	rule, err := client.CreateDataPartitionRule(input)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		return v.JSON(rule)
+	case "plain":
+		return v.Plain([][]string{
+			{rule.ID, rule.TargetDataPartition, fmt.Sprintf("%t", rule.Enabled)},
+		})
+	default:
+		v.Success("Log data partition rule created successfully")
+		v.Print("ID:        %s\n", rule.ID)
+		v.Print("Partition: %s\n", rule.TargetDataPartition)
+		v.Print("Retention: %s\n", rule.RetentionPolicy)
+		v.Print("Enabled:   %t\n", rule.Enabled)
+		return nil
+	}
+}
+
+type deletePartitionOptions struct {
+	*root.Options
+	force bool
+}
+
+func newDeletePartitionCmd(opts *root.Options) *cobra.Command {
+	deleteOpts := &deletePartitionOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "delete <rule-id>",
+		Short: "Delete a log data partition rule",
+		Long: `Delete a log data partition rule.
+
+New logs stop being routed to the partition; logs already in it are kept
+until they expire.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDeletePartition(deleteOpts, args[0])
+		},
+	}
+
+	root.AddForceFlag(cmd, &deleteOpts.force, "Skip confirmation prompt")
+	root.AddDryRunFlag(cmd, opts)
+
+	return cmd
+}
+
+func runDeletePartition(opts *deletePartitionOptions, ruleID string) error {
+	v := opts.View()
+
+	if opts.DryRun {
+		return opts.PrintDryRun(fmt.Sprintf("delete log data partition rule %s", ruleID), nil)
+	}
+
+	if !opts.force {
+		p := &confirm.Prompter{
+			In:  opts.Stdin,
+			Out: opts.Stderr,
+		}
+		if !p.Confirm(fmt.Sprintf("Delete log data partition rule %s?", ruleID)) {
+			v.Warning("Operation canceled")
+			return nil
+		}
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteDataPartitionRule(ruleID); err != nil {
+		return err
+	}
+
+	v.Success("Log data partition rule %s deleted", ruleID)
+	return nil
+}
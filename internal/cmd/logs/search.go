@@ -0,0 +1,307 @@
+package logs
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+// defaultLineWidth is used when the terminal width is unknown
+const defaultLineWidth = 120
+
+// logTimestampLayout is the layout of timestamps in log lines
+const logTimestampLayout = "2006-01-02 15:04:05"
+
+// maxContextLines caps --context-lines, since each result costs a query
+const maxContextLines = 5
+
+type searchOptions struct {
+	*root.Options
+	since string
+	until string
+	limit int
+	tail  bool
+	lines int
+}
+
+func newSearchCmd(opts *root.Options) *cobra.Command {
+	searchOpts := &searchOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search log data",
+		Long: `Search log data with an NRQL WHERE condition.
+
+The query is used as the WHERE clause of "FROM Log SELECT *". Results are
+shown newest first, one line per log with the timestamp followed by the
+message, truncated to the terminal width. Use -o json for the raw results.
+
+--context-lines N shows up to N logs before and after each result, taken
+from the same entity within N seconds of it. Context lines are dimmed, or
+bracketed when color is off. Each result needs an extra query, so N is
+capped at 5.`,
+		Example: `  nrq logs search "level = 'error'"
+  nrq logs search "message LIKE '%timeout%'" --since "30 minutes ago"
+  nrq logs search "service.name = 'checkout'" --since "2025-01-01" --until "2025-01-02" --limit 500
+  nrq logs search "level = 'error'" -o json
+  nrq logs search "level = 'error'" --context-lines 3 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSearch(searchOpts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&searchOpts.since, "since", "1 hour ago", "Time range start (e.g., '30 minutes ago', '2025-01-01')")
+	cmd.Flags().StringVar(&searchOpts.until, "until", "", "Time range end (e.g., 'now', '2025-01-15')")
+	cmd.Flags().IntVarP(&searchOpts.limit, "limit", "l", 100, "Maximum number of logs to return")
+	cmd.Flags().BoolVar(&searchOpts.tail, "tail", false, "Use NRQL live tail (future)")
+	cmd.Flags().IntVarP(&searchOpts.lines, "context-lines", "C", 0, fmt.Sprintf("Show up to N surrounding logs for each result (max %d)", maxContextLines))
+
+	return cmd
+}
+
+func runSearch(opts *searchOptions, query string) error {
+	if opts.tail {
+		return errors.New("--tail is not implemented yet")
+	}
+	if opts.limit <= 0 {
+		return fmt.Errorf("--limit must be greater than 0")
+	}
+	if opts.lines < 0 {
+		return fmt.Errorf("--context-lines must not be negative")
+	}
+	if opts.lines > maxContextLines {
+		opts.View().Warning("--context-lines capped at %d", maxContextLines)
+		opts.lines = maxContextLines
+	}
+
+	nrql, err := buildSearchQuery(query, opts.since, opts.until, opts.limit)
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	result, err := client.QueryNRQL(nrql)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	if opts.lines > 0 {
+		return renderWithContext(opts, client, sortLogsByTimestamp(result.Results))
+	}
+
+	if v.Format == view.FormatJSON {
+		return v.JSON(result.Results)
+	}
+
+	if len(result.Results) == 0 {
+		v.Println("No logs found")
+		return nil
+	}
+
+	logs := sortLogsByTimestamp(result.Results)
+
+	if v.Format == view.FormatTable {
+		width := terminalWidth()
+		for _, l := range logs {
+			v.Println(formatLogLine(l, width))
+		}
+		return nil
+	}
+
+	headers := []string{"TIMESTAMP", "MESSAGE"}
+	rows := make([][]string, len(logs))
+	for i, l := range logs {
+		rows[i] = []string{logTimestamp(l), logMessage(l)}
+	}
+	return v.Render(headers, rows, logs)
+}
+
+// logWithContext is a search result with the logs around it
+type logWithContext struct {
+	Log    map[string]interface{}   `json:"log"`
+	Before []map[string]interface{} `json:"before"`
+	After  []map[string]interface{} `json:"after"`
+}
+
+// renderWithContext fetches the logs around each result and renders the
+// groups newest first, each in time order and separated by "--"
+func renderWithContext(opts *searchOptions, client *api.Client, logs []map[string]interface{}) error {
+	v := opts.View()
+
+	spinner := opts.Spinner(fmt.Sprintf("Fetching context (0/%d)", len(logs)))
+	groups := make([]logWithContext, len(logs))
+	for i, l := range logs {
+		guid, _ := l["entity.guid"].(string)
+		entries, err := client.GetSurroundingLogs(time.UnixMilli(int64(logTime(l))), guid, opts.lines)
+		if err != nil {
+			spinner.Stop()
+			return fmt.Errorf("failed to fetch context: %w", err)
+		}
+		before, after := contextAround(entries, l, opts.lines)
+		groups[i] = logWithContext{Log: l, Before: before, After: after}
+		spinner.SetMessage(fmt.Sprintf("Fetching context (%d/%d)", i+1, len(logs)))
+	}
+	spinner.Stop()
+
+	if v.Format == view.FormatJSON {
+		return v.JSON(groups)
+	}
+
+	if len(groups) == 0 {
+		v.Println("No logs found")
+		return nil
+	}
+
+	if v.Format == view.FormatTable {
+		width := terminalWidth()
+		for i, g := range groups {
+			if i > 0 {
+				v.Println("--")
+			}
+			for _, c := range g.Before {
+				v.Println(contextLine(v, formatLogLine(c, width)))
+			}
+			v.Println(formatLogLine(g.Log, width))
+			for _, c := range g.After {
+				v.Println(contextLine(v, formatLogLine(c, width)))
+			}
+		}
+		return nil
+	}
+
+	headers := []string{"TIMESTAMP", "MESSAGE"}
+	var rows [][]string
+	for _, g := range groups {
+		for _, c := range g.Before {
+			rows = append(rows, []string{logTimestamp(c), "[" + logMessage(c) + "]"})
+		}
+		rows = append(rows, []string{logTimestamp(g.Log), logMessage(g.Log)})
+		for _, c := range g.After {
+			rows = append(rows, []string{logTimestamp(c), "[" + logMessage(c) + "]"})
+		}
+	}
+	return v.Render(headers, rows, groups)
+}
+
+// contextAround returns up to n logs before and after match from entries,
+// which are in time order and may include match itself
+func contextAround(entries []api.LogEntry, match map[string]interface{}, n int) (before, after []map[string]interface{}) {
+	matchTime := int64(logTime(match))
+	message := logMessage(match)
+
+	split := -1
+	for i, e := range entries {
+		if e.Timestamp.UnixMilli() == matchTime && e.Message == message {
+			split = i
+			break
+		}
+	}
+
+	for i, e := range entries {
+		switch {
+		case i == split:
+		case split >= 0 && i < split, split < 0 && e.Timestamp.UnixMilli() < matchTime:
+			before = append(before, e.Attributes)
+		default:
+			after = append(after, e.Attributes)
+		}
+	}
+
+	if len(before) > n {
+		before = before[len(before)-n:]
+	}
+	if len(after) > n {
+		after = after[:n]
+	}
+	return before, after
+}
+
+// contextLine marks a context line: dimmed in color output, bracketed
+// otherwise
+func contextLine(v *view.View, line string) string {
+	if v.NoColor {
+		return "[" + line + "]"
+	}
+	return v.Dim(line)
+}
+
+// buildSearchQuery builds the NRQL for a log search
+func buildSearchQuery(query, since, until string, limit int) (string, error) {
+	nrql := "FROM Log SELECT *"
+	if strings.TrimSpace(query) != "" {
+		nrql += " WHERE " + query
+	}
+
+	nrql, err := api.AppendNRQLTimeRange(nrql, since, until, false)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s LIMIT %d", nrql, limit), nil
+}
+
+// sortLogsByTimestamp returns logs ordered newest first
+func sortLogsByTimestamp(logs []map[string]interface{}) []map[string]interface{} {
+	sorted := make([]map[string]interface{}, len(logs))
+	copy(sorted, logs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return logTime(sorted[i]) > logTime(sorted[j])
+	})
+	return sorted
+}
+
+// formatLogLine formats a log as "timestamp  message", fitted to width
+func formatLogLine(l map[string]interface{}, width int) string {
+	prefix := logTimestamp(l) + "  "
+	message := strings.Join(strings.Fields(logMessage(l)), " ")
+
+	available := width - len(prefix)
+	if available < 10 {
+		available = 10
+	}
+	return prefix + view.Truncate(message, available)
+}
+
+// logTime returns the log's timestamp in epoch milliseconds
+func logTime(l map[string]interface{}) float64 {
+	ts, _ := l["timestamp"].(float64)
+	return ts
+}
+
+func logTimestamp(l map[string]interface{}) string {
+	ts := logTime(l)
+	if ts == 0 {
+		return strings.Repeat("-", len(logTimestampLayout))
+	}
+	return time.UnixMilli(int64(ts)).Local().Format(logTimestampLayout)
+}
+
+func logMessage(l map[string]interface{}) string {
+	if msg, ok := l["message"].(string); ok {
+		return msg
+	}
+	return ""
+}
+
+// terminalWidth returns the terminal width, or a default when it is unknown
+func terminalWidth() int {
+	if n := view.TerminalWidth(); n > 0 {
+		return n
+	}
+	return defaultLineWidth
+}
@@ -0,0 +1,105 @@
+package logs
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+)
+
+func TestBuildSearchQuery(t *testing.T) {
+	nrql, err := buildSearchQuery("level = 'error'", "1 hour ago", "", 100)
+
+	require.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`^FROM Log SELECT \* WHERE level = 'error' SINCE \d+ LIMIT 100$`), nrql)
+}
+
+func TestBuildSearchQuery_Until(t *testing.T) {
+	nrql, err := buildSearchQuery("level = 'error'", "2025-01-01", "2025-01-02", 10)
+
+	require.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`SINCE \d+ UNTIL \d+ LIMIT 10$`), nrql)
+}
+
+func TestBuildSearchQuery_InvalidSince(t *testing.T) {
+	_, err := buildSearchQuery("level = 'error'", "not a time", "", 100)
+
+	assert.Error(t, err)
+}
+
+func TestSortLogsByTimestamp(t *testing.T) {
+	logs := []map[string]interface{}{
+		{"timestamp": float64(1000), "message": "old"},
+		{"timestamp": float64(3000), "message": "new"},
+		{"timestamp": float64(2000), "message": "mid"},
+	}
+
+	sorted := sortLogsByTimestamp(logs)
+
+	assert.Equal(t, "new", sorted[0]["message"])
+	assert.Equal(t, "mid", sorted[1]["message"])
+	assert.Equal(t, "old", sorted[2]["message"])
+	assert.Equal(t, "old", logs[0]["message"], "input is not modified")
+}
+
+func TestFormatLogLine(t *testing.T) {
+	l := map[string]interface{}{
+		"timestamp": float64(1735689600000),
+		"message":   "connection refused\nretrying in 5s while contacting upstream service",
+	}
+
+	line := formatLogLine(l, 50)
+
+	assert.Len(t, line, 50)
+	assert.NotContains(t, line, "\n")
+	assert.Contains(t, line, "  connection refused retryin...")
+}
+
+func TestFormatLogLine_NoTimestamp(t *testing.T) {
+	line := formatLogLine(map[string]interface{}{"message": "hello"}, 80)
+
+	assert.Equal(t, "-------------------  hello", line)
+}
+
+func contextEntries(messages ...string) []api.LogEntry {
+	entries := make([]api.LogEntry, len(messages))
+	for i, m := range messages {
+		ts := int64(1000 * (i + 1))
+		entries[i] = api.LogEntry{
+			Timestamp:  time.UnixMilli(ts),
+			Message:    m,
+			Attributes: map[string]interface{}{"timestamp": float64(ts), "message": m},
+		}
+	}
+	return entries
+}
+
+func TestContextAround(t *testing.T) {
+	entries := contextEntries("a", "b", "c", "match", "d", "e", "f")
+	match := map[string]interface{}{"timestamp": float64(4000), "message": "match"}
+
+	before, after := contextAround(entries, match, 2)
+
+	require.Len(t, before, 2)
+	require.Len(t, after, 2)
+	assert.Equal(t, "b", before[0]["message"])
+	assert.Equal(t, "c", before[1]["message"])
+	assert.Equal(t, "d", after[0]["message"])
+	assert.Equal(t, "e", after[1]["message"])
+}
+
+func TestContextAround_MatchMissing(t *testing.T) {
+	entries := contextEntries("a", "b", "c", "d")
+	match := map[string]interface{}{"timestamp": float64(2500), "message": "gone"}
+
+	before, after := contextAround(entries, match, 5)
+
+	require.Len(t, before, 2)
+	require.Len(t, after, 2)
+	assert.Equal(t, "b", before[1]["message"])
+	assert.Equal(t, "c", after[0]["message"])
+}
@@ -1,9 +1,15 @@
 package nerdgraph
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/confirm"
 )
 
 // Register adds the nerdgraph commands to the root command
@@ -15,12 +21,23 @@ func Register(rootCmd *cobra.Command, opts *root.Options) {
 	}
 
 	nerdgraphCmd.AddCommand(newQueryCmd(opts))
+	nerdgraphCmd.AddCommand(newMutateCmd(opts))
+	nerdgraphCmd.AddCommand(newSchemaCmd(opts))
 
 	rootCmd.AddCommand(nerdgraphCmd)
 }
 
+// queryOptions holds options for the query command
+type queryOptions struct {
+	*root.Options
+	variablesFile string
+	vars          []string
+}
+
 func newQueryCmd(opts *root.Options) *cobra.Command {
-	return &cobra.Command{
+	queryOpts := &queryOptions{Options: opts}
+
+	cmd := &cobra.Command{
 		Use:   "query <graphql-query>",
 		Short: "Execute a GraphQL query",
 		Long: `Execute a GraphQL query against the NerdGraph API.
@@ -30,6 +47,12 @@ data and functionality. Use the NerdGraph API explorer to discover
 available queries and mutations:
   https://api.newrelic.com/graphiql
 
+Variables can be loaded from a JSON file with --variables-file and set
+individually with --var key=value; --var entries override file entries.
+A --var value is parsed as JSON when possible (numbers, booleans, objects),
+and is otherwise used as a string; quote it to force a string, as in
+--var 'id="123"'.
+
 Output is always JSON format.`,
 		Example: `  # Get current user info
   nrq nerdgraph query '{ actor { user { email name } } }'
@@ -59,6 +82,10 @@ Output is always JSON format.`,
     }
   }'
 
+  # Pass variables from a file, overriding one of them
+  nrq nerdgraph query 'query($guid: EntityGuid!) { actor { entity(guid: $guid) { name } } }' \
+    --variables-file vars.json --var guid=YOUR_ENTITY_GUID
+
   # Search entities
   nrq nerdgraph query '{
     actor {
@@ -74,18 +101,92 @@ Output is always JSON format.`,
   }'`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runQuery(opts, args[0])
+			return runQuery(queryOpts, args[0])
+		},
+	}
+
+	addVariableFlags(cmd, queryOpts)
+
+	return cmd
+}
+
+// addVariableFlags adds the flags that set GraphQL variables
+func addVariableFlags(cmd *cobra.Command, opts *queryOptions) {
+	cmd.Flags().StringVarP(&opts.variablesFile, "variables-file", "V", "", "JSON file containing query variables")
+	cmd.Flags().StringArrayVar(&opts.vars, "var", nil, "Query variable as key=value (repeatable)")
+}
+
+// mutateOptions holds options for the mutate command
+type mutateOptions struct {
+	queryOptions
+	force bool
+}
+
+func newMutateCmd(opts *root.Options) *cobra.Command {
+	mutateOpts := &mutateOptions{queryOptions: queryOptions{Options: opts}}
+
+	cmd := &cobra.Command{
+		Use:   "mutate <graphql-mutation>",
+		Short: "Execute a GraphQL mutation",
+		Long: `Execute a GraphQL mutation against the NerdGraph API.
+
+Works like 'nerdgraph query', but asks for confirmation before running the
+mutation unless --force is specified. When stdin is not a terminal (scripts,
+CI), the mutation is refused without --force instead of waiting for input.
+
+Output is always JSON format.`,
+		Example: `  # Add a tag to an entity
+  nrq nerdgraph mutate 'mutation {
+    taggingAddTagsToEntity(guid: "YOUR_ENTITY_GUID", tags: [{key: "env", values: ["production"]}]) {
+      errors { message }
+    }
+  }'
+
+  # Run from a script with variables
+  nrq nerdgraph mutate 'mutation($guid: EntityGuid!) { dashboardDelete(guid: $guid) { status } }' \
+    --var guid=YOUR_DASHBOARD_GUID --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMutate(mutateOpts, args[0])
 		},
 	}
+
+	addVariableFlags(cmd, &mutateOpts.queryOptions)
+	root.AddForceFlag(cmd, &mutateOpts.force, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runMutate(opts *mutateOptions, mutation string) error {
+	if !opts.force {
+		p := &confirm.Prompter{
+			In:  opts.Stdin,
+			Out: opts.Stderr,
+		}
+		if !p.IsInteractive() {
+			return fmt.Errorf("refusing to run mutation without confirmation: stdin is not a terminal (use --force)")
+		}
+		if !p.Confirm("Execute NerdGraph mutation?") {
+			opts.View().Warning("Operation canceled")
+			return nil
+		}
+	}
+
+	return runQuery(&opts.queryOptions, mutation)
 }
 
-func runQuery(opts *root.Options, query string) error {
+func runQuery(opts *queryOptions, query string) error {
+	variables, err := loadVariables(opts.variablesFile, opts.vars)
+	if err != nil {
+		return err
+	}
+
 	client, err := opts.APIClient()
 	if err != nil {
 		return err
 	}
 
-	result, err := client.NerdGraphQuery(query, nil)
+	result, err := client.NerdGraphQuery(query, variables)
 	if err != nil {
 		return err
 	}
@@ -93,3 +194,49 @@ func runQuery(opts *root.Options, query string) error {
 	v := opts.View()
 	return v.JSON(result)
 }
+
+// loadVariables reads variables from a JSON file, if given, and applies
+// key=value overrides. It returns nil when there are no variables.
+func loadVariables(file string, vars []string) (map[string]interface{}, error) {
+	var variables map[string]interface{}
+
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read variables file: %w", err)
+		}
+
+		var parsed interface{}
+		if err := json.Unmarshal(data, &parsed); err != nil {
+			return nil, fmt.Errorf("invalid JSON in variables file: %w", err)
+		}
+		obj, ok := parsed.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("variables file must contain a JSON object")
+		}
+		variables = obj
+	}
+
+	for _, v := range vars {
+		key, value, ok := strings.Cut(v, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --var %q: expected key=value", v)
+		}
+		if variables == nil {
+			variables = map[string]interface{}{}
+		}
+		variables[key] = parseVarValue(value)
+	}
+
+	return variables, nil
+}
+
+// parseVarValue decodes a --var value as JSON, falling back to the raw string
+func parseVarValue(value string) interface{} {
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(value), &parsed); err == nil {
+		return parsed
+	}
+	return value
+}
@@ -0,0 +1,94 @@
+package nerdgraph
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+// writeVariablesFile writes content to a temporary variables file
+func writeVariablesFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "vars.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoadVariables_None(t *testing.T) {
+	variables, err := loadVariables("", nil)
+	require.NoError(t, err)
+	assert.Nil(t, variables)
+}
+
+func TestLoadVariables_File(t *testing.T) {
+	path := writeVariablesFile(t, `{"accountId": 12345, "input": {"name": "test"}}`)
+
+	variables, err := loadVariables(path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, float64(12345), variables["accountId"])
+	assert.Equal(t, map[string]interface{}{"name": "test"}, variables["input"])
+}
+
+func TestLoadVariables_VarsOverrideFile(t *testing.T) {
+	path := writeVariablesFile(t, `{"accountId": 12345, "name": "old"}`)
+
+	variables, err := loadVariables(path, []string{"name=new", "accountId=67890", "enabled=true", `id="123"`, "query=a=b"})
+	require.NoError(t, err)
+	assert.Equal(t, "new", variables["name"])
+	assert.Equal(t, float64(67890), variables["accountId"])
+	assert.Equal(t, true, variables["enabled"])
+	assert.Equal(t, "123", variables["id"])
+	assert.Equal(t, "a=b", variables["query"])
+}
+
+func TestLoadVariables_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		vars    []string
+		wantErr string
+	}{
+		{"invalid json", `{"a":`, nil, "invalid JSON in variables file"},
+		{"top level array", `[1, 2]`, nil, "must contain a JSON object"},
+		{"top level string", `"vars"`, nil, "must contain a JSON object"},
+		{"var without value", `{}`, []string{"name"}, "expected key=value"},
+		{"var without key", `{}`, []string{"=value"}, "expected key=value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadVariables(writeVariablesFile(t, tt.content), tt.vars)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestLoadVariables_MissingFile(t *testing.T) {
+	_, err := loadVariables(filepath.Join(t.TempDir(), "missing.json"), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read variables file")
+}
+
+func TestRunMutate_RefusesWithoutTerminal(t *testing.T) {
+	var stderr bytes.Buffer
+	opts := &mutateOptions{queryOptions: queryOptions{Options: &root.Options{
+		Stdin:  strings.NewReader("y\n"),
+		Stdout: &bytes.Buffer{},
+		Stderr: &stderr,
+	}}}
+
+	err := runMutate(opts, `mutation { dashboardDelete(guid: "abc") { status } }`)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "stdin is not a terminal")
+	assert.Contains(t, err.Error(), "--force")
+	assert.Empty(t, stderr.String(), "no prompt should be shown")
+}
@@ -0,0 +1,271 @@
+package nerdgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/config"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+// schemaCacheTTL is how long a cached NerdGraph schema is reused
+const schemaCacheTTL = 24 * time.Hour
+
+// schemaCacheFile is the name of the schema cache in the cache directory
+const schemaCacheFile = "schema.json"
+
+// schemaType is a type from the NerdGraph introspection schema
+type schemaType struct {
+	Name        string        `json:"name"`
+	Kind        string        `json:"kind"`
+	Description string        `json:"description,omitempty"`
+	Fields      []schemaField `json:"fields,omitempty"`
+}
+
+// schemaField is a field of a schema type
+type schemaField struct {
+	Name string        `json:"name"`
+	Type schemaTypeRef `json:"type"`
+}
+
+// schemaTypeRef is a reference to a type, possibly wrapped in lists or
+// non-null markers through OfType
+type schemaTypeRef struct {
+	Name   string         `json:"name"`
+	Kind   string         `json:"kind"`
+	OfType *schemaTypeRef `json:"ofType"`
+}
+
+// String renders the reference in GraphQL notation, such as [Entity!]
+func (r schemaTypeRef) String() string {
+	switch {
+	case r.Kind == "NON_NULL" && r.OfType != nil:
+		return r.OfType.String() + "!"
+	case r.Kind == "LIST" && r.OfType != nil:
+		return "[" + r.OfType.String() + "]"
+	case r.Name != "":
+		return r.Name
+	default:
+		return r.Kind
+	}
+}
+
+type schemaOptions struct {
+	*root.Options
+	typeName string
+	search   string
+	refresh  bool
+}
+
+func newSchemaCmd(opts *root.Options) *cobra.Command {
+	schemaOpts := &schemaOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Browse the NerdGraph schema",
+		Long: `Browse the NerdGraph schema using introspection.
+
+Without flags, every type is listed. --type shows the fields of one type,
+and --search lists the types and fields whose names contain a pattern
+(case-insensitive). Built-in introspection types (__Type and so on) are
+left out.
+
+The schema is cached in the newrelic-cli cache directory
+(~/.cache/newrelic-cli/schema.json) for 24 hours; use --refresh to fetch
+it again.`,
+		Example: `  nrq nerdgraph schema
+  nrq nerdgraph schema --type DashboardEntity
+  nrq nerdgraph schema --search mutation
+  nrq nerdgraph schema --type RootMutationType -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSchema(schemaOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&schemaOpts.typeName, "type", "", "Show the fields of this type")
+	cmd.Flags().StringVar(&schemaOpts.search, "search", "", "Show types and fields whose names contain this pattern")
+	cmd.Flags().BoolVar(&schemaOpts.refresh, "refresh", false, "Fetch the schema even if a cached copy is available")
+	cmd.MarkFlagsMutuallyExclusive("type", "search")
+
+	return cmd
+}
+
+func runSchema(opts *schemaOptions) error {
+	types, err := loadSchemaTypes(opts)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	switch {
+	case opts.typeName != "":
+		t, ok := findSchemaType(types, opts.typeName)
+		if !ok {
+			return fmt.Errorf("type %q not found in the NerdGraph schema", opts.typeName)
+		}
+		if len(t.Fields) == 0 {
+			v.Print("%s (%s) has no fields\n", t.Name, t.Kind)
+			return nil
+		}
+
+		headers := []string{"FIELD", "TYPE"}
+		rows := make([][]string, len(t.Fields))
+		for i, f := range t.Fields {
+			rows[i] = []string{f.Name, f.Type.String()}
+		}
+		return v.Render(headers, rows, t)
+
+	case opts.search != "":
+		matches := searchSchema(types, opts.search)
+		if len(matches) == 0 {
+			v.Print("No types or fields match %q\n", opts.search)
+			return nil
+		}
+
+		headers := []string{"TYPE", "FIELD", "FIELD TYPE"}
+		var rows [][]string
+		for _, t := range matches {
+			if len(t.Fields) == 0 {
+				rows = append(rows, []string{t.Name, "", ""})
+			}
+			for _, f := range t.Fields {
+				rows = append(rows, []string{t.Name, f.Name, f.Type.String()})
+			}
+		}
+		return v.Render(headers, rows, matches)
+
+	default:
+		headers := []string{"NAME", "KIND", "DESCRIPTION"}
+		rows := make([][]string, len(types))
+		for i, t := range types {
+			rows[i] = []string{t.Name, t.Kind, view.Truncate(firstLine(t.Description), 60)}
+		}
+		return v.Render(headers, rows, types)
+	}
+}
+
+// loadSchemaTypes returns the schema types, sorted by name, from the cache
+// when it is fresh and otherwise from NerdGraph, refreshing the cache
+func loadSchemaTypes(opts *schemaOptions) ([]schemaType, error) {
+	cachePath := filepath.Join(config.GetCacheDir(), schemaCacheFile)
+
+	data, ok := readSchemaCache(cachePath, time.Now())
+	if !ok || opts.refresh {
+		client, err := opts.APIClient()
+		if err != nil {
+			return nil, err
+		}
+
+		spinner := opts.Spinner("Fetching NerdGraph schema")
+		schema, err := client.GetNerdGraphSchema()
+		spinner.Stop()
+		if err != nil {
+			return nil, err
+		}
+
+		data, err = json.Marshal(schema)
+		if err != nil {
+			return nil, err
+		}
+		// The cache only saves roundtrips, so failing to write it is not an error
+		_ = writeSchemaCache(cachePath, data)
+	}
+
+	return parseSchemaTypes(data)
+}
+
+// readSchemaCache returns the cached schema if it exists and is younger
+// than schemaCacheTTL at now
+func readSchemaCache(path string, now time.Time) ([]byte, bool) {
+	info, err := os.Stat(path)
+	if err != nil || now.Sub(info.ModTime()) > schemaCacheTTL {
+		return nil, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// writeSchemaCache stores the schema, creating the cache directory if needed
+func writeSchemaCache(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// parseSchemaTypes decodes an introspection __schema object, dropping the
+// built-in introspection types and sorting the rest by name
+func parseSchemaTypes(data []byte) ([]schemaType, error) {
+	var schema struct {
+		Types []schemaType `json:"types"`
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse NerdGraph schema: %w", err)
+	}
+
+	types := make([]schemaType, 0, len(schema.Types))
+	for _, t := range schema.Types {
+		if !strings.HasPrefix(t.Name, "__") {
+			types = append(types, t)
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	return types, nil
+}
+
+// findSchemaType looks up a type by name, ignoring case
+func findSchemaType(types []schemaType, name string) (schemaType, bool) {
+	for _, t := range types {
+		if strings.EqualFold(t.Name, name) {
+			return t, true
+		}
+	}
+	return schemaType{}, false
+}
+
+// searchSchema returns the types whose name contains pattern, with all
+// their fields, and the types with fields whose name contains pattern,
+// with only those fields. Matching ignores case.
+func searchSchema(types []schemaType, pattern string) []schemaType {
+	pattern = strings.ToLower(pattern)
+
+	var matches []schemaType
+	for _, t := range types {
+		if strings.Contains(strings.ToLower(t.Name), pattern) {
+			matches = append(matches, t)
+			continue
+		}
+
+		var fields []schemaField
+		for _, f := range t.Fields {
+			if strings.Contains(strings.ToLower(f.Name), pattern) {
+				fields = append(fields, f)
+			}
+		}
+		if len(fields) > 0 {
+			t.Fields = fields
+			matches = append(matches, t)
+		}
+	}
+	return matches
+}
+
+// firstLine returns the first line of a description
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
@@ -0,0 +1,87 @@
+package nerdgraph
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `{
+	"types": [
+		{"name": "RootMutationType", "kind": "OBJECT", "fields": [
+			{"name": "dashboardCreate", "type": {"name": "DashboardCreateResult", "kind": "OBJECT"}}
+		]},
+		{"name": "DashboardEntity", "kind": "OBJECT", "fields": [
+			{"name": "guid", "type": {"kind": "NON_NULL", "ofType": {"name": "EntityGuid", "kind": "SCALAR"}}},
+			{"name": "pages", "type": {"kind": "LIST", "ofType": {"kind": "NON_NULL", "ofType": {"name": "DashboardPage", "kind": "OBJECT"}}}}
+		]},
+		{"name": "__Type", "kind": "OBJECT", "fields": []}
+	]
+}`
+
+func TestParseSchemaTypes(t *testing.T) {
+	types, err := parseSchemaTypes([]byte(testSchema))
+
+	require.NoError(t, err)
+	require.Len(t, types, 2)
+	assert.Equal(t, "DashboardEntity", types[0].Name)
+	assert.Equal(t, "RootMutationType", types[1].Name)
+
+	assert.Equal(t, "EntityGuid!", types[0].Fields[0].Type.String())
+	assert.Equal(t, "[DashboardPage!]", types[0].Fields[1].Type.String())
+}
+
+func TestFindSchemaType(t *testing.T) {
+	types, err := parseSchemaTypes([]byte(testSchema))
+	require.NoError(t, err)
+
+	found, ok := findSchemaType(types, "dashboardentity")
+	require.True(t, ok)
+	assert.Equal(t, "DashboardEntity", found.Name)
+
+	_, ok = findSchemaType(types, "Missing")
+	assert.False(t, ok)
+}
+
+func TestSearchSchema(t *testing.T) {
+	types, err := parseSchemaTypes([]byte(testSchema))
+	require.NoError(t, err)
+
+	// A type name match keeps every field
+	matches := searchSchema(types, "mutation")
+	require.Len(t, matches, 1)
+	assert.Equal(t, "RootMutationType", matches[0].Name)
+
+	// A field name match keeps only the matching fields
+	matches = searchSchema(types, "PAGES")
+	require.Len(t, matches, 1)
+	assert.Equal(t, "DashboardEntity", matches[0].Name)
+	require.Len(t, matches[0].Fields, 1)
+	assert.Equal(t, "pages", matches[0].Fields[0].Name)
+
+	assert.Empty(t, searchSchema(types, "nothing"))
+}
+
+func TestSchemaCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", schemaCacheFile)
+
+	_, ok := readSchemaCache(path, time.Now())
+	assert.False(t, ok, "missing cache")
+
+	require.NoError(t, writeSchemaCache(path, []byte(testSchema)))
+
+	data, ok := readSchemaCache(path, time.Now())
+	require.True(t, ok)
+	assert.Equal(t, testSchema, string(data))
+
+	_, ok = readSchemaCache(path, time.Now().Add(schemaCacheTTL+time.Minute))
+	assert.False(t, ok, "expired cache")
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
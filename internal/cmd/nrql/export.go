@@ -0,0 +1,246 @@
+package nrql
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+type exportOptions struct {
+	*root.Options
+	file      string
+	format    string
+	since     string
+	until     string
+	sinceNRQL bool
+}
+
+func newExportCmd(opts *root.Options) *cobra.Command {
+	exportOpts := &exportOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "export <nrql>",
+		Short: "Export NRQL query results to a file",
+		Long: `Export all results of an NRQL query to a CSV or JSON file.
+
+Results are fetched page by page and written as they arrive, with progress
+reported on stderr. The file is written to a temporary path and renamed
+into place once the export completes, so a failed export never leaves a
+partial file behind.
+
+CSV columns are taken from the first page of results.`,
+		Example: `  nrq nrql export "SELECT * FROM Transaction" --file results.csv
+  nrq nrql export "SELECT * FROM Log" --file logs.json --format json
+  nrq nrql export "SELECT * FROM Transaction" --file results.csv --since "1 day ago"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExport(exportOpts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&exportOpts.file, "file", "", "Path of the file to write (required)")
+	cmd.Flags().StringVar(&exportOpts.format, "format", "csv", "File format: csv or json")
+	cmd.Flags().StringVar(&exportOpts.since, "since", "", "Time range start (e.g., '7 days ago', '2025-01-01')")
+	cmd.Flags().StringVar(&exportOpts.until, "until", "", "Time range end (e.g., 'now', '2025-01-15')")
+	cmd.Flags().BoolVar(&exportOpts.sinceNRQL, "since-nrql", false, "Write --since/--until as NRQL time expressions instead of Unix timestamps")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runExport(opts *exportOptions, nrql string) error {
+	if opts.format != "csv" && opts.format != "json" {
+		return fmt.Errorf("invalid format %q: must be csv or json", opts.format)
+	}
+
+	finalQuery, err := api.AppendNRQLTimeRange(nrql, opts.since, opts.until, opts.sinceNRQL)
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	tmpPath := opts.file + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", tmpPath, err)
+	}
+
+	total, err := exportPages(client.QueryNRQLPaginated, finalQuery, newResultWriter(opts.format, f), opts.Stderr)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	if err := os.Rename(tmpPath, opts.file); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to write %s: %w", opts.file, err)
+	}
+
+	opts.View().Success("Exported %d rows to %s", total, opts.file)
+	return nil
+}
+
+// pageFetcher fetches one page of NRQL results starting at cursor
+type pageFetcher func(nrql string, cursor string) (*api.NRQLResult, error)
+
+// exportPages follows the result cursor until the last page, writing each
+// page to w and reporting progress to progress. It returns the row count.
+func exportPages(fetch pageFetcher, nrql string, w resultWriter, progress io.Writer) (int, error) {
+	total := 0
+	cursor := ""
+	for page := 1; ; page++ {
+		result, err := fetch(nrql, cursor)
+		if err != nil {
+			return total, err
+		}
+
+		if err := w.Write(result.Results); err != nil {
+			return total, fmt.Errorf("failed to write results: %w", err)
+		}
+		total += len(result.Results)
+		fmt.Fprintf(progress, "Fetched page %d (%d rows total)\n", page, total)
+
+		if result.NextCursor == "" {
+			break
+		}
+		cursor = result.NextCursor
+	}
+
+	if err := w.Close(); err != nil {
+		return total, fmt.Errorf("failed to write results: %w", err)
+	}
+	return total, nil
+}
+
+// resultWriter writes NRQL result rows incrementally
+type resultWriter interface {
+	Write(rows []map[string]interface{}) error
+	Close() error
+}
+
+func newResultWriter(format string, out io.Writer) resultWriter {
+	if format == "json" {
+		return &jsonResultWriter{out: out}
+	}
+	return &csvResultWriter{w: csv.NewWriter(out)}
+}
+
+// csvResultWriter writes rows as CSV, with a header taken from the first rows
+type csvResultWriter struct {
+	w       *csv.Writer
+	columns []string
+}
+
+func (c *csvResultWriter) Write(rows []map[string]interface{}) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	if c.columns == nil {
+		c.columns = resultColumns(rows)
+		if err := c.w.Write(c.columns); err != nil {
+			return err
+		}
+	}
+
+	for _, row := range rows {
+		record := make([]string, len(c.columns))
+		for i, col := range c.columns {
+			record[i] = formatValue(row[col])
+		}
+		if err := c.w.Write(record); err != nil {
+			return err
+		}
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvResultWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// jsonResultWriter writes rows as a single JSON array, one row per line
+type jsonResultWriter struct {
+	out   io.Writer
+	count int
+}
+
+func (j *jsonResultWriter) Write(rows []map[string]interface{}) error {
+	for _, row := range rows {
+		data, err := json.Marshal(row)
+		if err != nil {
+			return err
+		}
+		sep := ",\n"
+		if j.count == 0 {
+			sep = "[\n"
+		}
+		if _, err := fmt.Fprintf(j.out, "%s  %s", sep, data); err != nil {
+			return err
+		}
+		j.count++
+	}
+	return nil
+}
+
+func (j *jsonResultWriter) Close() error {
+	if j.count == 0 {
+		_, err := io.WriteString(j.out, "[]\n")
+		return err
+	}
+	_, err := io.WriteString(j.out, "\n]\n")
+	return err
+}
+
+// resultColumns returns the sorted set of keys across rows
+func resultColumns(rows []map[string]interface{}) []string {
+	seen := map[string]bool{}
+	var columns []string
+	for _, row := range rows {
+		for k := range row {
+			if !seen[k] {
+				seen[k] = true
+				columns = append(columns, k)
+			}
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// formatValue renders an NRQL result value as a flat string
+func formatValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	default:
+		data, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Sprintf("%v", val)
+		}
+		return string(data)
+	}
+}
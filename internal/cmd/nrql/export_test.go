@@ -0,0 +1,82 @@
+package nrql
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+)
+
+func TestExportPages_FollowsCursor(t *testing.T) {
+	pages := map[string]*api.NRQLResult{
+		"": {
+			Results:    []map[string]interface{}{{"name": "a", "count": float64(1)}},
+			NextCursor: "page-2",
+		},
+		"page-2": {
+			Results: []map[string]interface{}{{"name": "b", "count": 2.5}},
+		},
+	}
+	var cursors []string
+	fetch := func(nrql, cursor string) (*api.NRQLResult, error) {
+		cursors = append(cursors, cursor)
+		return pages[cursor], nil
+	}
+
+	var out, progress bytes.Buffer
+	total, err := exportPages(fetch, "SELECT * FROM Log", newResultWriter("csv", &out), &progress)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, []string{"", "page-2"}, cursors)
+	assert.Equal(t, "count,name\n1,a\n2.5,b\n", out.String())
+	assert.Contains(t, progress.String(), "Fetched page 2 (2 rows total)")
+}
+
+func TestExportPages_JSON(t *testing.T) {
+	fetch := func(nrql, cursor string) (*api.NRQLResult, error) {
+		return &api.NRQLResult{Results: []map[string]interface{}{{"a": 1}, {"a": 2}}}, nil
+	}
+
+	var out bytes.Buffer
+	_, err := exportPages(fetch, "SELECT * FROM Log", newResultWriter("json", &out), &bytes.Buffer{})
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"a":1},{"a":2}]`, out.String())
+}
+
+func TestExportPages_Empty(t *testing.T) {
+	fetch := func(nrql, cursor string) (*api.NRQLResult, error) {
+		return &api.NRQLResult{}, nil
+	}
+
+	var out bytes.Buffer
+	total, err := exportPages(fetch, "SELECT * FROM Log", newResultWriter("json", &out), &bytes.Buffer{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, total)
+	assert.JSONEq(t, `[]`, out.String())
+}
+
+func TestExportPages_FetchError(t *testing.T) {
+	fetch := func(nrql, cursor string) (*api.NRQLResult, error) {
+		return nil, errors.New("boom")
+	}
+
+	_, err := exportPages(fetch, "SELECT * FROM Log", newResultWriter("csv", &bytes.Buffer{}), &bytes.Buffer{})
+
+	assert.EqualError(t, err, "boom")
+}
+
+func TestFormatValue(t *testing.T) {
+	assert.Equal(t, "", formatValue(nil))
+	assert.Equal(t, "text", formatValue("text"))
+	assert.Equal(t, "1500", formatValue(float64(1500)))
+	assert.Equal(t, "0.25", formatValue(0.25))
+	assert.Equal(t, "true", formatValue(true))
+	assert.Equal(t, `["a","b"]`, formatValue([]interface{}{"a", "b"}))
+}
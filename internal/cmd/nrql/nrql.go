@@ -2,18 +2,40 @@ package nrql
 
 import (
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/open-cli-collective/newrelic-cli/api"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/validate"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
 )
 
+// wideTableColumns is the column count above which table values are truncated
+const wideTableColumns = 8
+
+// wideTableValueWidth is the maximum value width in a wide table
+const wideTableValueWidth = 20
+
+// asyncPollInterval is the wait between polls of an async query when
+// NerdGraph does not suggest one
+const asyncPollInterval = 2 * time.Second
+
+// asyncQueryTimeout is how long an async query is polled before giving up
+const asyncQueryTimeout = 10 * time.Minute
+
 type queryOptions struct {
 	*root.Options
-	since string
-	until string
+	since     string
+	until     string
+	sinceNRQL bool
+	jsonArray bool
+	async     bool
 }
 
 // Register adds the nrql commands to the root command
@@ -32,8 +54,13 @@ or via --since and --until flags which will be appended to your query.
 
 Supported time formats:
   - Relative: "7 days ago", "1 hour ago", "30 minutes ago"
+  - Compact relative: "7d", "1h", "30m", "1w"
   - Special: "now", "today", "yesterday"
-  - Absolute: "2025-01-01", "2025-01-01T00:00:00Z"`,
+  - Absolute: "2025-01-01", "2025-01-01T00:00:00Z"
+  - Unix timestamp: "1735689600" (seconds) or "1735689600000" (milliseconds)
+
+Results are shown as a table with one column per result key. Use -o json
+for the full NerdGraph response.`,
 		Example: `  # Direct query (shortcut)
   nrq nrql "SELECT count(*) FROM Transaction SINCE 1 hour ago"
 
@@ -44,7 +71,16 @@ Supported time formats:
   nrq nrql "SELECT count(*) FROM Transaction" --since "7 days ago"
 
   # Using both --since and --until
-  nrq nrql "SELECT * FROM Log" --since "2025-01-01" --until "2025-01-15"`,
+  nrq nrql "SELECT * FROM Log" --since "2025-01-01" --until "2025-01-15"
+
+  # Append "SINCE 7 days ago" rather than a Unix timestamp
+  nrq nrql "SELECT count(*) FROM Transaction" --since "7 days ago" --since-nrql
+
+  # Compact JSON for piping into other tools
+  nrq nrql "SELECT count(*) FROM Transaction" -o json --pretty=false
+
+  # Run a long query asynchronously, polling until it completes
+  nrq nrql "SELECT * FROM Log SINCE 1 month ago LIMIT MAX" --async`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 {
@@ -56,9 +92,14 @@ Supported time formats:
 
 	nrqlCmd.Flags().StringVar(&queryOpts.since, "since", "", "Time range start (e.g., '7 days ago', '2025-01-01')")
 	nrqlCmd.Flags().StringVar(&queryOpts.until, "until", "", "Time range end (e.g., 'now', '2025-01-15')")
+	nrqlCmd.Flags().BoolVar(&queryOpts.sinceNRQL, "since-nrql", false, "Write --since/--until as NRQL time expressions instead of Unix timestamps")
+	nrqlCmd.Flags().BoolVar(&queryOpts.jsonArray, "json-array-mode", false, "Wrap the result in a JSON array")
+	nrqlCmd.Flags().BoolVar(&queryOpts.async, "async", false, "Run the query asynchronously and poll until it completes, for queries that exceed the synchronous timeout")
 
 	// Add query subcommand for compatibility
 	nrqlCmd.AddCommand(newQueryCmd(queryOpts))
+	nrqlCmd.AddCommand(newExportCmd(opts))
+	nrqlCmd.AddCommand(newTimeseriesCmd(opts))
 
 	rootCmd.AddCommand(nrqlCmd)
 }
@@ -73,7 +114,8 @@ Time ranges can be specified either in the query itself (SINCE/UNTIL clauses)
 or via --since and --until flags which will be appended to your query.`,
 		Example: `  nrq nrql query "SELECT count(*) FROM Transaction SINCE 1 hour ago"
   nrq nrql query "SELECT * FROM Log LIMIT 10"
-  nrq nrql query "SELECT count(*) FROM Transaction" --since "7 days ago"`,
+  nrq nrql query "SELECT count(*) FROM Transaction" --since "7 days ago"
+  nrq nrql query "SELECT * FROM Log LIMIT 10" -o json --pretty=false --json-array-mode`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runQuery(opts, args[0])
@@ -82,49 +124,158 @@ or via --since and --until flags which will be appended to your query.`,
 
 	cmd.Flags().StringVar(&opts.since, "since", "", "Time range start (e.g., '7 days ago', '2025-01-01')")
 	cmd.Flags().StringVar(&opts.until, "until", "", "Time range end (e.g., 'now', '2025-01-15')")
+	cmd.Flags().BoolVar(&opts.sinceNRQL, "since-nrql", false, "Write --since/--until as NRQL time expressions instead of Unix timestamps")
+	cmd.Flags().BoolVar(&opts.jsonArray, "json-array-mode", false, "Wrap the result in a JSON array")
+	cmd.Flags().BoolVar(&opts.async, "async", false, "Run the query asynchronously and poll until it completes, for queries that exceed the synchronous timeout")
 
 	return cmd
 }
 
 func runQuery(opts *queryOptions, nrql string) error {
+	warning, err := validate.NRQL(nrql)
+	if err != nil {
+		return err
+	}
+	if warning != "" {
+		opts.View().Warning("Warning: %s", warning)
+	}
+
 	client, err := opts.APIClient()
 	if err != nil {
 		return err
 	}
 
-	// Build the final query with time range flags
-	finalQuery := nrql
+	// Append time range flags unless the query already has them
+	finalQuery, err := api.AppendNRQLTimeRange(nrql, opts.since, opts.until, opts.sinceNRQL)
+	if err != nil {
+		return err
+	}
+
+	var result *api.NRQLResult
+	if opts.async {
+		result, err = queryAsync(opts, client, finalQuery)
+	} else {
+		result, err = client.QueryNRQL(finalQuery)
+	}
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+	if v.Format == view.FormatTable {
+		return renderNRQLTable(v, result.Results)
+	}
+	v.JSONArray = opts.jsonArray
+	return v.JSON(result)
+}
+
+// queryAsync submits an async NRQL query and polls it until it completes,
+// showing progress on a spinner
+func queryAsync(opts *queryOptions, client *api.Client, nrql string) (*api.NRQLResult, error) {
+	spinner := opts.Spinner("Submitting query")
+	defer spinner.Stop()
 
-	// Append SINCE clause if provided and not already in query
-	if opts.since != "" && !containsClause(nrql, "SINCE") {
-		since, err := api.ParseFlexibleTime(opts.since)
+	job, err := client.QueryNRQLAsync(nrql)
+	if err != nil {
+		return nil, err
+	}
+	if job.Completed {
+		return job.Result, nil
+	}
+
+	interval := asyncPollInterval
+	if job.RetryAfter > 0 {
+		interval = time.Duration(job.RetryAfter) * time.Second
+	}
+
+	start := time.Now()
+	for time.Since(start) < asyncQueryTimeout {
+		spinner.SetMessage(fmt.Sprintf("Waiting for query %s (%s elapsed)", job.QueryID, time.Since(start).Round(time.Second)))
+		time.Sleep(interval)
+
+		result, done, err := client.PollNRQLAsyncJob(job.QueryID)
 		if err != nil {
-			return fmt.Errorf("invalid --since value: %w", err)
+			return nil, err
+		}
+		if done {
+			return result, nil
 		}
-		// Use Unix timestamp for precision
-		finalQuery += fmt.Sprintf(" SINCE %d", since.Unix())
 	}
 
-	// Append UNTIL clause if provided and not already in query
-	if opts.until != "" && !containsClause(nrql, "UNTIL") {
-		until, err := api.ParseFlexibleTime(opts.until)
-		if err != nil {
-			return fmt.Errorf("invalid --until value: %w", err)
+	return nil, fmt.Errorf("query %s did not complete within %s", job.QueryID, asyncQueryTimeout)
+}
+
+// renderNRQLTable renders NRQL results as a table, using the keys of the
+// first result as columns. Wide results are truncated with a hint to use
+// JSON output instead.
+func renderNRQLTable(v *view.View, results []map[string]interface{}) error {
+	if len(results) == 0 {
+		v.Println("No results")
+		return nil
+	}
+
+	columns := nrqlColumns(results[0])
+	wide := len(columns) > wideTableColumns
+
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = strings.ToUpper(col)
+	}
+
+	rows := make([][]string, len(results))
+	for i, r := range results {
+		row := make([]string, len(columns))
+		for j, col := range columns {
+			row[j] = formatTableValue(r[col])
+			if wide {
+				row[j] = view.Truncate(row[j], wideTableValueWidth)
+			}
 		}
-		finalQuery += fmt.Sprintf(" UNTIL %d", until.Unix())
+		rows[i] = row
 	}
 
-	result, err := client.QueryNRQL(finalQuery)
-	if err != nil {
+	if err := v.Table(headers, rows); err != nil {
 		return err
 	}
+	if wide {
+		v.Warning("%d columns; values truncated to %d characters. Use -o json for full results.", len(columns), wideTableValueWidth)
+	}
+	return nil
+}
 
-	v := opts.View()
-	return v.JSON(result)
+// nrqlColumns returns the keys of a result in a stable order: timestamp,
+// then name, then the rest alphabetically
+func nrqlColumns(result map[string]interface{}) []string {
+	priority := map[string]int{"timestamp": 0, "name": 1}
+	rank := func(k string) int {
+		if p, ok := priority[k]; ok {
+			return p
+		}
+		return len(priority)
+	}
+
+	columns := make([]string, 0, len(result))
+	for k := range result {
+		columns = append(columns, k)
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		ri, rj := rank(columns[i]), rank(columns[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return columns[i] < columns[j]
+	})
+	return columns
 }
 
-// containsClause checks if the NRQL query already contains a specific clause
-func containsClause(nrql, clause string) bool {
-	upper := strings.ToUpper(nrql)
-	return strings.Contains(upper, " "+clause+" ") || strings.HasSuffix(upper, " "+clause)
+// formatTableValue formats a result value for display, rounding fractional
+// numbers to at most four decimal places
+func formatTableValue(v interface{}) string {
+	f, ok := v.(float64)
+	if !ok || f == math.Trunc(f) || math.IsInf(f, 0) || math.IsNaN(f) {
+		return formatValue(v)
+	}
+	s := strconv.FormatFloat(f, 'f', 4, 64)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
 }
@@ -0,0 +1,102 @@
+package nrql
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+func TestNRQLColumns(t *testing.T) {
+	result := map[string]interface{}{
+		"zeta":      1,
+		"name":      "x",
+		"alpha":     2,
+		"timestamp": 3,
+	}
+
+	assert.Equal(t, []string{"timestamp", "name", "alpha", "zeta"}, nrqlColumns(result))
+}
+
+func TestFormatTableValue(t *testing.T) {
+	assert.Equal(t, "1500", formatTableValue(float64(1500)))
+	assert.Equal(t, "0.3333", formatTableValue(1.0/3))
+	assert.Equal(t, "2.5", formatTableValue(2.5))
+	assert.Equal(t, "1700000000000", formatTableValue(float64(1700000000000)))
+	assert.Equal(t, "web", formatTableValue("web"))
+	assert.Equal(t, "", formatTableValue(nil))
+}
+
+func TestRenderNRQLTable(t *testing.T) {
+	var out, errOut bytes.Buffer
+	v := view.New(&out, &errOut)
+	v.NoColor = true
+
+	err := renderNRQLTable(v, []map[string]interface{}{
+		{"count": float64(1500), "facet": "api"},
+		{"count": float64(850), "facet": "web"},
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), "COUNT")
+	assert.Contains(t, out.String(), "FACET")
+	assert.Contains(t, out.String(), "1500")
+	assert.Empty(t, errOut.String())
+}
+
+func TestRenderNRQLTable_Wide(t *testing.T) {
+	var out, errOut bytes.Buffer
+	v := view.New(&out, &errOut)
+	v.NoColor = true
+
+	result := map[string]interface{}{}
+	for _, k := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"} {
+		result[k] = strings.Repeat(k, 30)
+	}
+
+	err := renderNRQLTable(v, []map[string]interface{}{result})
+
+	require.NoError(t, err)
+	assert.NotContains(t, out.String(), strings.Repeat("a", 30))
+	assert.Contains(t, errOut.String(), "-o json")
+}
+
+func TestRenderNRQLTable_Empty(t *testing.T) {
+	var out bytes.Buffer
+	v := view.New(&out, &bytes.Buffer{})
+
+	require.NoError(t, renderNRQLTable(v, nil))
+	assert.Contains(t, out.String(), "No results")
+}
+
+func TestChartValues(t *testing.T) {
+	results := []map[string]interface{}{
+		{"beginTimeSeconds": float64(100), "endTimeSeconds": float64(160), "facet": "web", "count": float64(3)},
+		{"beginTimeSeconds": float64(160), "endTimeSeconds": float64(220), "facet": "web", "count": nil},
+		{"beginTimeSeconds": float64(220), "endTimeSeconds": float64(280), "facet": "web", "count": float64(9)},
+	}
+
+	column, values := chartValues(results)
+
+	assert.Equal(t, "count", column)
+	require.Len(t, values, 3)
+	assert.Equal(t, float64(3), values[0])
+	assert.True(t, math.IsNaN(values[1]))
+	assert.Equal(t, float64(9), values[2])
+}
+
+func TestChartValues_NoNumericColumn(t *testing.T) {
+	results := []map[string]interface{}{
+		{"beginTimeSeconds": float64(100), "endTimeSeconds": float64(160), "facet": "web"},
+	}
+
+	column, values := chartValues(results)
+
+	assert.Empty(t, column)
+	assert.Nil(t, values)
+}
@@ -0,0 +1,125 @@
+package nrql
+
+import (
+	"math"
+	"regexp"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+// chartWidth is the maximum number of bars in a --chart sparkline
+const chartWidth = 60
+
+// timeseriesClause matches a TIMESERIES clause anywhere in a query
+var timeseriesClause = regexp.MustCompile(`(?i)\bTIMESERIES\b`)
+
+// timeseriesOptions holds options for the nrql timeseries command
+type timeseriesOptions struct {
+	*root.Options
+	since     string
+	until     string
+	sinceNRQL bool
+	chart     bool
+}
+
+func newTimeseriesCmd(opts *root.Options) *cobra.Command {
+	tsOpts := &timeseriesOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "timeseries <nrql>",
+		Short: "Run a TIMESERIES NRQL query",
+		Long: `Run an NRQL query with TIMESERIES, which returns one row per time bucket.
+
+TIMESERIES is appended to the query if it is not already there. With --chart,
+a sparkline of the first numeric column is drawn below the table, scaled
+between its smallest and largest value.`,
+		Example: `  nrq nrql timeseries "SELECT count(*) FROM Transaction SINCE 1 hour ago"
+  nrq nrql timeseries "SELECT average(duration) FROM Transaction TIMESERIES 5 minutes" --chart
+  nrq nrql timeseries "SELECT count(*) FROM Transaction" --since "1 day ago" -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTimeseries(tsOpts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&tsOpts.since, "since", "", "Time range start (e.g., '7 days ago', '2025-01-01')")
+	cmd.Flags().StringVar(&tsOpts.until, "until", "", "Time range end (e.g., 'now', '2025-01-15')")
+	cmd.Flags().BoolVar(&tsOpts.sinceNRQL, "since-nrql", false, "Write --since/--until as NRQL time expressions instead of Unix timestamps")
+	cmd.Flags().BoolVar(&tsOpts.chart, "chart", false, "Draw a sparkline of the values below the table")
+
+	return cmd
+}
+
+func runTimeseries(opts *timeseriesOptions, nrql string) error {
+	if !timeseriesClause.MatchString(nrql) {
+		nrql += " TIMESERIES"
+	}
+
+	finalQuery, err := api.AppendNRQLTimeRange(nrql, opts.since, opts.until, opts.sinceNRQL)
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	result, err := client.QueryNRQL(finalQuery)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+	if v.Format != view.FormatTable {
+		return v.JSON(result)
+	}
+
+	if err := renderNRQLTable(v, result.Results); err != nil {
+		return err
+	}
+	if opts.chart && len(result.Results) > 0 {
+		column, values := chartValues(result.Results)
+		if column == "" {
+			v.Warning("No numeric column to chart")
+			return nil
+		}
+		v.Println()
+		v.Print("%s  %s\n", column, view.Sparkline(values, chartWidth))
+	}
+	return nil
+}
+
+// chartValues returns the first numeric column of timeseries results, in
+// nrqlColumns order but skipping the bucket times, and its value in each row.
+// Rows without a numeric value are NaN so they show as gaps.
+func chartValues(results []map[string]interface{}) (string, []float64) {
+	var column string
+	for _, col := range nrqlColumns(results[0]) {
+		switch col {
+		case "beginTimeSeconds", "endTimeSeconds", "timestamp":
+			continue
+		}
+		if _, ok := results[0][col].(float64); ok {
+			column = col
+			break
+		}
+	}
+	if column == "" {
+		return "", nil
+	}
+
+	values := make([]float64, len(results))
+	for i, r := range results {
+		f, ok := r[column].(float64)
+		if !ok {
+			f = math.NaN()
+		}
+		values[i] = f
+	}
+	return column, values
+}
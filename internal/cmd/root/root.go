@@ -1,37 +1,90 @@
 package root
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/open-cli-collective/newrelic-cli/api"
 	"github.com/open-cli-collective/newrelic-cli/internal/config"
+	"github.com/open-cli-collective/newrelic-cli/internal/validate"
 	"github.com/open-cli-collective/newrelic-cli/internal/version"
 	"github.com/open-cli-collective/newrelic-cli/internal/view"
 )
 
+// defaultTimeout is the HTTP timeout used when --timeout is not given
+const defaultTimeout = 30 * time.Second
+
+// noPagerAnnotation marks commands whose output must not be paged
+const noPagerAnnotation = "nrq/no-pager"
+
+// DisablePager marks cmd as never paging its output. Use it for commands
+// that prompt for input, which would compete with the pager for the
+// terminal. It returns cmd for use in constructors.
+func DisablePager(cmd *cobra.Command) *cobra.Command {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[noPagerAnnotation] = "true"
+	return cmd
+}
+
 // RegisterFunc is a function that registers a command
 type RegisterFunc func(rootCmd *cobra.Command, opts *Options)
 
 // Options contains global command options
 type Options struct {
-	Output  string
-	NoColor bool
-	Verbose bool
-	Stdin   io.Reader
-	Stdout  io.Writer
-	Stderr  io.Writer
+	Output    string
+	NoColor   bool
+	Verbose   bool
+	Pretty    bool
+	Profile   string
+	Timeout   time.Duration
+	RateLimit float64
+	Pager     bool
+	NoPager   bool
+	Stdin     io.Reader
+	Stdout    io.Writer
+	Stderr    io.Writer
+
+	// AccountIDOverride, APIKeyOverride and RegionOverride replace the
+	// stored account ID, API key and region for this invocation
+	AccountIDOverride string
+	APIKeyOverride    string
+	RegionOverride    string
+
+	// DryRun is set by --dry-run on commands that change data. They print
+	// what they would do with PrintDryRun instead of calling the API.
+	DryRun bool
+
+	// ColumnWidth is the minimum table column width from --column-width or
+	// NEWRELIC_CLI_COLUMN_WIDTH. When either is 0, TableWidth is set to the
+	// terminal width instead so that tables fit the terminal.
+	ColumnWidth int
+	TableWidth  int
+
+	// Context is the running command's context. It is cancelled on Ctrl+C,
+	// which aborts in-flight API requests made by clients from APIClient.
+	Context context.Context
 }
 
 // DefaultOptions returns options with defaults
 func DefaultOptions() *Options {
 	return &Options{
-		Output: "table",
-		Stdin:  os.Stdin,
-		Stdout: os.Stdout,
-		Stderr: os.Stderr,
+		Output:  "table",
+		Pretty:  true,
+		Timeout: defaultTimeout,
+		Stdin:   os.Stdin,
+		Stdout:  os.Stdout,
+		Stderr:  os.Stderr,
 	}
 }
 
@@ -40,28 +93,146 @@ func (o *Options) View() *view.View {
 	v := view.New(o.Stdout, o.Stderr)
 	v.Format = view.Format(o.Output)
 	v.NoColor = o.NoColor
+	v.CompactJSON = !o.Pretty
+	v.ColumnWidth = o.ColumnWidth
+	v.TableWidth = o.TableWidth
 	return v
 }
 
+// Spinner starts a progress spinner on stderr showing msg. It stays hidden
+// when stderr is not a terminal or --verbose is logging requests there.
+// Callers must Stop it before printing results.
+func (o *Options) Spinner(msg string) *view.Spinner {
+	s := view.NewSpinner(o.Stderr)
+	s.SetMessage(msg)
+	if !o.Verbose {
+		s.Start()
+	}
+	return s
+}
+
+// ProgressBar returns a progress bar for total steps drawn on stderr. Like
+// Spinner, it stays hidden with --verbose so it doesn't mix with the logs.
+func (o *Options) ProgressBar(total int) *view.ProgressBar {
+	w := o.Stderr
+	if o.Verbose {
+		w = io.Discard
+	}
+	return view.NewProgressBar(w, total)
+}
+
+// dryRunBanner ends the output of every --dry-run command
+const dryRunBanner = "[DRY RUN] No changes were made"
+
+// AddDryRunFlag adds --dry-run to a command that changes data
+func AddDryRunFlag(cmd *cobra.Command, opts *Options) {
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show what would be done without making any changes")
+}
+
+// AddForceFlag adds -f/--force to a command that asks for confirmation,
+// along with a hidden --no-confirm alias for the same setting
+func AddForceFlag(cmd *cobra.Command, force *bool, usage string) {
+	cmd.Flags().BoolVarP(force, "force", "f", false, usage)
+	cmd.Flags().BoolVar(force, "no-confirm", false, usage)
+	_ = cmd.Flags().MarkHidden("no-confirm")
+}
+
+// PrintDryRun describes a change skipped because of --dry-run. action
+// completes the sentence "Would ...", and input, if not nil, is the request
+// input that would have been sent, printed as JSON.
+func (o *Options) PrintDryRun(action string, input interface{}) error {
+	v := o.View()
+
+	if v.Format == view.FormatJSON {
+		out := map[string]interface{}{
+			"dryRun": true,
+			"action": action,
+		}
+		if input != nil {
+			out["input"] = input
+		}
+		if err := v.JSON(out); err != nil {
+			return err
+		}
+	} else {
+		v.Println("Would " + action)
+		if input != nil {
+			data, err := json.MarshalIndent(input, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to encode input: %w", err)
+			}
+			v.Println(string(data))
+		}
+	}
+
+	v.Warning(dryRunBanner)
+	return nil
+}
+
+// PrintChanges writes a diff of a resource before and after an update to
+// stderr, keeping it apart from the command's regular output
+func (o *Options) PrintChanges(before, after interface{}) error {
+	diff, err := view.Diff(before, after)
+	if err != nil {
+		return err
+	}
+
+	v := o.View()
+	if diff == "" {
+		fmt.Fprintln(v.ErrOut, "No changes")
+		return nil
+	}
+	v.PrintDiff(diff)
+	return nil
+}
+
 // APIClient creates a New Relic API client with options applied
 func (o *Options) APIClient() (*api.Client, error) {
-	apiKey, err := config.GetAPIKey()
-	if err != nil {
-		return nil, err
+	apiKey := o.APIKeyOverride
+	if apiKey == "" {
+		var err error
+		apiKey, err = config.GetAPIKey(o.Profile)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	accountID, _ := config.GetAccountID() // Optional
-	region := config.GetRegion()
+	accountID := o.AccountIDOverride
+	if accountID == "" {
+		accountID, _ = config.GetAccountID(o.Profile) // Optional
+	}
+	region := o.RegionOverride
+	if region == "" {
+		region = config.GetRegion(o.Profile)
+	}
 
 	return api.NewWithConfig(api.ClientConfig{
-		APIKey:    apiKey,
-		AccountID: accountID,
-		Region:    region,
-		Verbose:   o.Verbose,
-		Stderr:    o.Stderr,
+		APIKey:     apiKey,
+		AccountID:  accountID,
+		Region:     region,
+		Timeout:    o.Timeout,
+		MaxRetries: api.DefaultMaxRetries,
+		Verbose:    o.Verbose,
+		Stderr:     o.Stderr,
+		RateLimit:  o.RateLimit,
+		Context:    o.Context,
+
+		MaxIdleConns:    api.DefaultMaxIdleConns,
+		IdleConnTimeout: api.DefaultIdleConnTimeout,
 	}), nil
 }
 
+// APIClientOrNil is APIClient for callers that have nothing better to do
+// with an error than give up quietly, such as shell completion. It returns
+// nil when no client can be created, e.g. because no API key is set.
+func (o *Options) APIClientOrNil() *api.Client {
+	client, err := o.APIClient()
+	if err != nil {
+		return nil
+	}
+	return client
+}
+
 var rootCmd = &cobra.Command{
 	Use:   "nrq",
 	Short: "A CLI tool for interacting with New Relic",
@@ -79,24 +250,152 @@ Set your account ID with:
 Or set environment variables:
   NEWRELIC_API_KEY
   NEWRELIC_ACCOUNT_ID
-  NEWRELIC_REGION (US or EU)`,
+  NEWRELIC_REGION (US or EU)
+  NEWRELIC_PROFILE (credential profile)`,
 	Version: version.Info(),
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		globalOpts.Context = cmd.Context()
+		applyColorEnv(cmd, globalOpts)
+		if err := applyColumnWidth(cmd, globalOpts); err != nil {
+			return err
+		}
+
 		// Validate output format
 		output, _ := cmd.Flags().GetString("output")
-		return view.ValidateFormat(output)
+		if err := view.ValidateFormat(output); err != nil {
+			return err
+		}
+
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		if timeout <= 0 {
+			return fmt.Errorf("invalid timeout %s: must be positive", timeout)
+		}
+
+		if globalOpts.AccountIDOverride != "" {
+			if err := validate.AccountID(globalOpts.AccountIDOverride); err != nil {
+				return err
+			}
+		}
+
+		if globalOpts.RegionOverride != "" {
+			if err := validate.Region(globalOpts.RegionOverride); err != nil {
+				return err
+			}
+			globalOpts.RegionOverride = strings.ToUpper(globalOpts.RegionOverride)
+		}
+
+		if globalOpts.APIKeyOverride != "" {
+			warning, err := validate.APIKey(globalOpts.APIKeyOverride)
+			if err != nil {
+				return err
+			}
+			if warning != "" {
+				globalOpts.View().Warning("Warning: " + warning)
+			}
+		}
+
+		rateLimit, _ := cmd.Flags().GetFloat64("rate-limit")
+		if rateLimit < 0 {
+			return fmt.Errorf("invalid rate limit %g: must not be negative", rateLimit)
+		}
+
+		if usePager(cmd, globalOpts) {
+			pager = view.PagerWriter(globalOpts.Stdout)
+			globalOpts.Stdout = pager
+		}
+		return nil
 	},
 }
 
 var globalOpts = DefaultOptions()
 
+// applyColorEnv honors the NO_COLOR and CLICOLOR=0 conventions by turning
+// off color. NO_COLOR also switches output to JSON, since it usually means
+// a CI environment, unless --output was given explicitly.
+func applyColorEnv(cmd *cobra.Command, opts *Options) {
+	noColor := os.Getenv("NO_COLOR") != ""
+	if !noColor && os.Getenv("CLICOLOR") != "0" {
+		return
+	}
+
+	opts.NoColor = true
+	if noColor && !cmd.Flags().Changed("output") {
+		opts.Output = string(view.FormatJSON)
+	}
+}
+
+// columnWidthEnv sets the default for --column-width
+const columnWidthEnv = "NEWRELIC_CLI_COLUMN_WIDTH"
+
+// applyColumnWidth sets the table column width from --column-width, or
+// from NEWRELIC_CLI_COLUMN_WIDTH when the flag is not given. A width of 0
+// fits tables to the terminal; with neither set, tables keep their
+// natural width.
+func applyColumnWidth(cmd *cobra.Command, opts *Options) error {
+	source := "--column-width"
+	if !cmd.Flags().Changed("column-width") {
+		env := os.Getenv(columnWidthEnv)
+		if env == "" {
+			return nil
+		}
+		n, err := strconv.Atoi(env)
+		if err != nil {
+			return fmt.Errorf("invalid %s %q: must be a number", columnWidthEnv, env)
+		}
+		opts.ColumnWidth = n
+		source = columnWidthEnv
+	}
+
+	if opts.ColumnWidth < 0 {
+		return fmt.Errorf("invalid %s %d: must not be negative", source, opts.ColumnWidth)
+	}
+	if opts.ColumnWidth == 0 {
+		opts.TableWidth = view.TerminalWidth()
+	}
+	return nil
+}
+
+// pager is the active pager, if output is being paged
+var pager io.WriteCloser
+
+// usePager reports whether output should go through a pager: when --pager
+// is given, or by default when stdout is a terminal, unless the command
+// disabled paging.
+func usePager(cmd *cobra.Command, opts *Options) bool {
+	if opts.NoPager || cmd.Annotations[noPagerAnnotation] != "" {
+		return false
+	}
+	return opts.Pager || view.IsTerminal(opts.Stdout)
+}
+
 func init() {
 	rootCmd.PersistentFlags().StringVarP(&globalOpts.Output, "output", "o", "table",
-		"Output format: table, json, or plain")
+		"Output format: table, json, plain, csv, or markdown")
 	rootCmd.PersistentFlags().BoolVar(&globalOpts.NoColor, "no-color", false,
 		"Disable colored output")
 	rootCmd.PersistentFlags().BoolVarP(&globalOpts.Verbose, "verbose", "v", false,
 		"Enable verbose output (shows API requests)")
+	rootCmd.PersistentFlags().BoolVar(&globalOpts.Pretty, "pretty", true,
+		"Indent JSON output (use --pretty=false for compact JSON)")
+	rootCmd.PersistentFlags().StringVar(&globalOpts.Profile, "profile", "",
+		"Credential profile to use instead of the active profile")
+	rootCmd.PersistentFlags().StringVar(&globalOpts.AccountIDOverride, "account-id", "",
+		"Account ID to use instead of the stored one, for this command only")
+	rootCmd.PersistentFlags().StringVar(&globalOpts.APIKeyOverride, "api-key", "",
+		"API key to use instead of the stored one, for this command only")
+	rootCmd.PersistentFlags().StringVar(&globalOpts.RegionOverride, "region", "",
+		"Region (US or EU) to use instead of the stored one, for this command only")
+	rootCmd.PersistentFlags().DurationVar(&globalOpts.Timeout, "timeout", defaultTimeout,
+		"HTTP timeout for API requests (e.g., 120s, 2m)")
+	rootCmd.PersistentFlags().Float64Var(&globalOpts.RateLimit, "rate-limit", 0,
+		"Maximum API requests per second (0 = no limit)")
+	rootCmd.PersistentFlags().IntVar(&globalOpts.ColumnWidth, "column-width", 0,
+		"Minimum table column width; 0 fits tables to the terminal (env: "+columnWidthEnv+")")
+	rootCmd.PersistentFlags().BoolVar(&globalOpts.Pager, "pager", false,
+		"Page output through $PAGER or less (default when stdout is a terminal)")
+	rootCmd.PersistentFlags().BoolVar(&globalOpts.NoPager, "no-pager", false,
+		"Do not page output")
+	rootCmd.MarkFlagsMutuallyExclusive("pager", "no-pager")
 
 	// Keep backward compatibility with --json flag
 	rootCmd.PersistentFlags().Bool("json", false, "Output in JSON format (deprecated: use -o json)")
@@ -105,7 +404,20 @@ func init() {
 
 // Execute runs the root command
 func Execute() error {
-	return rootCmd.Execute()
+	// Ctrl+C cancels the command's context. Once it has, stop catching the
+	// signal so that a second Ctrl+C kills a command that doesn't notice.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	err := rootCmd.ExecuteContext(ctx)
+	if pager != nil {
+		_ = pager.Close()
+	}
+	return err
 }
 
 // RootCmd returns the root command (for registering subcommands)
@@ -0,0 +1,209 @@
+package root
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newColorEnvCmd returns a command with an --output flag bound to opts
+func newColorEnvCmd(opts *Options, args ...string) *cobra.Command {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", "table", "")
+	_ = cmd.ParseFlags(args)
+	return cmd
+}
+
+func TestApplyColorEnv_NoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	t.Setenv("CLICOLOR", "")
+
+	opts := DefaultOptions()
+	applyColorEnv(newColorEnvCmd(opts), opts)
+
+	assert.True(t, opts.NoColor)
+	assert.Equal(t, "json", opts.Output)
+}
+
+func TestApplyColorEnv_NoColorExplicitOutput(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	t.Setenv("CLICOLOR", "")
+
+	opts := DefaultOptions()
+	cmd := newColorEnvCmd(opts, "-o", "table")
+	require.True(t, cmd.Flags().Changed("output"))
+	applyColorEnv(cmd, opts)
+
+	assert.True(t, opts.NoColor)
+	assert.Equal(t, "table", opts.Output)
+}
+
+func TestApplyColorEnv_CLIColorZero(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("CLICOLOR", "0")
+
+	opts := DefaultOptions()
+	applyColorEnv(newColorEnvCmd(opts), opts)
+
+	assert.True(t, opts.NoColor)
+	assert.Equal(t, "table", opts.Output)
+}
+
+func TestApplyColorEnv_Unset(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("CLICOLOR", "")
+
+	opts := DefaultOptions()
+	applyColorEnv(newColorEnvCmd(opts), opts)
+
+	assert.False(t, opts.NoColor)
+	assert.Equal(t, "table", opts.Output)
+}
+
+// newColumnWidthCmd returns a command with the --column-width flag parsed
+// from args
+func newColumnWidthCmd(opts *Options, args ...string) *cobra.Command {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().IntVar(&opts.ColumnWidth, "column-width", 0, "")
+	_ = cmd.ParseFlags(args)
+	return cmd
+}
+
+func TestApplyColumnWidth_Flag(t *testing.T) {
+	t.Setenv(columnWidthEnv, "30")
+
+	opts := DefaultOptions()
+	require.NoError(t, applyColumnWidth(newColumnWidthCmd(opts, "--column-width", "20"), opts))
+
+	assert.Equal(t, 20, opts.ColumnWidth)
+	assert.Zero(t, opts.TableWidth)
+	assert.Equal(t, 20, opts.View().ColumnWidth)
+}
+
+func TestApplyColumnWidth_Env(t *testing.T) {
+	t.Setenv(columnWidthEnv, "30")
+
+	opts := DefaultOptions()
+	require.NoError(t, applyColumnWidth(newColumnWidthCmd(opts), opts))
+
+	assert.Equal(t, 30, opts.ColumnWidth)
+}
+
+func TestApplyColumnWidth_AutoUsesTerminalWidth(t *testing.T) {
+	t.Setenv(columnWidthEnv, "")
+	t.Setenv("COLUMNS", "100")
+
+	opts := DefaultOptions()
+	require.NoError(t, applyColumnWidth(newColumnWidthCmd(opts, "--column-width", "0"), opts))
+
+	assert.Zero(t, opts.ColumnWidth)
+	assert.Equal(t, 100, opts.TableWidth)
+	assert.Equal(t, 100, opts.View().TableWidth)
+}
+
+func TestApplyColumnWidth_Unset(t *testing.T) {
+	t.Setenv(columnWidthEnv, "")
+	t.Setenv("COLUMNS", "100")
+
+	opts := DefaultOptions()
+	require.NoError(t, applyColumnWidth(newColumnWidthCmd(opts), opts))
+
+	assert.Zero(t, opts.ColumnWidth)
+	assert.Zero(t, opts.TableWidth)
+}
+
+func TestApplyColumnWidth_Invalid(t *testing.T) {
+	t.Setenv(columnWidthEnv, "wide")
+	opts := DefaultOptions()
+	err := applyColumnWidth(newColumnWidthCmd(opts), opts)
+	assert.ErrorContains(t, err, "invalid NEWRELIC_CLI_COLUMN_WIDTH")
+
+	opts = DefaultOptions()
+	err = applyColumnWidth(newColumnWidthCmd(opts, "--column-width", "-5"), opts)
+	assert.ErrorContains(t, err, "must not be negative")
+}
+
+func TestAddForceFlag(t *testing.T) {
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{nil, false},
+		{[]string{"--force"}, true},
+		{[]string{"-f"}, true},
+		{[]string{"--no-confirm"}, true},
+	}
+
+	for _, tt := range tests {
+		var force bool
+		cmd := &cobra.Command{Use: "test"}
+		AddForceFlag(cmd, &force, "Skip confirmation prompt")
+
+		require.NoError(t, cmd.ParseFlags(tt.args))
+		assert.Equal(t, tt.want, force, "args %v", tt.args)
+		assert.True(t, cmd.Flags().Lookup("no-confirm").Hidden)
+	}
+}
+
+func TestAPIClient_RegionOverride(t *testing.T) {
+	t.Setenv("NEWRELIC_REGION", "US")
+
+	opts := DefaultOptions()
+	opts.APIKeyOverride = "NRAK-TESTKEY1234567890123456789"
+	opts.RegionOverride = "EU"
+
+	client, err := opts.APIClient()
+
+	require.NoError(t, err)
+	assert.Equal(t, "EU", client.Region)
+	assert.Contains(t, client.NerdGraphURL, ".eu.")
+}
+
+func TestAPIClient_Context(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	opts := DefaultOptions()
+	opts.APIKeyOverride = "NRAK-TESTKEY1234567890123456789"
+	opts.Context = ctx
+
+	client, err := opts.APIClient()
+
+	require.NoError(t, err)
+	assert.Equal(t, ctx, client.Context)
+}
+
+func TestAPIClientOrNil(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	opts := DefaultOptions()
+	opts.Profile = "missing"
+	assert.Nil(t, opts.APIClientOrNil())
+
+	opts.Profile = ""
+	opts.APIKeyOverride = "NRAK-TESTKEY1234567890123456789"
+	assert.NotNil(t, opts.APIClientOrNil())
+}
+
+func TestProgressBar(t *testing.T) {
+	var stderr bytes.Buffer
+	opts := DefaultOptions()
+	opts.Stderr = &stderr
+
+	bar := opts.ProgressBar(2)
+	bar.Increment()
+	bar.Done()
+	assert.Equal(t, "1/2 completed\n", stderr.String())
+
+	// --verbose hides the bar, as it does the spinner
+	stderr.Reset()
+	opts.Verbose = true
+	bar = opts.ProgressBar(2)
+	bar.Increment()
+	bar.Done()
+	assert.Empty(t, stderr.String())
+}
@@ -0,0 +1,310 @@
+package slo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+// Register adds the slo commands to the root command
+func Register(rootCmd *cobra.Command, opts *root.Options) {
+	sloCmd := &cobra.Command{
+		Use:     "slo",
+		Aliases: []string{"slos", "service-levels"},
+		Short:   "View service levels (SLIs and SLOs)",
+		Long: `View service level indicators (SLIs) and their objectives (SLOs).
+
+An SLI measures the share of valid events that were good, such as requests
+answered in under 500ms. Each SLO sets a target for it over a rolling
+window, such as 99.5% over 7 days.`,
+	}
+
+	sloCmd.AddCommand(newListCmd(opts))
+	sloCmd.AddCommand(newGetCmd(opts))
+	sloCmd.AddCommand(newStatusCmd(opts))
+
+	rootCmd.AddCommand(sloCmd)
+}
+
+type listOptions struct {
+	*root.Options
+	entityGUID string
+	account    bool
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	listOpts := &listOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List service levels",
+		Long: `List service level indicators and their objectives.
+
+By default every SLI in the account is listed. Use --entity-guid to list
+only the SLIs of one entity.`,
+		Example: `  nrq slo list
+  nrq slo list --entity-guid "MTIzNDU2N3xBUE18QVBQTElDQVRJT058OTk"
+  nrq slo list -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(listOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&listOpts.entityGUID, "entity-guid", "", "Only list SLIs of this entity")
+	cmd.Flags().BoolVar(&listOpts.account, "account", false, "List every SLI in the account (default)")
+	cmd.MarkFlagsMutuallyExclusive("entity-guid", "account")
+
+	return cmd
+}
+
+func runList(opts *listOptions) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	slis, err := client.ListServiceLevels(api.EntityGUID(opts.entityGUID))
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	if len(slis) == 0 {
+		v.Println("No service levels found")
+		return nil
+	}
+
+	headers := []string{"GUID", "NAME", "OBJECTIVES"}
+	rows := make([][]string, len(slis))
+	for i, sli := range slis {
+		objectives := make([]string, len(sli.Objectives))
+		for j, o := range sli.Objectives {
+			objectives[j] = formatObjective(o)
+		}
+		rows[i] = []string{
+			sli.GUID.String(),
+			view.Truncate(sli.Name, 40),
+			strings.Join(objectives, ", "),
+		}
+	}
+
+	return v.Render(headers, rows, slis)
+}
+
+func newGetCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <sli-guid>",
+		Short: "Get details for a service level",
+		Long: `Get a service level indicator's event queries and objectives.
+
+The GUID comes from 'slo list'.`,
+		Example: `  nrq slo get "MTIzNDU2N3xFWFR8U0VSVklDRV9MRVZFTHwxMDE"
+  nrq slo get "MTIzNDU2N3xFWFR8U0VSVklDRV9MRVZFTHwxMDE" -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGet(opts, api.EntityGUID(args[0]))
+		},
+	}
+}
+
+func runGet(opts *root.Options, guid api.EntityGUID) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	sli, err := client.GetServiceLevel(guid)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		return v.JSON(sli)
+	case "plain":
+		return v.Plain([][]string{{sli.GUID.String(), sli.Name, sli.EntityGUID.String()}})
+	default:
+		v.Print("GUID:        %s\n", sli.GUID.String())
+		v.Print("Name:        %s\n", sli.Name)
+		if sli.Description != "" {
+			v.Print("Description: %s\n", sli.Description)
+		}
+		v.Print("Entity GUID: %s\n", sli.EntityGUID.String())
+		if sli.ValidEvents != nil {
+			v.Print("Valid:       %s\n", formatEvents(sli.ValidEvents))
+		}
+		if sli.GoodEvents != nil {
+			v.Print("Good:        %s\n", formatEvents(sli.GoodEvents))
+		}
+		if sli.BadEvents != nil {
+			v.Print("Bad:         %s\n", formatEvents(sli.BadEvents))
+		}
+		v.Print("Objectives:  %d\n", len(sli.Objectives))
+		for _, o := range sli.Objectives {
+			if o.Name != "" {
+				v.Print("  - %s: %s\n", o.Name, formatObjective(o))
+			} else {
+				v.Print("  - %s\n", formatObjective(o))
+			}
+		}
+		return nil
+	}
+}
+
+type statusOptions struct {
+	*root.Options
+	since      string
+	entityGUID string
+}
+
+// objectiveStatus is how one SLO is doing over the --since window
+type objectiveStatus struct {
+	GUID       api.EntityGUID `json:"guid"`
+	Name       string         `json:"name"`
+	Target     float64        `json:"target"`
+	WindowDays int            `json:"windowDays"`
+	Attainment *float64       `json:"attainment"`
+	BurnRate   *float64       `json:"burnRate"`
+	Error      string         `json:"error,omitempty"`
+}
+
+func newStatusCmd(opts *root.Options) *cobra.Command {
+	statusOpts := &statusOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show attainment and error budget burn rate",
+		Long: `Show each SLO's attainment and error budget burn rate since --since.
+
+The burn rate is the share of bad events divided by the share the target
+allows. At 1.0x the error budget runs out exactly at the end of the
+window; above 1.0x it runs out early.`,
+		Example: `  nrq slo status
+  nrq slo status --since 1d
+  nrq slo status --entity-guid "MTIzNDU2N3xBUE18QVBQTElDQVRJT058OTk" -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatus(statusOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&statusOpts.since, "since", "7d", "Start of the window (e.g., 1d, 7d, \"2 weeks ago\")")
+	cmd.Flags().StringVar(&statusOpts.entityGUID, "entity-guid", "", "Only show SLIs of this entity")
+
+	return cmd
+}
+
+func runStatus(opts *statusOptions) error {
+	if _, err := api.ParseFlexibleTime(opts.since); err != nil {
+		return fmt.Errorf("invalid --since: %w", err)
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	slis, err := client.ListServiceLevels(api.EntityGUID(opts.entityGUID))
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	if len(slis) == 0 {
+		v.Println("No service levels found")
+		return nil
+	}
+
+	spinner := opts.Spinner(fmt.Sprintf("Computing attainment (0/%d)", len(slis)))
+	var statuses []objectiveStatus
+	for i, sli := range slis {
+		attainment, err := client.GetServiceLevelAttainment(sli, opts.since)
+		spinner.SetMessage(fmt.Sprintf("Computing attainment (%d/%d)", i+1, len(slis)))
+
+		for _, o := range sli.Objectives {
+			status := objectiveStatus{GUID: sli.GUID, Name: sli.Name, Target: o.Target, WindowDays: o.WindowDays}
+			if err != nil {
+				status.Error = err.Error()
+			} else {
+				a := attainment
+				status.Attainment = &a
+				if rate, ok := burnRate(attainment, o.Target); ok {
+					status.BurnRate = &rate
+				}
+			}
+			statuses = append(statuses, status)
+		}
+	}
+	spinner.Stop()
+
+	if len(statuses) == 0 {
+		v.Println("No service level objectives found")
+		return nil
+	}
+
+	headers := []string{"NAME", "TARGET", "WINDOW", "ATTAINMENT", "BURN RATE"}
+	rows := make([][]string, len(statuses))
+	for i, s := range statuses {
+		attainment, rate := "-", "-"
+		if s.Attainment != nil {
+			attainment = formatPercent(*s.Attainment)
+		}
+		if s.BurnRate != nil {
+			rate = fmt.Sprintf("%.2fx", *s.BurnRate)
+		}
+		rows[i] = []string{
+			view.Truncate(s.Name, 40),
+			formatPercent(s.Target),
+			formatWindow(s.WindowDays),
+			attainment,
+			rate,
+		}
+	}
+
+	return v.Render(headers, rows, statuses)
+}
+
+// burnRate returns how fast the error budget is being spent: the share of
+// bad events (100 - attainment) over the share the target allows. It is
+// undefined for a 100% target, which allows no bad events.
+func burnRate(attainment, target float64) (float64, bool) {
+	budget := 100 - target
+	if budget <= 0 {
+		return 0, false
+	}
+	return (100 - attainment) / budget, true
+}
+
+// formatObjective renders an objective as "99.5% / 7d"
+func formatObjective(o api.ServiceLevelObjective) string {
+	return formatPercent(o.Target) + " / " + formatWindow(o.WindowDays)
+}
+
+// formatWindow renders a rolling window in days, or "-" when unknown
+func formatWindow(days int) string {
+	if days <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%dd", days)
+}
+
+// formatPercent renders a percentage without trailing zeros, e.g. "99.5%"
+func formatPercent(p float64) string {
+	return fmt.Sprintf("%g%%", p)
+}
+
+// formatEvents renders an event selector as "FROM x WHERE y"
+func formatEvents(e *api.ServiceLevelEvents) string {
+	if e.Where == "" {
+		return "FROM " + e.From
+	}
+	return "FROM " + e.From + " WHERE " + e.Where
+}
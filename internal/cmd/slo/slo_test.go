@@ -0,0 +1,32 @@
+package slo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+)
+
+func TestBurnRate(t *testing.T) {
+	rate, ok := burnRate(99.0, 99.5)
+	assert.True(t, ok)
+	assert.InDelta(t, 2.0, rate, 0.0001)
+
+	rate, ok = burnRate(100, 99.9)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.0, rate, 0.0001)
+
+	_, ok = burnRate(99.0, 100)
+	assert.False(t, ok, "a 100% target has no error budget")
+}
+
+func TestFormatObjective(t *testing.T) {
+	assert.Equal(t, "99.5% / 7d", formatObjective(api.ServiceLevelObjective{Target: 99.5, WindowDays: 7}))
+	assert.Equal(t, "99% / -", formatObjective(api.ServiceLevelObjective{Target: 99}))
+}
+
+func TestFormatEvents(t *testing.T) {
+	assert.Equal(t, "FROM Transaction", formatEvents(&api.ServiceLevelEvents{From: "Transaction"}))
+	assert.Equal(t, "FROM Transaction WHERE duration < 0.5", formatEvents(&api.ServiceLevelEvents{From: "Transaction", Where: "duration < 0.5"}))
+}
@@ -0,0 +1,104 @@
+package summary
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+type summaryOptions struct {
+	*root.Options
+	since time.Duration
+}
+
+// Register adds the summary command to the root command
+func Register(rootCmd *cobra.Command, opts *root.Options) {
+	summaryOpts := &summaryOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "summary",
+		Short: "Show a high-level overview of the account",
+		Long: `Show a high-level overview of the account: entity counts by type,
+alert policies, synthetic monitors, and recent deployments.
+
+The sections are fetched concurrently. If a section cannot be fetched, it is
+reported and the rest of the summary is still shown.`,
+		Example: `  nrq summary
+  nrq summary --since 24h
+  nrq summary -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSummary(summaryOpts)
+		},
+	}
+
+	cmd.Flags().DurationVar(&summaryOpts.since, "since", 7*24*time.Hour, "Window for counting recent deployments (e.g., 24h, 168h)")
+
+	rootCmd.AddCommand(cmd)
+}
+
+func runSummary(opts *summaryOptions) error {
+	if opts.since <= 0 {
+		return fmt.Errorf("--since must be a positive duration")
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	summary, err := client.GetAccountSummary(opts.since)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	for _, section := range sortedKeys(summary.Errors) {
+		v.Warning("Could not fetch %s: %s", section, summary.Errors[section])
+	}
+
+	return v.Render([]string{"METRIC", "VALUE"}, summaryRows(summary), summary)
+}
+
+// summaryRows flattens a summary into metric/value rows, with entity counts
+// sorted by type after the headline numbers. Failed sections show "-".
+func summaryRows(summary *api.AccountSummary) [][]string {
+	value := func(section string, n int) string {
+		if _, failed := summary.Errors[section]; failed {
+			return "-"
+		}
+		return fmt.Sprintf("%d", n)
+	}
+
+	var total int
+	for _, n := range summary.EntityCounts {
+		total += n
+	}
+
+	rows := [][]string{
+		{"Entities", value(api.SummaryEntities, total)},
+		{"Alert policies", value(api.SummaryAlertPolicies, summary.ActiveAlertPolicies)},
+		{"Synthetic monitors", value(api.SummarySyntheticMonitors, summary.SyntheticMonitors)},
+		{fmt.Sprintf("Deployments (last %s)", summary.DeploymentsSince), value(api.SummaryDeployments, summary.RecentDeployments)},
+	}
+
+	for _, entityType := range sortedKeys(summary.EntityCounts) {
+		rows = append(rows, []string{"  " + entityType, fmt.Sprintf("%d", summary.EntityCounts[entityType])})
+	}
+
+	return rows
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
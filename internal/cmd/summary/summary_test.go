@@ -0,0 +1,44 @@
+package summary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+)
+
+func TestSummaryRows(t *testing.T) {
+	summary := &api.AccountSummary{
+		EntityCounts:        map[string]int{"HOST": 30, "APPLICATION": 12},
+		ActiveAlertPolicies: 7,
+		SyntheticMonitors:   4,
+		RecentDeployments:   9,
+		DeploymentsSince:    "24h0m0s",
+	}
+
+	expected := [][]string{
+		{"Entities", "42"},
+		{"Alert policies", "7"},
+		{"Synthetic monitors", "4"},
+		{"Deployments (last 24h0m0s)", "9"},
+		{"  APPLICATION", "12"},
+		{"  HOST", "30"},
+	}
+
+	assert.Equal(t, expected, summaryRows(summary))
+}
+
+func TestSummaryRows_FailedSection(t *testing.T) {
+	summary := &api.AccountSummary{
+		EntityCounts:      map[string]int{},
+		SyntheticMonitors: 4,
+		DeploymentsSince:  "1h0m0s",
+		Errors:            map[string]string{api.SummaryAlertPolicies: "query failed"},
+	}
+
+	rows := summaryRows(summary)
+
+	assert.Equal(t, []string{"Alert policies", "-"}, rows[1])
+	assert.Equal(t, []string{"Synthetic monitors", "4"}, rows[2])
+}
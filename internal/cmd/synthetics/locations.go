@@ -0,0 +1,88 @@
+package synthetics
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+func newLocationsCmd(opts *root.Options) *cobra.Command {
+	locationsCmd := &cobra.Command{
+		Use:     "locations",
+		Aliases: []string{"location"},
+		Short:   "View locations synthetic monitors can run from",
+	}
+
+	locationsCmd.AddCommand(newLocationsListCmd(opts))
+
+	return locationsCmd
+}
+
+// locationsListOptions holds options for the locations list command
+type locationsListOptions struct {
+	*root.Options
+	private bool
+}
+
+func newLocationsListCmd(opts *root.Options) *cobra.Command {
+	listOpts := &locationsListOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List synthetic monitor locations",
+		Long: `List the public and private locations synthetic monitors can run from.
+
+The ID column is the value to pass to 'synthetics create --location' or use in
+the "locations" list of a monitor definition file.`,
+		Example: `  nrq synthetics locations list
+  nrq synthetics locations list --private
+  nrq synthetics locations list -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLocationsList(listOpts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&listOpts.private, "private", false, "Only show private minion locations")
+
+	return cmd
+}
+
+func runLocationsList(opts *locationsListOptions) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	locations, err := client.ListSyntheticLocations()
+	if err != nil {
+		return err
+	}
+
+	if opts.private {
+		private := make([]api.SyntheticLocation, 0, len(locations))
+		for _, l := range locations {
+			if l.Private {
+				private = append(private, l)
+			}
+		}
+		locations = private
+	}
+
+	v := opts.View()
+
+	if len(locations) == 0 {
+		v.Println("No synthetic locations found")
+		return nil
+	}
+
+	headers := []string{"ID", "NAME", "PRIVATE"}
+	rows := make([][]string, len(locations))
+	for i, l := range locations {
+		rows[i] = []string{l.Name, l.Label, fmt.Sprintf("%t", l.Private)}
+	}
+
+	return v.Render(headers, rows, locations)
+}
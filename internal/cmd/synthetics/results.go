@@ -0,0 +1,89 @@
+package synthetics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+func newResultsCmd(opts *root.Options) *cobra.Command {
+	resultsCmd := &cobra.Command{
+		Use:     "results",
+		Aliases: []string{"result"},
+		Short:   "View synthetic monitor check results",
+	}
+
+	resultsCmd.AddCommand(newResultsListCmd(opts))
+
+	return resultsCmd
+}
+
+// resultsListOptions holds options for the results list command
+type resultsListOptions struct {
+	*root.Options
+	limit int
+}
+
+func newResultsListCmd(opts *root.Options) *cobra.Command {
+	listOpts := &resultsListOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "list <monitor-id>",
+		Short: "List recent check results for a monitor",
+		Long: `List the most recent check results for a synthetic monitor.
+
+Displays when and where each check ran, its result, duration, and any error.`,
+		Example: `  nrq synthetics results list abc-123-def-456
+  nrq synthetics results list abc-123-def-456 --limit 50
+  nrq synthetics results list abc-123-def-456 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runResultsList(listOpts, args[0])
+		},
+	}
+
+	cmd.Flags().IntVarP(&listOpts.limit, "limit", "l", 20, "Maximum number of results to return")
+
+	return cmd
+}
+
+func runResultsList(opts *resultsListOptions, monitorID string) error {
+	if opts.limit <= 0 {
+		return fmt.Errorf("--limit must be greater than 0")
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	results, err := client.ListSyntheticResults(monitorID, opts.limit)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	if len(results) == 0 {
+		v.Println("No results found")
+		return nil
+	}
+
+	headers := []string{"TIMESTAMP", "LOCATION", "RESULT", "DURATION", "ERROR"}
+	rows := make([][]string, len(results))
+	for i, r := range results {
+		rows[i] = []string{
+			time.UnixMilli(r.Timestamp).Local().Format("2006-01-02 15:04:05"),
+			r.Location,
+			r.Result,
+			fmt.Sprintf("%.0fms", r.Duration),
+			view.Truncate(r.Error, 50),
+		}
+	}
+
+	return v.Render(headers, rows, results)
+}
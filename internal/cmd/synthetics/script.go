@@ -0,0 +1,187 @@
+package synthetics
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+func newMonitorScriptCmd(opts *root.Options) *cobra.Command {
+	scriptCmd := &cobra.Command{
+		Use:     "monitor-script",
+		Aliases: []string{"script"},
+		Short:   "Get or set the script of a scripted monitor",
+	}
+
+	scriptCmd.AddCommand(newScriptGetCmd(opts))
+	scriptCmd.AddCommand(newScriptSetCmd(opts))
+
+	return scriptCmd
+}
+
+// scriptGetOptions holds options for the monitor-script get command
+type scriptGetOptions struct {
+	*root.Options
+	file string
+}
+
+func newScriptGetCmd(opts *root.Options) *cobra.Command {
+	getOpts := &scriptGetOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "get <monitor-id>",
+		Short: "Print the script of a scripted monitor",
+		Long: `Print the decoded script of a scripted browser or API test monitor.
+
+The script is written as-is to stdout so it can be redirected to a file, or
+to the file given with --file.`,
+		Example: `  nrq synthetics monitor-script get abc-123-def-456
+  nrq synthetics monitor-script get abc-123-def-456 > monitor.js
+  nrq synthetics script get abc-123-def-456 --file monitor.js`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runScriptGet(getOpts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&getOpts.file, "file", "", "Path of the file to write (default: stdout)")
+
+	return cmd
+}
+
+func runScriptGet(opts *scriptGetOptions, monitorID string) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	script, err := client.GetSyntheticMonitorScript(monitorID)
+	if err != nil {
+		return err
+	}
+
+	if opts.file != "" {
+		if err := os.WriteFile(opts.file, []byte(script), 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", opts.file, err)
+		}
+		opts.View().Success("Wrote script for monitor %s to %s", monitorID, opts.file)
+		return nil
+	}
+
+	v := opts.View()
+
+	if v.Format == "json" {
+		return v.JSON(map[string]string{
+			"monitorId": monitorID,
+			"script":    script,
+		})
+	}
+
+	v.Print("%s", script)
+	if !strings.HasSuffix(script, "\n") {
+		v.Println()
+	}
+	return nil
+}
+
+// scriptSetOptions holds options for the monitor-script set command
+type scriptSetOptions struct {
+	*root.Options
+	file      string
+	locations []string
+}
+
+func newScriptSetCmd(opts *root.Options) *cobra.Command {
+	setOpts := &scriptSetOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:     "set <monitor-id>",
+		Aliases: []string{"update"},
+		Short:   "Upload the script of a scripted monitor",
+		Long: `Upload a new script for a scripted browser or API test monitor.
+
+The script is read from --file, or from stdin when the file is "-".
+Private locations that run the monitor need an HMAC, given with
+--location name=hmac (repeatable).`,
+		Example: `  # Upload a script from a file
+  nrq synthetics monitor-script set abc-123-def-456 --file monitor.js
+  nrq synthetics script update abc-123-def-456 --file monitor.js
+
+  # Upload from stdin
+  cat monitor.js | nrq synthetics monitor-script set abc-123-def-456 --file -
+
+  # Include a private location HMAC
+  nrq synthetics monitor-script set abc-123-def-456 -f monitor.js --location my-location=abc123`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runScriptSet(setOpts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&setOpts.file, "file", "f", "", "Path to the script file, or - for stdin (required)")
+	// --from-file was the original name of --file
+	cmd.Flags().StringVar(&setOpts.file, "from-file", "", "Path to the script file, or - for stdin")
+	_ = cmd.Flags().MarkHidden("from-file")
+	cmd.MarkFlagsMutuallyExclusive("file", "from-file")
+	cmd.Flags().StringArrayVar(&setOpts.locations, "location", nil, "Private location as name=hmac (repeatable)")
+
+	return cmd
+}
+
+func runScriptSet(opts *scriptSetOptions, monitorID string) error {
+	if opts.file == "" {
+		return fmt.Errorf(`required flag(s) "file" not set`)
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if opts.file == "-" {
+		data, err = io.ReadAll(opts.Stdin)
+	} else {
+		data, err = os.ReadFile(opts.file)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read script: %w", err)
+	}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return fmt.Errorf("script is empty")
+	}
+
+	locations, err := parseScriptLocations(opts.locations)
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.UpdateSyntheticMonitorScript(monitorID, string(data), locations); err != nil {
+		return fmt.Errorf("failed to update script: %w", err)
+	}
+
+	opts.View().Success("Script updated for monitor %s", monitorID)
+	return nil
+}
+
+// parseScriptLocations parses name=hmac pairs
+func parseScriptLocations(values []string) ([]api.ScriptLocation, error) {
+	var locations []api.ScriptLocation
+	for _, value := range values {
+		name, hmac, ok := strings.Cut(value, "=")
+		if !ok || name == "" || hmac == "" {
+			return nil, fmt.Errorf("invalid --location %q: expected name=hmac", value)
+		}
+		locations = append(locations, api.ScriptLocation{Name: name, HMAC: hmac})
+	}
+	return locations, nil
+}
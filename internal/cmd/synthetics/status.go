@@ -0,0 +1,118 @@
+package synthetics
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/confirm"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+// pauseOptions holds options for the pause command
+type pauseOptions struct {
+	*root.Options
+	force bool
+}
+
+func newPauseCmd(opts *root.Options) *cobra.Command {
+	pauseOpts := &pauseOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "pause <monitor-id>",
+		Short: "Pause a synthetic monitor",
+		Long: `Pause a synthetic monitor by setting its status to DISABLED.
+
+The monitor stops running checks until it is resumed, but its configuration
+and history are kept. Requires confirmation unless --force is specified.`,
+		Example: `  nrq synthetics pause abc-123-def-456
+  nrq synthetics pause abc-123-def-456 --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPause(pauseOpts, args[0])
+		},
+	}
+
+	root.AddForceFlag(cmd, &pauseOpts.force, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runPause(opts *pauseOptions, monitorID string) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	monitor, err := client.GetSyntheticMonitor(monitorID)
+	if err != nil {
+		return fmt.Errorf("failed to get monitor: %w", err)
+	}
+
+	if monitor.Status == api.SyntheticStatusDisabled {
+		v.Warning("Synthetic monitor \"%s\" is already paused", monitor.Name)
+		return nil
+	}
+
+	if !opts.force {
+		p := &confirm.Prompter{
+			In:  opts.Stdin,
+			Out: opts.Stderr,
+		}
+		msg := fmt.Sprintf("Pause synthetic monitor \"%s\" (ID: %s)?", monitor.Name, view.Truncate(monitorID, 20))
+		if !p.Confirm(msg) {
+			v.Warning("Operation canceled")
+			return nil
+		}
+	}
+
+	if err := client.SetSyntheticMonitorStatus(monitorID, api.SyntheticStatusDisabled); err != nil {
+		return fmt.Errorf("failed to pause monitor: %w", err)
+	}
+
+	v.Success("Synthetic monitor \"%s\" paused", monitor.Name)
+	return nil
+}
+
+func newResumeCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:     "resume <monitor-id>",
+		Short:   "Resume a paused synthetic monitor",
+		Long:    `Resume a synthetic monitor by setting its status to ENABLED.`,
+		Example: `  nrq synthetics resume abc-123-def-456`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runResume(opts, args[0])
+		},
+	}
+}
+
+func runResume(opts *root.Options, monitorID string) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	monitor, err := client.GetSyntheticMonitor(monitorID)
+	if err != nil {
+		return fmt.Errorf("failed to get monitor: %w", err)
+	}
+
+	if monitor.Status == api.SyntheticStatusEnabled {
+		v.Warning("Synthetic monitor \"%s\" is already running", monitor.Name)
+		return nil
+	}
+
+	if err := client.SetSyntheticMonitorStatus(monitorID, api.SyntheticStatusEnabled); err != nil {
+		return fmt.Errorf("failed to resume monitor: %w", err)
+	}
+
+	v.Success("Synthetic monitor \"%s\" resumed", monitor.Name)
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -26,6 +27,11 @@ func Register(rootCmd *cobra.Command, opts *root.Options) {
 	syntheticsCmd.AddCommand(newCreateCmd(opts))
 	syntheticsCmd.AddCommand(newUpdateCmd(opts))
 	syntheticsCmd.AddCommand(newDeleteCmd(opts))
+	syntheticsCmd.AddCommand(newPauseCmd(opts))
+	syntheticsCmd.AddCommand(newResumeCmd(opts))
+	syntheticsCmd.AddCommand(newMonitorScriptCmd(opts))
+	syntheticsCmd.AddCommand(newResultsCmd(opts))
+	syntheticsCmd.AddCommand(newLocationsCmd(opts))
 
 	rootCmd.AddCommand(syntheticsCmd)
 }
@@ -152,16 +158,25 @@ func runGet(opts *root.Options, monitorID string) error {
 // createOptions holds options for the create command
 type createOptions struct {
 	*root.Options
-	fromFile string
+	fromFile    string
+	name        string
+	monitorType string
+	frequency   int
+	uri         string
+	status      string
+	locations   []string
 }
 
+// createInlineFlags define a monitor without a file
+var createInlineFlags = []string{"name", "type", "frequency", "uri", "status", "location"}
+
 func newCreateCmd(opts *root.Options) *cobra.Command {
 	createOpts := &createOptions{Options: opts}
 
 	cmd := &cobra.Command{
 		Use:   "create",
-		Short: "Create a new synthetic monitor from a JSON file",
-		Long: `Create a new synthetic monitor from a JSON file.
+		Short: "Create a new synthetic monitor",
+		Long: `Create a new synthetic monitor from a JSON file or from flags.
 
 The JSON file should contain the monitor definition with the following structure:
 {
@@ -173,6 +188,9 @@ The JSON file should contain the monitor definition with the following structure
   "locations": ["AWS_US_EAST_1", "AWS_US_WEST_1"]
 }
 
+For simple monitors, pass --name, --type, --frequency, --uri, and one or more
+--location flags instead of a file. --from-file cannot be combined with them.
+
 Monitor types:
   SIMPLE:          Simple browser ping
   BROWSER:         Scripted browser
@@ -186,31 +204,63 @@ Common locations: AWS_US_EAST_1, AWS_US_EAST_2, AWS_US_WEST_1, AWS_US_WEST_2,
 		Example: `  # Create a monitor from a JSON file
   nrq synthetics create --from-file monitor.json
 
+  # Create a simple ping monitor from flags
+  nrq synthetics create --name "Ping example.com" --type SIMPLE --frequency 5 \
+    --uri https://example.com --location AWS_US_EAST_1 --location AWS_EU_WEST_1
+
   # Create and output result as JSON
   nrq synthetics create --from-file monitor.json -o json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runCreate(createOpts)
+			return runCreate(createOpts, cmd)
 		},
 	}
 
-	cmd.Flags().StringVarP(&createOpts.fromFile, "from-file", "f", "", "Path to JSON file containing monitor definition (required)")
-	_ = cmd.MarkFlagRequired("from-file")
+	cmd.Flags().StringVarP(&createOpts.fromFile, "from-file", "f", "", "Path to JSON file containing monitor definition")
+	cmd.Flags().StringVar(&createOpts.name, "name", "", "Monitor name (creates the monitor from flags)")
+	cmd.Flags().StringVar(&createOpts.monitorType, "type", "", "Monitor type: SIMPLE, BROWSER, SCRIPT_API, or SCRIPT_BROWSER")
+	cmd.Flags().IntVar(&createOpts.frequency, "frequency", 0, "Check frequency in minutes")
+	cmd.Flags().StringVar(&createOpts.uri, "uri", "", "URI to monitor")
+	cmd.Flags().StringVar(&createOpts.status, "status", "", "Monitor status: ENABLED, DISABLED, or MUTED (default ENABLED)")
+	cmd.Flags().StringArrayVar(&createOpts.locations, "location", nil, "Location to run the monitor from (repeatable)")
+	root.AddDryRunFlag(cmd, opts)
 
 	return cmd
 }
 
-func runCreate(opts *createOptions) error {
+func runCreate(opts *createOptions, cmd *cobra.Command) error {
 	v := opts.View()
 
-	// Read and parse the JSON file
-	data, err := os.ReadFile(opts.fromFile)
-	if err != nil {
-		return fmt.Errorf("failed to read file: %w", err)
+	inline := false
+	for _, name := range createInlineFlags {
+		if cmd.Flags().Changed(name) {
+			inline = true
+			break
+		}
 	}
 
 	var input api.SyntheticMonitorInput
-	if err := json.Unmarshal(data, &input); err != nil {
-		return fmt.Errorf("failed to parse JSON: %w", err)
+	switch {
+	case opts.fromFile != "" && inline:
+		return fmt.Errorf("--from-file cannot be combined with monitor flags (--%s)", strings.Join(createInlineFlags, ", --"))
+	case opts.fromFile != "":
+		data, err := os.ReadFile(opts.fromFile)
+		if err != nil {
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+		if err := json.Unmarshal(data, &input); err != nil {
+			return fmt.Errorf("failed to parse JSON: %w", err)
+		}
+	case inline:
+		input = api.SyntheticMonitorInput{
+			Name:      opts.name,
+			Type:      opts.monitorType,
+			Frequency: opts.frequency,
+			Status:    opts.status,
+			URI:       opts.uri,
+			Locations: opts.locations,
+		}
+	default:
+		return fmt.Errorf(`required flag(s) "from-file" not set`)
 	}
 
 	// Validate required fields
@@ -227,6 +277,10 @@ func runCreate(opts *createOptions) error {
 		input.Status = "ENABLED"
 	}
 
+	if opts.DryRun {
+		return opts.PrintDryRun(fmt.Sprintf("create synthetic monitor %q", input.Name), input)
+	}
+
 	client, err := opts.APIClient()
 	if err != nil {
 		return err
@@ -256,7 +310,13 @@ func runCreate(opts *createOptions) error {
 // updateOptions holds options for the update command
 type updateOptions struct {
 	*root.Options
-	fromFile string
+	fromFile  string
+	jsonMerge string
+	name      string
+	frequency int
+	status    string
+	uri       string
+	locations []string
 }
 
 func newUpdateCmd(opts *root.Options) *cobra.Command {
@@ -264,48 +324,77 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "update <monitor-id>",
-		Short: "Update an existing synthetic monitor from a JSON file",
-		Long: `Update an existing synthetic monitor from a JSON file.
+		Short: "Update an existing synthetic monitor",
+		Long: `Update an existing synthetic monitor.
 
-The JSON file format is similar to 'synthetics create', but the type cannot be changed.
-The monitor-id identifies which monitor to update.`,
+With --from-file, the monitor is replaced with the definition in the JSON file.
+The format is similar to 'synthetics create', but the type cannot be changed.
+
+Without --from-file, the current monitor is fetched and only the properties
+given by flags (or by a partial JSON object via --json-merge) are changed.
+All other properties, including options and slaThreshold, are sent back
+unchanged. --json-merge can set any monitor field; nested objects such as
+options are merged key by key.`,
 		Example: `  # Update a monitor from a JSON file
   nrq synthetics update abc-123-def-456 --from-file monitor.json
 
-  # Update and output result as JSON
-  nrq synthetics update abc-123-def-456 --from-file monitor.json -o json`,
+  # Disable a monitor, leaving everything else unchanged
+  nrq synthetics update abc-123-def-456 --status DISABLED
+
+  # Change frequency and locations
+  nrq synthetics update abc-123-def-456 --frequency 15 --locations AWS_US_EAST_1,AWS_EU_WEST_1
+
+  # Merge a partial JSON definition
+  nrq synthetics update abc-123-def-456 --json-merge '{"name": "Homepage", "uri": "https://example.com"}'
+
+  # Change one option, keeping the others
+  nrq synthetics update abc-123-def-456 --json-merge '{"options": {"verifySSL": true}}'`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runUpdate(updateOpts, args[0])
+			return runUpdate(cmd, updateOpts, args[0])
 		},
 	}
 
-	cmd.Flags().StringVarP(&updateOpts.fromFile, "from-file", "f", "", "Path to JSON file containing monitor definition (required)")
-	_ = cmd.MarkFlagRequired("from-file")
+	cmd.Flags().StringVarP(&updateOpts.fromFile, "from-file", "f", "", "Path to JSON file containing the full monitor definition")
+	cmd.Flags().StringVar(&updateOpts.jsonMerge, "json-merge", "", "Partial JSON object to merge into the current monitor")
+	cmd.Flags().StringVar(&updateOpts.name, "name", "", "New monitor name")
+	cmd.Flags().IntVar(&updateOpts.frequency, "frequency", 0, "Check frequency in minutes")
+	cmd.Flags().StringVar(&updateOpts.status, "status", "", "Monitor status: ENABLED, DISABLED, or MUTED")
+	cmd.Flags().StringVar(&updateOpts.uri, "uri", "", "URI to monitor")
+	cmd.Flags().StringSliceVar(&updateOpts.locations, "locations", nil, "Comma-separated monitor locations")
+	cmd.MarkFlagsMutuallyExclusive("from-file", "json-merge")
+	root.AddDryRunFlag(cmd, opts)
 
 	return cmd
 }
 
-func runUpdate(opts *updateOptions, monitorID string) error {
+func runUpdate(cmd *cobra.Command, opts *updateOptions, monitorID string) error {
 	v := opts.View()
 
-	// Read and parse the JSON file
-	data, err := os.ReadFile(opts.fromFile)
-	if err != nil {
-		return fmt.Errorf("failed to read file: %w", err)
-	}
+	var (
+		input *api.SyntheticMonitorInput
+		patch *api.SyntheticMonitorPatch
+		err   error
+	)
 
-	var input api.SyntheticMonitorInput
-	if err := json.Unmarshal(data, &input); err != nil {
-		return fmt.Errorf("failed to parse JSON: %w", err)
+	if opts.fromFile != "" {
+		if hasPropertyFlags(cmd) {
+			return fmt.Errorf("property flags cannot be combined with --from-file")
+		}
+		input, err = readUpdateFile(opts.fromFile)
+	} else {
+		patch, err = buildPatch(cmd, opts)
 	}
-
-	// Validate required fields
-	if input.Name == "" {
-		return fmt.Errorf("monitor name is required")
+	if err != nil {
+		return err
 	}
-	if input.Frequency == 0 {
-		return fmt.Errorf("monitor frequency is required (in minutes)")
+
+	if opts.DryRun {
+		action := fmt.Sprintf("update synthetic monitor %s", monitorID)
+		if input != nil {
+			return opts.PrintDryRun(action, input)
+		}
+		return opts.PrintDryRun(action+" (changed fields only)", patch)
 	}
 
 	client, err := opts.APIClient()
@@ -313,7 +402,12 @@ func runUpdate(opts *updateOptions, monitorID string) error {
 		return err
 	}
 
-	monitor, err := client.UpdateSyntheticMonitor(monitorID, &input)
+	var monitor *api.SyntheticMonitor
+	if input != nil {
+		monitor, err = client.UpdateSyntheticMonitor(monitorID, input)
+	} else {
+		monitor, err = client.PatchSyntheticMonitor(monitorID, patch)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to update monitor: %w", err)
 	}
@@ -333,6 +427,87 @@ func runUpdate(opts *updateOptions, monitorID string) error {
 	}
 }
 
+// readUpdateFile reads a full monitor definition for update
+func readUpdateFile(path string) (*api.SyntheticMonitorInput, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var input api.SyntheticMonitorInput
+	if err := json.Unmarshal(data, &input); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	// Validate required fields
+	if input.Name == "" {
+		return nil, fmt.Errorf("monitor name is required")
+	}
+	if input.Frequency == 0 {
+		return nil, fmt.Errorf("monitor frequency is required (in minutes)")
+	}
+
+	return &input, nil
+}
+
+// propertyFlags are the update flags that patch individual monitor fields
+var propertyFlags = []string{"name", "frequency", "status", "uri", "locations"}
+
+func hasPropertyFlags(cmd *cobra.Command) bool {
+	for _, name := range propertyFlags {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildPatch builds a partial update from --json-merge and property flags.
+// Flags take precedence over values in --json-merge.
+func buildPatch(cmd *cobra.Command, opts *updateOptions) (*api.SyntheticMonitorPatch, error) {
+	patch := &api.SyntheticMonitorPatch{}
+
+	if opts.jsonMerge != "" {
+		if err := json.Unmarshal([]byte(opts.jsonMerge), patch); err != nil {
+			return nil, fmt.Errorf("failed to parse --json-merge: %w", err)
+		}
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("name") {
+		patch.Name = &opts.name
+	}
+	if flags.Changed("frequency") {
+		patch.Frequency = &opts.frequency
+	}
+	if flags.Changed("status") {
+		status := strings.ToUpper(opts.status)
+		patch.Status = &status
+	}
+	if flags.Changed("uri") {
+		patch.URI = &opts.uri
+	}
+	if flags.Changed("locations") {
+		patch.Locations = opts.locations
+	}
+
+	if patch.IsEmpty() {
+		return nil, fmt.Errorf("nothing to update: specify --from-file, --json-merge, or at least one property flag")
+	}
+	if patch.Frequency != nil && *patch.Frequency <= 0 {
+		return nil, fmt.Errorf("frequency must be a positive number of minutes")
+	}
+	if patch.Status != nil {
+		switch *patch.Status {
+		case "ENABLED", "DISABLED", "MUTED":
+		default:
+			return nil, fmt.Errorf("invalid status %q: must be ENABLED, DISABLED, or MUTED", *patch.Status)
+		}
+	}
+
+	return patch, nil
+}
+
 // deleteOptions holds options for the delete command
 type deleteOptions struct {
 	*root.Options
@@ -362,7 +537,8 @@ WARNING: This action cannot be undone.`,
 		},
 	}
 
-	cmd.Flags().BoolVarP(&deleteOpts.force, "force", "f", false, "Skip confirmation prompt")
+	root.AddForceFlag(cmd, &deleteOpts.force, "Skip confirmation prompt")
+	root.AddDryRunFlag(cmd, opts)
 
 	return cmd
 }
@@ -381,6 +557,10 @@ func runDelete(opts *deleteOptions, monitorID string) error {
 		return fmt.Errorf("failed to get monitor: %w", err)
 	}
 
+	if opts.DryRun {
+		return opts.PrintDryRun(fmt.Sprintf("delete synthetic monitor %q (ID: %s)", monitor.Name, monitorID), nil)
+	}
+
 	if !opts.force {
 		p := &confirm.Prompter{
 			In:  opts.Stdin,
@@ -0,0 +1,47 @@
+package synthetics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+func TestScriptSetCmd_FromFileAlias(t *testing.T) {
+	for _, args := range [][]string{{"--file", "monitor.js"}, {"--from-file", "monitor.js"}, {"-f", "monitor.js"}} {
+		cmd := newScriptSetCmd(&root.Options{})
+		require.NoError(t, cmd.ParseFlags(args))
+
+		file, err := cmd.Flags().GetString("file")
+		require.NoError(t, err)
+		assert.Equal(t, "monitor.js", file, "args %v", args)
+	}
+	assert.True(t, newScriptSetCmd(&root.Options{}).Flags().Lookup("from-file").Hidden)
+}
+
+func TestScriptSetCmd_FileRequired(t *testing.T) {
+	err := runScriptSet(&scriptSetOptions{Options: &root.Options{}}, "abc-123")
+	assert.EqualError(t, err, `required flag(s) "file" not set`)
+}
+
+func TestBuildPatch_KeepsOtherFields(t *testing.T) {
+	opts := &updateOptions{Options: &root.Options{}}
+	cmd := newUpdateCmd(opts.Options)
+	require.NoError(t, cmd.ParseFlags([]string{
+		"--frequency", "10",
+		"--json-merge", `{"frequency": 30, "options": {"verifySSL": true}}`,
+	}))
+	opts.frequency, _ = cmd.Flags().GetInt("frequency")
+	opts.jsonMerge, _ = cmd.Flags().GetString("json-merge")
+
+	patch, err := buildPatch(cmd, opts)
+	require.NoError(t, err)
+
+	// Flags win over --json-merge, and fields without a flag are kept
+	require.NotNil(t, patch.Frequency)
+	assert.Equal(t, 10, *patch.Frequency)
+	assert.Nil(t, patch.Name)
+	assert.Equal(t, map[string]interface{}{"options": map[string]interface{}{"verifySSL": true}}, patch.Other)
+}
@@ -0,0 +1,141 @@
+package users
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+func newGroupsCmd(opts *root.Options) *cobra.Command {
+	groupsCmd := &cobra.Command{
+		Use:     "groups",
+		Aliases: []string{"group"},
+		Short:   "View user groups and their members",
+	}
+
+	groupsCmd.AddCommand(newGroupsListCmd(opts))
+	groupsCmd.AddCommand(newGroupsGetCmd(opts))
+
+	return groupsCmd
+}
+
+func newGroupsListCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all groups",
+		Long:  `List the groups of every authentication domain in your organization.`,
+		Example: `  nrq users groups list
+  nrq users groups list -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGroupsList(opts)
+		},
+	}
+}
+
+func runGroupsList(opts *root.Options) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	groups, err := client.ListGroups()
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	if len(groups) == 0 {
+		v.Println("No groups found")
+		return nil
+	}
+
+	headers := []string{"ID", "NAME", "DOMAIN"}
+	rows := make([][]string, len(groups))
+	for i, g := range groups {
+		rows[i] = []string{
+			g.ID,
+			view.Truncate(g.Name, 30),
+			view.Truncate(g.AuthenticationDomain, 20),
+		}
+	}
+
+	return v.Render(headers, rows, groups)
+}
+
+func newGroupsGetCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <group-name>",
+		Short: "List the members of a group",
+		Long: `List the users in a group. The group is looked up by name
+(case-insensitive); if groups in several authentication domains share the
+name, use the group ID from 'nrq users groups list' instead.`,
+		Example: `  nrq users groups get Admin
+  nrq users groups get "Read Only" -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGroupsGet(opts, args[0])
+		},
+	}
+}
+
+func runGroupsGet(opts *root.Options, name string) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	groups, err := client.ListGroups()
+	if err != nil {
+		return err
+	}
+
+	group, err := findGroup(groups, name)
+	if err != nil {
+		return err
+	}
+
+	members, err := client.ListGroupMembers(group.ID)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	if v.Format == view.FormatTable {
+		v.Print("Group: %s (%s)\n\n", group.Name, group.AuthenticationDomain)
+	}
+
+	return renderUsers(v, members)
+}
+
+// findGroup returns the group whose ID or name (case-insensitive) is name
+func findGroup(groups []api.Group, name string) (*api.Group, error) {
+	var matches []api.Group
+	for _, g := range groups {
+		if g.ID == name {
+			return &g, nil
+		}
+		if strings.EqualFold(g.Name, name) {
+			matches = append(matches, g)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("group not found: %s", name)
+	case 1:
+		return &matches[0], nil
+	default:
+		domains := make([]string, len(matches))
+		for i, g := range matches {
+			domains[i] = g.AuthenticationDomain
+		}
+		return nil, fmt.Errorf("group name %q is ambiguous (found in domains: %s); use the group ID instead", name, strings.Join(domains, ", "))
+	}
+}
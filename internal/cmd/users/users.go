@@ -1,10 +1,12 @@
 package users
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/newrelic-cli/api"
 	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
 	"github.com/open-cli-collective/newrelic-cli/internal/view"
 )
@@ -19,13 +21,19 @@ func Register(rootCmd *cobra.Command, opts *root.Options) {
 
 	usersCmd.AddCommand(newListCmd(opts))
 	usersCmd.AddCommand(newGetCmd(opts))
+	usersCmd.AddCommand(newSearchCmd(opts))
+	usersCmd.AddCommand(newInviteCmd(opts))
+	usersCmd.AddCommand(newGroupsCmd(opts))
 
 	rootCmd.AddCommand(usersCmd)
 }
 
 type listOptions struct {
 	*root.Options
-	limit int
+	limit    int
+	sort     string
+	sortDesc bool
+	filter   string
 }
 
 func newListCmd(opts *root.Options) *cobra.Command {
@@ -42,13 +50,18 @@ User types:
   BASIC_USER_TIER: Basic user`,
 		Example: `  nrq users list
   nrq users list -o json
-  nrq users list --limit 20`,
+  nrq users list --limit 20
+  nrq users list --sort email
+  nrq users list --filter "email~@example.com"`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runList(listOpts)
 		},
 	}
 
 	cmd.Flags().IntVarP(&listOpts.limit, "limit", "l", 0, "Limit number of results (0 = no limit)")
+	cmd.Flags().StringVar(&listOpts.sort, "sort", "", "Sort rows by this column (e.g., name, email, type)")
+	cmd.Flags().BoolVar(&listOpts.sortDesc, "sort-desc", false, "Sort in descending order")
+	cmd.Flags().StringVar(&listOpts.filter, "filter", "", "Only show rows matching COLUMN=VALUE (exact) or COLUMN~VALUE (contains)")
 
 	return cmd
 }
@@ -64,18 +77,45 @@ func runList(opts *listOptions) error {
 		return err
 	}
 
+	v := opts.View()
+
+	if len(users) == 0 {
+		v.Println("No users found")
+		return nil
+	}
+
+	headers, rows := userRows(users)
+	users, rows, err = view.FilterSort(users, rows, headers, opts.filter, opts.sort, opts.sortDesc)
+	if err != nil {
+		return err
+	}
+
 	// Apply limit
-	if opts.limit > 0 && len(users) > opts.limit {
-		users = users[:opts.limit]
+	if opts.limit > 0 {
+		if len(users) > opts.limit {
+			users = users[:opts.limit]
+		}
+		if len(rows) > opts.limit {
+			rows = rows[:opts.limit]
+		}
 	}
 
-	v := opts.View()
+	return v.Render(headers, rows, users)
+}
 
+// renderUsers renders a list of users
+func renderUsers(v *view.View, users []api.User) error {
 	if len(users) == 0 {
 		v.Println("No users found")
 		return nil
 	}
 
+	headers, rows := userRows(users)
+	return v.Render(headers, rows, users)
+}
+
+// userRows returns the table columns for users
+func userRows(users []api.User) ([]string, [][]string) {
 	headers := []string{"ID", "NAME", "EMAIL", "TYPE", "DOMAIN"}
 	rows := make([][]string, len(users))
 	for i, u := range users {
@@ -87,8 +127,7 @@ func runList(opts *listOptions) error {
 			view.Truncate(u.AuthenticationDomain, 20),
 		}
 	}
-
-	return v.Render(headers, rows, users)
+	return headers, rows
 }
 
 func newGetCmd(opts *root.Options) *cobra.Command {
@@ -138,3 +177,189 @@ func runGet(opts *root.Options, userID string) error {
 		return nil
 	}
 }
+
+// userTypes maps --type values to user tier IDs. The API reports types by
+// display name ("Basic", "Core", "Full platform"), so both forms are matched.
+var userTypes = map[string]string{
+	"basic": "BASIC_USER_TIER",
+	"core":  "CORE_USER_TIER",
+	"full":  "FULL_USER_TIER",
+}
+
+type searchOptions struct {
+	*root.Options
+	userType string
+}
+
+func newSearchCmd(opts *root.Options) *cobra.Command {
+	searchOpts := &searchOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search users by name or email",
+		Long: `Search users whose name or email contains the query (case-insensitive).
+
+Use --type to only show users of one type. An empty query with --type lists
+every user of that type.`,
+		Example: `  nrq users search jane
+  nrq users search @example.com --type full
+  nrq users search "" --type basic -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSearch(searchOpts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&searchOpts.userType, "type", "", "Only show users of this type: basic, core, or full")
+
+	return cmd
+}
+
+func runSearch(opts *searchOptions, query string) error {
+	userType := strings.ToLower(opts.userType)
+	if _, ok := userTypes[userType]; userType != "" && !ok {
+		return fmt.Errorf("invalid --type %q: must be basic, core, or full", opts.userType)
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	users, err := client.ListUsers()
+	if err != nil {
+		return err
+	}
+
+	return renderUsers(opts.View(), filterUsers(users, query, userType))
+}
+
+// filterUsers returns users whose name or email contains query
+// (case-insensitive) and, if userType (basic, core, or full) is set, whose
+// type matches it
+func filterUsers(users []api.User, query string, userType string) []api.User {
+	query = strings.ToLower(strings.TrimSpace(query))
+
+	var filtered []api.User
+	for _, u := range users {
+		if userType != "" && !matchesUserType(u.Type, userType) {
+			continue
+		}
+		if query != "" &&
+			!strings.Contains(strings.ToLower(u.Name), query) &&
+			!strings.Contains(strings.ToLower(u.Email), query) {
+			continue
+		}
+		filtered = append(filtered, u)
+	}
+	return filtered
+}
+
+// matchesUserType reports whether a user's type, given as a display name or
+// tier ID, is userType
+func matchesUserType(t, userType string) bool {
+	t = strings.ToLower(t)
+	return strings.HasPrefix(t, userType) || strings.EqualFold(t, userTypes[userType])
+}
+
+type inviteOptions struct {
+	*root.Options
+	email          string
+	name           string
+	userType       string
+	authDomainID   string
+	authDomainName string
+}
+
+func newInviteCmd(opts *root.Options) *cobra.Command {
+	inviteOpts := &inviteOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "invite",
+		Short: "Invite a new user",
+		Long: `Create a user in an authentication domain. New Relic emails the user an
+invitation to set up their login.
+
+The domain is given by ID with --auth-domain-id, or by name with
+--auth-domain-name. 'nrq users list' shows the domain of existing users.
+
+User types (--type):
+  basic or BASIC_USER_TIER: Basic user (default)
+  core  or CORE_USER_TIER:  Core user
+  full  or FULL_USER_TIER:  Full platform user`,
+		Example: `  nrq users invite --email alice@example.com --name "Alice Smith" --auth-domain-name Default
+  nrq users invite --email alice@example.com --name "Alice Smith" --type FULL_USER_TIER --auth-domain-id abc-123`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runInvite(inviteOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&inviteOpts.email, "email", "", "Email address of the new user (required)")
+	cmd.Flags().StringVar(&inviteOpts.name, "name", "", "Full name of the new user (required)")
+	cmd.Flags().StringVar(&inviteOpts.userType, "type", "BASIC_USER_TIER", "User type: basic, core, or full")
+	cmd.Flags().StringVar(&inviteOpts.authDomainID, "auth-domain-id", "", "ID of the authentication domain")
+	cmd.Flags().StringVar(&inviteOpts.authDomainName, "auth-domain-name", "", "Name of the authentication domain")
+	_ = cmd.MarkFlagRequired("email")
+	_ = cmd.MarkFlagRequired("name")
+	cmd.MarkFlagsOneRequired("auth-domain-id", "auth-domain-name")
+	cmd.MarkFlagsMutuallyExclusive("auth-domain-id", "auth-domain-name")
+
+	return cmd
+}
+
+func runInvite(opts *inviteOptions) error {
+	userType, err := userTier(opts.userType)
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	domainID := opts.authDomainID
+	if opts.authDomainName != "" {
+		domain, err := client.FindAuthDomain(opts.authDomainName)
+		if err != nil {
+			return err
+		}
+		domainID = domain.ID
+	}
+
+	user, err := client.InviteUser(api.UserInviteInput{
+		Email:                  opts.email,
+		Name:                   opts.name,
+		Type:                   userType,
+		AuthenticationDomainID: domainID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to invite user: %w", err)
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		return v.JSON(user)
+	case "plain":
+		return v.Plain([][]string{{user.ID, user.Name, user.Email}})
+	default:
+		v.Success("Invited %s <%s>", user.Name, user.Email)
+		v.Print("ID: %s\n", user.ID)
+		return nil
+	}
+}
+
+// userTier converts a --type value (basic, core, full, or a tier ID) to a tier ID
+func userTier(t string) (string, error) {
+	if tier, ok := userTypes[strings.ToLower(t)]; ok {
+		return tier, nil
+	}
+	for _, tier := range userTypes {
+		if strings.EqualFold(t, tier) {
+			return tier, nil
+		}
+	}
+	return "", fmt.Errorf("invalid --type %q: must be basic, core, or full", t)
+}
@@ -0,0 +1,92 @@
+package users
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+)
+
+var testUsers = []api.User{
+	{ID: "1", Name: "Jane Doe", Email: "jane@example.com", Type: "FULL_USER_TIER"},
+	{ID: "2", Name: "John Smith", Email: "jsmith@example.com", Type: "BASIC_USER_TIER"},
+	{ID: "3", Name: "Ops Bot", Email: "ops@other.io", Type: "CORE_USER_TIER"},
+	{ID: "4", Name: "Sam Lee", Email: "sam@other.io", Type: "Full platform"},
+}
+
+func userIDs(users []api.User) []string {
+	var ids []string
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	return ids
+}
+
+func TestFilterUsers_EmptyQuery(t *testing.T) {
+	assert.Equal(t, testUsers, filterUsers(testUsers, "", ""))
+}
+
+func TestFilterUsers_CaseInsensitive(t *testing.T) {
+	assert.Equal(t, []string{"1"}, userIDs(filterUsers(testUsers, "JANE", "")))
+	assert.Equal(t, []string{"2"}, userIDs(filterUsers(testUsers, "smith", "")))
+}
+
+func TestFilterUsers_MatchesEmail(t *testing.T) {
+	assert.Equal(t, []string{"1", "2"}, userIDs(filterUsers(testUsers, "@Example.com", "")))
+}
+
+func TestFilterUsers_Type(t *testing.T) {
+	assert.Equal(t, []string{"2"}, userIDs(filterUsers(testUsers, "", "basic")))
+	assert.Equal(t, []string{"1"}, userIDs(filterUsers(testUsers, "example", "full")))
+}
+
+func TestFilterUsers_TypeDisplayName(t *testing.T) {
+	assert.Equal(t, []string{"1", "4"}, userIDs(filterUsers(testUsers, "", "full")))
+}
+
+func TestFilterUsers_NoMatch(t *testing.T) {
+	assert.Empty(t, filterUsers(testUsers, "nobody", ""))
+}
+
+func TestUserTier(t *testing.T) {
+	for input, expected := range map[string]string{
+		"basic":           "BASIC_USER_TIER",
+		"Full":            "FULL_USER_TIER",
+		"CORE_USER_TIER":  "CORE_USER_TIER",
+		"full_user_tier":  "FULL_USER_TIER",
+		"BASIC_USER_TIER": "BASIC_USER_TIER",
+	} {
+		tier, err := userTier(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, tier, input)
+	}
+
+	_, err := userTier("admin")
+	assert.Error(t, err)
+}
+
+func TestFindGroup(t *testing.T) {
+	groups := []api.Group{
+		{ID: "grp-1", Name: "Admin", AuthenticationDomain: "Default"},
+		{ID: "grp-2", Name: "Engineering", AuthenticationDomain: "Default"},
+		{ID: "grp-3", Name: "Engineering", AuthenticationDomain: "Okta SSO"},
+	}
+
+	g, err := findGroup(groups, "admin")
+	require.NoError(t, err)
+	assert.Equal(t, "grp-1", g.ID)
+
+	g, err = findGroup(groups, "grp-3")
+	require.NoError(t, err)
+	assert.Equal(t, "Okta SSO", g.AuthenticationDomain)
+
+	_, err = findGroup(groups, "Engineering")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ambiguous")
+
+	_, err = findGroup(groups, "missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "group not found")
+}
@@ -0,0 +1,70 @@
+package whoami
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+)
+
+// Register adds the whoami command to the root command
+func Register(rootCmd *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the current user and account",
+		Long: `Show the user the API key belongs to, and the account and region
+commands run against.
+
+For a full check of the credentials and configuration, use 'nrq config test'
+or 'nrq config doctor'.`,
+		Example: `  nrq whoami
+  nrq whoami --profile production
+  nrq whoami -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWhoAmI(opts)
+		},
+	}
+
+	rootCmd.AddCommand(cmd)
+}
+
+func runWhoAmI(opts *root.Options) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	result, err := client.WhoAmI()
+	if err != nil {
+		return err
+	}
+
+	account := "(no account ID configured)"
+	accountID := ""
+	if result.AccountID != 0 {
+		account = result.AccountName
+		accountID = fmt.Sprintf("%d", result.AccountID)
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		return v.JSON(result)
+	case "plain":
+		return v.Plain([][]string{
+			{result.UserEmail, fmt.Sprintf("%d", result.UserID), result.AccountName, accountID, result.Region},
+		})
+	default:
+		v.Print("User:       %s (%s)\n", result.UserEmail, result.UserName)
+		v.Print("User ID:    %d\n", result.UserID)
+		v.Print("Account:    %s\n", account)
+		if accountID != "" {
+			v.Print("Account ID: %s\n", accountID)
+		}
+		v.Print("Region:     %s\n", result.Region)
+		return nil
+	}
+}
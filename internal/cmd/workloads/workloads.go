@@ -0,0 +1,179 @@
+package workloads
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/newrelic-cli/api"
+	"github.com/open-cli-collective/newrelic-cli/internal/cmd/root"
+	"github.com/open-cli-collective/newrelic-cli/internal/view"
+)
+
+// Register adds the workloads commands to the root command
+func Register(rootCmd *cobra.Command, opts *root.Options) {
+	workloadsCmd := &cobra.Command{
+		Use:     "workloads",
+		Aliases: []string{"workload"},
+		Short:   "View New Relic workloads",
+		Long: `View workloads, groups of entities whose health is monitored together.
+
+A workload's status is computed from its entities: OPERATIONAL, DEGRADED,
+DISRUPTED or UNKNOWN.`,
+	}
+
+	workloadsCmd.AddCommand(newListCmd(opts))
+	workloadsCmd.AddCommand(newGetCmd(opts))
+	workloadsCmd.AddCommand(newStatusCmd(opts))
+
+	rootCmd.AddCommand(workloadsCmd)
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List workloads",
+		Long:  `List the workloads in your account with their current status.`,
+		Example: `  nrq workloads list
+  nrq workloads list -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(opts)
+		},
+	}
+}
+
+func runList(opts *root.Options) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	workloads, err := client.ListWorkloads()
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	if len(workloads) == 0 {
+		v.Println("No workloads found")
+		return nil
+	}
+
+	headers := []string{"GUID", "NAME", "STATUS"}
+	rows := make([][]string, len(workloads))
+	for i, w := range workloads {
+		rows[i] = []string{
+			w.GUID.String(),
+			view.Truncate(w.Name, 40),
+			v.HealthStatus(w.Status),
+		}
+	}
+
+	return v.Render(headers, rows, workloads)
+}
+
+func newGetCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <guid>",
+		Short: "Get a workload and its entities",
+		Long: `Get a workload's status and the name and type of each entity in it.
+
+The GUID comes from 'workloads list' or the New Relic UI.`,
+		Example: `  nrq workloads get "MTIzNDU2N3xOUjF8V09SS0xPQUR8MTAx"
+  nrq workloads get "MTIzNDU2N3xOUjF8V09SS0xPQUR8MTAx" -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGet(opts, api.EntityGUID(args[0]))
+		},
+	}
+}
+
+func runGet(opts *root.Options, guid api.EntityGUID) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	workload, err := client.GetWorkload(guid)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		return v.JSON(workload)
+	case "plain":
+		rows := make([][]string, len(workload.Entities))
+		for i, e := range workload.Entities {
+			rows[i] = []string{e.GUID.String(), e.Name, e.Type}
+		}
+		return v.Plain(rows)
+	default:
+		v.Print("GUID:     %s\n", workload.GUID.String())
+		v.Print("Name:     %s\n", workload.Name)
+		v.Print("Status:   %s\n", v.HealthStatus(workload.Status))
+		if workload.Permalink != "" {
+			v.Print("Link:     %s\n", workload.Permalink)
+		}
+		v.Print("Entities: %d\n", len(workload.Entities))
+		if len(workload.Entities) == 0 {
+			return nil
+		}
+
+		v.Println()
+		headers := []string{"GUID", "NAME", "TYPE"}
+		rows := make([][]string, len(workload.Entities))
+		for i, e := range workload.Entities {
+			rows[i] = []string{e.GUID.String(), view.Truncate(e.Name, 40), e.Type}
+		}
+		return v.Table(headers, rows)
+	}
+}
+
+func newStatusCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status <guid>",
+		Short: "Show a workload's computed status",
+		Long: `Show a workload's computed status and where it comes from.
+
+Sources:
+  ROLLUP_RULE: Computed from the status of the workload's entities
+  STATIC:      Set manually
+  WORKLOAD:    Taken from other workloads`,
+		Example: `  nrq workloads status "MTIzNDU2N3xOUjF8V09SS0xPQUR8MTAx"
+  nrq workloads status "MTIzNDU2N3xOUjF8V09SS0xPQUR8MTAx" -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatus(opts, api.EntityGUID(args[0]))
+		},
+	}
+}
+
+func runStatus(opts *root.Options, guid api.EntityGUID) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	status, err := client.GetWorkloadStatus(guid)
+	if err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	switch v.Format {
+	case "json":
+		return v.JSON(status)
+	case "plain":
+		return v.Plain([][]string{{status.Value, status.Source, status.Description}})
+	default:
+		v.Print("Status: %s\n", v.HealthStatus(status.Value))
+		v.Print("Source: %s\n", status.Source)
+		if status.Description != "" {
+			v.Print("Detail: %s\n", status.Description)
+		}
+		return nil
+	}
+}
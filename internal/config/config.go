@@ -20,10 +20,15 @@ const (
 	RegionKey    = "region"
 )
 
-// GetAPIKey retrieves the New Relic API key
-func GetAPIKey() (string, error) {
+// GetAPIKey retrieves the New Relic API key from profile, or from the
+// active profile if profile is empty
+func GetAPIKey(profile string) (string, error) {
+	if err := CheckProfile(profile); err != nil {
+		return "", err
+	}
+
 	// Try secure storage first
-	key, err := getCredential(APIKeyKey)
+	key, err := getProfileCredential(profile, APIKeyKey)
 	if err == nil && key != "" {
 		return key, nil
 	}
@@ -47,10 +52,15 @@ func DeleteAPIKey() error {
 	return deleteCredential(APIKeyKey)
 }
 
-// GetAccountID retrieves the New Relic account ID
-func GetAccountID() (string, error) {
+// GetAccountID retrieves the New Relic account ID from profile, or from the
+// active profile if profile is empty
+func GetAccountID(profile string) (string, error) {
+	if err := CheckProfile(profile); err != nil {
+		return "", err
+	}
+
 	// Try secure storage first
-	id, err := getCredential(AccountIDKey)
+	id, err := getProfileCredential(profile, AccountIDKey)
 	if err == nil && id != "" {
 		return id, nil
 	}
@@ -74,10 +84,11 @@ func DeleteAccountID() error {
 	return deleteCredential(AccountIDKey)
 }
 
-// GetRegion retrieves the New Relic region (US or EU)
-func GetRegion() string {
+// GetRegion retrieves the New Relic region (US or EU) from profile, or from
+// the active profile if profile is empty
+func GetRegion(profile string) string {
 	// Try secure storage first
-	region, err := getCredential(RegionKey)
+	region, err := getProfileCredential(profile, RegionKey)
 	if err == nil && region != "" {
 		return region
 	}
@@ -96,22 +107,46 @@ func SetRegion(region string) error {
 	return setCredential(RegionKey, strings.ToUpper(region))
 }
 
-// IsSecureStorage returns true if using secure storage (macOS Keychain)
+// IsSecureStorage returns true if using secure storage (macOS Keychain or
+// Windows Credential Manager)
 func IsSecureStorage() bool {
-	return runtime.GOOS == "darwin"
+	return runtime.GOOS == "darwin" || runtime.GOOS == "windows"
 }
 
-// GetCredentialStatus returns the current credential status
-func GetCredentialStatus() map[string]bool {
+// SecureStorageName names the OS credential store used when
+// IsSecureStorage is true
+func SecureStorageName() string {
+	if runtime.GOOS == "windows" {
+		return "Windows Credential Manager"
+	}
+	return "Keychain"
+}
+
+// StorageType identifies where credentials are stored: "keychain",
+// "credential_manager", or "config_file"
+func StorageType() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "keychain"
+	case "windows":
+		return "credential_manager"
+	default:
+		return "config_file"
+	}
+}
+
+// GetCredentialStatus returns the credential status of profile, or of the
+// active profile if profile is empty
+func GetCredentialStatus(profile string) map[string]bool {
 	status := make(map[string]bool)
 
-	if key, _ := getCredential(APIKeyKey); key != "" {
+	if key, _ := getProfileCredential(profile, APIKeyKey); key != "" {
 		status["api_key_stored"] = true
 	}
-	if id, _ := getCredential(AccountIDKey); id != "" {
+	if id, _ := getProfileCredential(profile, AccountIDKey); id != "" {
 		status["account_id_stored"] = true
 	}
-	if region, _ := getCredential(RegionKey); region != "" {
+	if region, _ := getProfileCredential(profile, RegionKey); region != "" {
 		status["region_stored"] = true
 	}
 
@@ -125,11 +160,11 @@ func GetCredentialStatus() map[string]bool {
 // CheckPermissions verifies config file has secure permissions (Linux only)
 // Returns warning message if permissions are too open, empty string otherwise
 func CheckPermissions() string {
-	if runtime.GOOS == "darwin" {
-		return "" // macOS uses Keychain, no file to check
+	if IsSecureStorage() {
+		return "" // Credentials are in the OS store, no file to check
 	}
 
-	configPath := getConfigFilePath()
+	configPath := GetConfigFilePath()
 	info, err := os.Stat(configPath)
 	if err != nil {
 		return "" // File doesn't exist, that's OK
@@ -145,11 +180,11 @@ func CheckPermissions() string {
 
 // FixPermissions corrects config file permissions to 0600 (Linux only)
 func FixPermissions() error {
-	if runtime.GOOS == "darwin" {
-		return nil // macOS uses Keychain, nothing to fix
+	if IsSecureStorage() {
+		return nil // Credentials are in the OS store, nothing to fix
 	}
 
-	configPath := getConfigFilePath()
+	configPath := GetConfigFilePath()
 	if _, err := os.Stat(configPath); os.IsNotExist(err) {
 		return fmt.Errorf("credentials file does not exist")
 	}
@@ -190,31 +225,91 @@ func ClearAll() []error {
 // --- Platform-specific implementations ---
 
 func getCredential(key string) (string, error) {
-	if runtime.GOOS == "darwin" {
-		return getFromKeychain(key)
+	if !IsSecureStorage() {
+		return getFromConfigFile(key)
 	}
-	return getFromConfigFile(key)
+
+	value, err := getFromSecureStorage(serviceName, key)
+	if err != nil && runtime.GOOS == "windows" {
+		// Credentials saved before Credential Manager support are still in
+		// the credentials file
+		if migrated, ok := migrateFromFile(
+			func() (string, error) { return getFromConfigFile(key) },
+			func(v string) error { return setInWincred(serviceName, key, v) },
+			func() error { return deleteFromConfigFile(key) },
+		); ok {
+			return migrated, nil
+		}
+	}
+	return value, err
+}
+
+// migrateFromFile moves a credential out of a file into the OS store. read
+// loads it from the file, store saves it in the OS store and remove deletes
+// it from the file. The value is returned even when it cannot be stored, in
+// which case it stays in the file and the move is retried on the next read.
+func migrateFromFile(read func() (string, error), store func(string) error, remove func() error) (string, bool) {
+	value, err := read()
+	if err != nil || value == "" {
+		return "", false
+	}
+
+	if err := store(value); err == nil {
+		_ = remove()
+	}
+	return value, true
 }
 
 func setCredential(key, value string) error {
-	if runtime.GOOS == "darwin" {
-		return setInKeychain(key, value)
+	if IsSecureStorage() {
+		return setInSecureStorage(serviceName, key, value)
 	}
 	return setInConfigFile(key, value)
 }
 
 func deleteCredential(key string) error {
-	if runtime.GOOS == "darwin" {
-		return deleteFromKeychain(key)
+	if IsSecureStorage() {
+		return deleteFromSecureStorage(serviceName, key)
 	}
 	return deleteFromConfigFile(key)
 }
 
+// getFromSecureStorage reads from the Keychain on macOS or the Credential
+// Manager on Windows
+func getFromSecureStorage(service, account string) (string, error) {
+	if runtime.GOOS == "windows" {
+		return getFromWincred(service, account)
+	}
+	return getFromKeychain(service, account)
+}
+
+func setInSecureStorage(service, account, value string) error {
+	if runtime.GOOS == "windows" {
+		return setInWincred(service, account, value)
+	}
+	return setInKeychain(service, account, value)
+}
+
+func deleteFromSecureStorage(service, account string) error {
+	if runtime.GOOS == "windows" {
+		return deleteFromWincred(service, account)
+	}
+	return deleteFromKeychain(service, account)
+}
+
+// --- Windows Credential Manager ---
+
+// wincredTarget is the Credential Manager target name for a credential,
+// e.g. "newrelic-cli:api_key"
+func wincredTarget(service, account string) string {
+	return service + ":" + account
+}
+
 // --- macOS Keychain ---
 
-func getFromKeychain(account string) (string, error) {
+func getFromKeychain(service, account string) (string, error) {
 	cmd := exec.Command("security", "find-generic-password",
-		"-s", serviceName,
+		"-s", service,
 		"-a", account,
 		"-w")
 
@@ -226,12 +321,12 @@ func getFromKeychain(account string) (string, error) {
 	return strings.TrimSpace(string(output)), nil
 }
 
-func setInKeychain(account, value string) error {
+func setInKeychain(service, account, value string) error {
 	// First try to delete any existing item (ignore errors)
-	_ = deleteFromKeychain(account)
+	_ = deleteFromKeychain(service, account)
 
 	cmd := exec.Command("security", "add-generic-password",
-		"-s", serviceName,
+		"-s", service,
 		"-a", account,
 		"-w", value,
 		"-U") // Update if exists
@@ -239,9 +334,9 @@ func setInKeychain(account, value string) error {
 	return cmd.Run()
 }
 
-func deleteFromKeychain(account string) error {
+func deleteFromKeychain(service, account string) error {
 	cmd := exec.Command("security", "delete-generic-password",
-		"-s", serviceName,
+		"-s", service,
 		"-a", account)
 
 	return cmd.Run()
@@ -249,7 +344,9 @@ func deleteFromKeychain(account string) error {
 
 // --- Config File (Linux fallback) ---
 
-func getConfigDir() string {
+// GetConfigDir returns the directory holding the credentials and profiles
+// files: $XDG_CONFIG_HOME/newrelic-cli, or ~/.config/newrelic-cli
+func GetConfigDir() string {
 	if xdgConfig := os.Getenv("XDG_CONFIG_HOME"); xdgConfig != "" {
 		return filepath.Join(xdgConfig, "newrelic-cli")
 	}
@@ -257,12 +354,24 @@ func getConfigDir() string {
 	return filepath.Join(home, ".config", "newrelic-cli")
 }
 
-func getConfigFilePath() string {
-	return filepath.Join(getConfigDir(), "credentials")
+// GetCacheDir returns the directory for cached API data:
+// $XDG_CACHE_HOME/newrelic-cli, or ~/.cache/newrelic-cli
+func GetCacheDir() string {
+	if xdgCache := os.Getenv("XDG_CACHE_HOME"); xdgCache != "" {
+		return filepath.Join(xdgCache, "newrelic-cli")
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".cache", "newrelic-cli")
+}
+
+// GetConfigFilePath returns the path of the credentials file used when
+// credentials are not kept in an OS credential store
+func GetConfigFilePath() string {
+	return filepath.Join(GetConfigDir(), "credentials")
 }
 
 func getFromConfigFile(key string) (string, error) {
-	data, err := os.ReadFile(getConfigFilePath())
+	data, err := os.ReadFile(GetConfigFilePath())
 	if err != nil {
 		return "", err
 	}
@@ -279,12 +388,12 @@ func getFromConfigFile(key string) (string, error) {
 }
 
 func setInConfigFile(key, value string) error {
-	configDir := getConfigDir()
+	configDir := GetConfigDir()
 	if err := os.MkdirAll(configDir, 0700); err != nil {
 		return err
 	}
 
-	configPath := getConfigFilePath()
+	configPath := GetConfigFilePath()
 
 	// Read existing config
 	existing := make(map[string]string)
@@ -311,7 +420,7 @@ func setInConfigFile(key, value string) error {
 }
 
 func deleteFromConfigFile(key string) error {
-	configPath := getConfigFilePath()
+	configPath := GetConfigFilePath()
 
 	data, err := os.ReadFile(configPath)
 	if err != nil {
@@ -0,0 +1,187 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetters_ProfileEnvVar(t *testing.T) {
+	useTempConfigDir(t)
+
+	require.NoError(t, SetAPIKey("NRAK-DEFAULT"))
+	require.NoError(t, AddProfile("production", ProfileCredentials{
+		APIKey:    "NRAK-PRODUCTION",
+		AccountID: "7654321",
+		Region:    "EU",
+	}))
+
+	t.Setenv(ProfileEnvVar, "production")
+
+	key, err := GetAPIKey("")
+	require.NoError(t, err)
+	assert.Equal(t, "NRAK-PRODUCTION", key)
+
+	id, err := GetAccountID("")
+	require.NoError(t, err)
+	assert.Equal(t, "7654321", id)
+
+	assert.Equal(t, "EU", GetRegion(""))
+}
+
+func TestGetters_ProfileFlagOverridesEnvVar(t *testing.T) {
+	useTempConfigDir(t)
+
+	require.NoError(t, SetAPIKey("NRAK-DEFAULT"))
+	require.NoError(t, AddProfile("production", ProfileCredentials{APIKey: "NRAK-PRODUCTION"}))
+
+	t.Setenv(ProfileEnvVar, "production")
+
+	key, err := GetAPIKey(DefaultProfile)
+	require.NoError(t, err)
+	assert.Equal(t, "NRAK-DEFAULT", key)
+}
+
+func TestGetters_ProfileEnvVarOverridesActive(t *testing.T) {
+	useTempConfigDir(t)
+
+	require.NoError(t, AddProfile("staging", ProfileCredentials{APIKey: "NRAK-STAGING"}))
+	require.NoError(t, AddProfile("production", ProfileCredentials{APIKey: "NRAK-PRODUCTION"}))
+	require.NoError(t, UseProfile("staging"))
+
+	t.Setenv(ProfileEnvVar, "production")
+
+	assert.Equal(t, "production", ResolveProfile(""))
+	key, err := GetAPIKey("")
+	require.NoError(t, err)
+	assert.Equal(t, "NRAK-PRODUCTION", key)
+}
+
+func TestGetters_UnknownProfileEnvVar(t *testing.T) {
+	useTempConfigDir(t)
+
+	t.Setenv(ProfileEnvVar, "missing")
+
+	_, err := GetAPIKey("")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `profile "missing" not found`)
+}
+
+func TestGetConfigDir_XDGConfigHome(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+
+	assert.Equal(t, filepath.Join(dir, "newrelic-cli"), GetConfigDir())
+	assert.Equal(t, filepath.Join(dir, "newrelic-cli", "credentials"), GetConfigFilePath())
+
+	other := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", other)
+
+	assert.Equal(t, filepath.Join(other, "newrelic-cli"), GetConfigDir())
+}
+
+func TestGetConfigDir_HomeFallback(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join(home, ".config", "newrelic-cli"), GetConfigDir())
+	assert.Equal(t, filepath.Join(home, ".config", "newrelic-cli", "credentials"), GetConfigFilePath())
+}
+
+func TestGetCacheDir(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	assert.Equal(t, filepath.Join(dir, "newrelic-cli"), GetCacheDir())
+
+	t.Setenv("XDG_CACHE_HOME", "")
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, ".cache", "newrelic-cli"), GetCacheDir())
+}
+
+func TestMigrateFromFile_CredentialsFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	require.NoError(t, setInConfigFile(APIKeyKey, "NRAK-OLD"))
+	require.NoError(t, setInConfigFile(AccountIDKey, "1234567"))
+
+	stored := map[string]string{}
+	value, ok := migrateFromFile(
+		func() (string, error) { return getFromConfigFile(APIKeyKey) },
+		func(v string) error { stored[APIKeyKey] = v; return nil },
+		func() error { return deleteFromConfigFile(APIKeyKey) },
+	)
+	require.True(t, ok)
+	assert.Equal(t, "NRAK-OLD", value)
+	assert.Equal(t, "NRAK-OLD", stored[APIKeyKey])
+
+	// The migrated key leaves the file; the others stay until they are read
+	_, err := getFromConfigFile(APIKeyKey)
+	assert.Error(t, err)
+	id, err := getFromConfigFile(AccountIDKey)
+	require.NoError(t, err)
+	assert.Equal(t, "1234567", id)
+}
+
+func TestMigrateFromFile_StoreFails(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	require.NoError(t, setInConfigFile(APIKeyKey, "NRAK-OLD"))
+
+	value, ok := migrateFromFile(
+		func() (string, error) { return getFromConfigFile(APIKeyKey) },
+		func(string) error { return errors.New("access denied") },
+		func() error { return deleteFromConfigFile(APIKeyKey) },
+	)
+	require.True(t, ok)
+	assert.Equal(t, "NRAK-OLD", value)
+
+	// The file keeps the key so the next read can retry the move
+	key, err := getFromConfigFile(APIKeyKey)
+	require.NoError(t, err)
+	assert.Equal(t, "NRAK-OLD", key)
+}
+
+func TestMigrateFromFile_Missing(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	value, ok := migrateFromFile(
+		func() (string, error) { return getFromConfigFile(APIKeyKey) },
+		func(string) error { t.Fatal("nothing should be stored"); return nil },
+		func() error { return deleteFromConfigFile(APIKeyKey) },
+	)
+	assert.False(t, ok)
+	assert.Empty(t, value)
+}
+
+func TestMigrateFromFile_ProfilesFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv(ProfileEnvVar, "")
+	f := &profilesFile{sections: map[string]map[string]string{
+		"staging": {APIKeyKey: "NRAK-STAGING", RegionKey: "EU"},
+	}}
+	require.NoError(t, f.write())
+
+	stored := map[string]string{}
+	value, ok := migrateFromFile(
+		func() (string, error) { return getFromProfilesFile("staging", APIKeyKey) },
+		func(v string) error { stored[APIKeyKey] = v; return nil },
+		func() error { return deleteFromProfilesFile("staging", APIKeyKey) },
+	)
+	require.True(t, ok)
+	assert.Equal(t, "NRAK-STAGING", value)
+	assert.Equal(t, "NRAK-STAGING", stored[APIKeyKey])
+
+	_, err := getFromProfilesFile("staging", APIKeyKey)
+	assert.Error(t, err)
+	region, err := getFromProfilesFile("staging", RegionKey)
+	require.NoError(t, err)
+	assert.Equal(t, "EU", region)
+
+	// The profile itself still exists
+	assert.NoError(t, CheckProfile("staging"))
+}
@@ -0,0 +1,43 @@
+//go:build windows
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testWincredService keeps test credentials apart from real ones
+const testWincredService = "newrelic-cli-test"
+
+func TestIsSecureStorage_Windows(t *testing.T) {
+	assert.True(t, IsSecureStorage())
+	assert.Equal(t, "Windows Credential Manager", SecureStorageName())
+	assert.Equal(t, "credential_manager", StorageType())
+}
+
+func TestWincred_RoundTrip(t *testing.T) {
+	t.Cleanup(func() { _ = deleteFromWincred(testWincredService, APIKeyKey) })
+
+	require.NoError(t, setInWincred(testWincredService, APIKeyKey, "NRAK-TEST"))
+
+	value, err := getFromWincred(testWincredService, APIKeyKey)
+	require.NoError(t, err)
+	assert.Equal(t, "NRAK-TEST", value)
+
+	// Writing again replaces the stored value
+	require.NoError(t, setInWincred(testWincredService, APIKeyKey, "NRAK-UPDATED"))
+	value, err = getFromWincred(testWincredService, APIKeyKey)
+	require.NoError(t, err)
+	assert.Equal(t, "NRAK-UPDATED", value)
+
+	require.NoError(t, deleteFromWincred(testWincredService, APIKeyKey))
+	_, err = getFromWincred(testWincredService, APIKeyKey)
+	assert.Error(t, err)
+}
+
+func TestWincred_DeleteMissing(t *testing.T) {
+	assert.Error(t, deleteFromWincred(testWincredService, "missing"))
+}
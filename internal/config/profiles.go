@@ -0,0 +1,371 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// DefaultProfile is the profile backed by the original single-credential
+// storage (the credentials file on Linux, the "newrelic-cli" Keychain service
+// on macOS, "newrelic-cli:*" Credential Manager targets on Windows). It
+// always exists.
+const DefaultProfile = "default"
+
+// ProfileEnvVar selects a profile when --profile is not given
+const ProfileEnvVar = "NEWRELIC_PROFILE"
+
+// activeKey is the top-level profiles file entry naming the active profile
+const activeKey = "active"
+
+// ProfileCredentials holds the credentials stored in a named profile
+type ProfileCredentials struct {
+	APIKey    string
+	AccountID string
+	Region    string
+}
+
+// profilesFile is the parsed form of the profiles file. On Linux each
+// section holds the profile's credentials; on macOS and Windows sections
+// only record which profiles exist and the credentials live in the OS store.
+type profilesFile struct {
+	active   string
+	sections map[string]map[string]string
+}
+
+// ActiveProfile returns the profile selected with 'config profiles use',
+// or DefaultProfile
+func ActiveProfile() string {
+	f, err := readProfilesFile()
+	if err != nil || f.active == "" {
+		return DefaultProfile
+	}
+	if _, ok := f.sections[f.active]; !ok {
+		return DefaultProfile
+	}
+	return f.active
+}
+
+// ListProfiles returns all profile names, DefaultProfile first
+func ListProfiles() ([]string, error) {
+	f, err := readProfilesFile()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(f.sections))
+	for name := range f.sections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return append([]string{DefaultProfile}, names...), nil
+}
+
+// AddProfile creates or replaces a named profile
+func AddProfile(name string, creds ProfileCredentials) error {
+	if err := validateProfileName(name); err != nil {
+		return err
+	}
+
+	f, err := readProfilesFile()
+	if err != nil {
+		return err
+	}
+
+	values := map[string]string{
+		APIKeyKey:    creds.APIKey,
+		AccountIDKey: creds.AccountID,
+		RegionKey:    strings.ToUpper(creds.Region),
+	}
+
+	section := map[string]string{}
+	for key, value := range values {
+		if value == "" {
+			continue
+		}
+		if IsSecureStorage() {
+			if err := setInSecureStorage(profileServiceName(name), key, value); err != nil {
+				return fmt.Errorf("failed to store %s: %w", key, err)
+			}
+			continue
+		}
+		section[key] = value
+	}
+	f.sections[name] = section
+
+	return f.write()
+}
+
+// UseProfile makes a profile the active one
+func UseProfile(name string) error {
+	f, err := readProfilesFile()
+	if err != nil {
+		return err
+	}
+
+	if name == DefaultProfile {
+		f.active = ""
+		return f.write()
+	}
+	if _, ok := f.sections[name]; !ok {
+		return fmt.Errorf("profile %q not found", name)
+	}
+
+	f.active = name
+	return f.write()
+}
+
+// DeleteProfile removes a named profile. If it was active, the default
+// profile becomes active.
+func DeleteProfile(name string) error {
+	if name == DefaultProfile {
+		return fmt.Errorf("the default profile cannot be deleted - use 'nrq config clear' instead")
+	}
+
+	f, err := readProfilesFile()
+	if err != nil {
+		return err
+	}
+	if _, ok := f.sections[name]; !ok {
+		return fmt.Errorf("profile %q not found", name)
+	}
+
+	if IsSecureStorage() {
+		for _, key := range []string{APIKeyKey, AccountIDKey, RegionKey} {
+			_ = deleteFromSecureStorage(profileServiceName(name), key)
+		}
+	}
+
+	delete(f.sections, name)
+	if f.active == name {
+		f.active = ""
+	}
+
+	return f.write()
+}
+
+// ResolveProfile returns the profile to read credentials from. An explicit
+// name (the --profile flag) wins, then NEWRELIC_PROFILE, then the active
+// profile.
+func ResolveProfile(name string) string {
+	if name != "" {
+		return name
+	}
+	if env := os.Getenv(ProfileEnvVar); env != "" {
+		return env
+	}
+	return ActiveProfile()
+}
+
+// CheckProfile returns an error if a named profile does not exist. An empty
+// name refers to the active profile.
+func CheckProfile(name string) error {
+	name = ResolveProfile(name)
+	if name == DefaultProfile {
+		return nil
+	}
+
+	f, err := readProfilesFile()
+	if err != nil {
+		return err
+	}
+	if _, ok := f.sections[name]; !ok {
+		return fmt.Errorf("profile %q not found - run 'nrq config profiles list' to see available profiles", name)
+	}
+	return nil
+}
+
+// getProfileCredential reads a credential from a profile
+func getProfileCredential(profile, key string) (string, error) {
+	profile = ResolveProfile(profile)
+	if profile == DefaultProfile {
+		return getCredential(key)
+	}
+
+	if !IsSecureStorage() {
+		return getFromProfilesFile(profile, key)
+	}
+
+	service := profileServiceName(profile)
+	value, err := getFromSecureStorage(service, key)
+	if err != nil && runtime.GOOS == "windows" {
+		// Profiles added before Credential Manager support keep their
+		// credentials in the profiles file
+		if migrated, ok := migrateFromFile(
+			func() (string, error) { return getFromProfilesFile(profile, key) },
+			func(v string) error { return setInWincred(service, key, v) },
+			func() error { return deleteFromProfilesFile(profile, key) },
+		); ok {
+			return migrated, nil
+		}
+	}
+	return value, err
+}
+
+// getFromProfilesFile reads a credential from a profiles file section
+func getFromProfilesFile(profile, key string) (string, error) {
+	f, err := readProfilesFile()
+	if err != nil {
+		return "", err
+	}
+	value, ok := f.sections[profile][key]
+	if !ok {
+		return "", fmt.Errorf("key not found")
+	}
+	return value, nil
+}
+
+// deleteFromProfilesFile removes a credential from a profiles file section,
+// keeping the (possibly empty) section so the profile still exists
+func deleteFromProfilesFile(profile, key string) error {
+	f, err := readProfilesFile()
+	if err != nil {
+		return err
+	}
+	if _, ok := f.sections[profile][key]; !ok {
+		return nil
+	}
+	delete(f.sections[profile], key)
+	return f.write()
+}
+
+// GetStoredCredentials returns the credentials stored in profile, or in the
+// active profile if profile is empty. Environment variables are ignored.
+func GetStoredCredentials(profile string) (ProfileCredentials, error) {
+	if err := CheckProfile(profile); err != nil {
+		return ProfileCredentials{}, err
+	}
+
+	apiKey, _ := getProfileCredential(profile, APIKeyKey)
+	accountID, _ := getProfileCredential(profile, AccountIDKey)
+	region, _ := getProfileCredential(profile, RegionKey)
+
+	return ProfileCredentials{
+		APIKey:    apiKey,
+		AccountID: accountID,
+		Region:    region,
+	}, nil
+}
+
+// profileServiceName is the Keychain service (or Credential Manager target
+// prefix) holding a named profile
+func profileServiceName(name string) string {
+	return serviceName + "." + name
+}
+
+func validateProfileName(name string) error {
+	if name == "" {
+		return fmt.Errorf("profile name cannot be empty")
+	}
+	if name == DefaultProfile {
+		return fmt.Errorf("%q is reserved - use 'nrq config set-api-key' to configure the default profile", name)
+	}
+	if strings.ContainsAny(name, "[]= \t\r\n") {
+		return fmt.Errorf("invalid profile name %q: must not contain spaces, '=', '[' or ']'", name)
+	}
+	return nil
+}
+
+// ProfileStorageDescription describes where a profile's credentials are stored
+func ProfileStorageDescription(name string) string {
+	name = ResolveProfile(name)
+	switch {
+	case runtime.GOOS == "darwin" && name == DefaultProfile:
+		return "macOS Keychain (secure)"
+	case runtime.GOOS == "darwin":
+		return fmt.Sprintf("macOS Keychain, service %s (secure)", profileServiceName(name))
+	case runtime.GOOS == "windows" && name == DefaultProfile:
+		return "Windows Credential Manager (secure)"
+	case runtime.GOOS == "windows":
+		return fmt.Sprintf("Windows Credential Manager, target %s:* (secure)", profileServiceName(name))
+	case name == DefaultProfile:
+		return "Config file (~/.config/newrelic-cli/credentials)"
+	default:
+		return "Config file (~/.config/newrelic-cli/profiles)"
+	}
+}
+
+func getProfilesFilePath() string {
+	return filepath.Join(GetConfigDir(), "profiles")
+}
+
+// readProfilesFile parses the profiles file. A missing file has no profiles.
+func readProfilesFile() (*profilesFile, error) {
+	f := &profilesFile{sections: map[string]map[string]string{}}
+
+	data, err := os.ReadFile(getProfilesFilePath())
+	if os.IsNotExist(err) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var section map[string]string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			section = map[string]string{}
+			f.sections[name] = section
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		if section == nil {
+			if key == activeKey {
+				f.active = value
+			}
+			continue
+		}
+		section[key] = value
+	}
+
+	return f, nil
+}
+
+// write saves the profiles file with owner-only permissions
+func (f *profilesFile) write() error {
+	if err := os.MkdirAll(GetConfigDir(), 0700); err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	if f.active != "" {
+		fmt.Fprintf(&b, "%s=%s\n", activeKey, f.active)
+	}
+
+	names := make([]string, 0, len(f.sections))
+	for name := range f.sections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "[%s]\n", name)
+
+		keys := make([]string, 0, len(f.sections[name]))
+		for key := range f.sections[name] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(&b, "%s=%s\n", key, f.sections[name][key])
+		}
+	}
+
+	return os.WriteFile(getProfilesFilePath(), []byte(b.String()), 0600)
+}
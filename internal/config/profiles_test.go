@@ -0,0 +1,118 @@
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// useTempConfigDir points the config file storage at a temporary directory
+func useTempConfigDir(t *testing.T) {
+	t.Helper()
+	if IsSecureStorage() {
+		t.Skip("profile credentials are stored in the OS credential store on macOS and Windows")
+	}
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("NEWRELIC_API_KEY", "")
+	t.Setenv("NEWRELIC_ACCOUNT_ID", "")
+	t.Setenv("NEWRELIC_REGION", "")
+	t.Setenv(ProfileEnvVar, "")
+}
+
+func TestProfiles_AddAndRead(t *testing.T) {
+	useTempConfigDir(t)
+
+	require.NoError(t, AddProfile("staging", ProfileCredentials{
+		APIKey:    "NRAK-STAGING",
+		AccountID: "1234567",
+		Region:    "eu",
+	}))
+
+	key, err := GetAPIKey("staging")
+	require.NoError(t, err)
+	assert.Equal(t, "NRAK-STAGING", key)
+
+	id, err := GetAccountID("staging")
+	require.NoError(t, err)
+	assert.Equal(t, "1234567", id)
+
+	assert.Equal(t, "EU", GetRegion("staging"))
+
+	info, err := os.Stat(getProfilesFilePath())
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestProfiles_UseSwitchesActive(t *testing.T) {
+	useTempConfigDir(t)
+
+	require.NoError(t, SetAPIKey("NRAK-DEFAULT"))
+	require.NoError(t, AddProfile("staging", ProfileCredentials{APIKey: "NRAK-STAGING"}))
+
+	assert.Equal(t, DefaultProfile, ActiveProfile())
+	key, err := GetAPIKey("")
+	require.NoError(t, err)
+	assert.Equal(t, "NRAK-DEFAULT", key)
+
+	require.NoError(t, UseProfile("staging"))
+	assert.Equal(t, "staging", ActiveProfile())
+	key, err = GetAPIKey("")
+	require.NoError(t, err)
+	assert.Equal(t, "NRAK-STAGING", key)
+
+	// An explicit profile overrides the active one
+	key, err = GetAPIKey(DefaultProfile)
+	require.NoError(t, err)
+	assert.Equal(t, "NRAK-DEFAULT", key)
+
+	require.NoError(t, UseProfile(DefaultProfile))
+	assert.Equal(t, DefaultProfile, ActiveProfile())
+}
+
+func TestProfiles_List(t *testing.T) {
+	useTempConfigDir(t)
+
+	require.NoError(t, AddProfile("prod", ProfileCredentials{APIKey: "a"}))
+	require.NoError(t, AddProfile("dev", ProfileCredentials{APIKey: "b"}))
+
+	names, err := ListProfiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{DefaultProfile, "dev", "prod"}, names)
+}
+
+func TestProfiles_Delete(t *testing.T) {
+	useTempConfigDir(t)
+
+	require.NoError(t, AddProfile("staging", ProfileCredentials{APIKey: "NRAK-STAGING"}))
+	require.NoError(t, UseProfile("staging"))
+
+	require.NoError(t, DeleteProfile("staging"))
+
+	assert.Equal(t, DefaultProfile, ActiveProfile())
+	names, err := ListProfiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{DefaultProfile}, names)
+
+	assert.Error(t, DeleteProfile("staging"))
+	assert.Error(t, DeleteProfile(DefaultProfile))
+}
+
+func TestProfiles_Unknown(t *testing.T) {
+	useTempConfigDir(t)
+
+	_, err := GetAPIKey("missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `profile "missing" not found`)
+
+	assert.Error(t, UseProfile("missing"))
+}
+
+func TestProfiles_InvalidName(t *testing.T) {
+	useTempConfigDir(t)
+
+	for _, name := range []string{"", DefaultProfile, "has space", "a=b", "[x]"} {
+		assert.Error(t, AddProfile(name, ProfileCredentials{APIKey: "a"}), name)
+	}
+}
@@ -0,0 +1,21 @@
+//go:build !windows
+
+package config
+
+import "errors"
+
+// errNoWincred is returned by the Windows Credential Manager functions on
+// other platforms; callers only use them when runtime.GOOS is "windows"
+var errNoWincred = errors.New("windows credential manager is not available on this platform")
+
+func getFromWincred(service, account string) (string, error) {
+	return "", errNoWincred
+}
+
+func setInWincred(service, account, value string) error {
+	return errNoWincred
+}
+
+func deleteFromWincred(service, account string) error {
+	return errNoWincred
+}
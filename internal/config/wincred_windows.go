@@ -0,0 +1,102 @@
+//go:build windows
+
+package config
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// Windows Credential Manager, called directly through advapi32 so that no
+// cgo or extra dependency is needed
+
+var (
+	advapi32        = syscall.NewLazyDLL("advapi32.dll")
+	procCredReadW   = advapi32.NewProc("CredReadW")
+	procCredWriteW  = advapi32.NewProc("CredWriteW")
+	procCredDeleteW = advapi32.NewProc("CredDeleteW")
+	procCredFree    = advapi32.NewProc("CredFree")
+)
+
+const (
+	credTypeGeneric         = 1 // CRED_TYPE_GENERIC
+	credPersistLocalMachine = 2 // CRED_PERSIST_LOCAL_MACHINE
+)
+
+// credential mirrors the Win32 CREDENTIALW struct
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        syscall.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+func getFromWincred(service, account string) (string, error) {
+	target, err := syscall.UTF16PtrFromString(wincredTarget(service, account))
+	if err != nil {
+		return "", err
+	}
+
+	var cred *credential
+	r, _, err := procCredReadW.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0, uintptr(unsafe.Pointer(&cred)))
+	if r == 0 {
+		return "", err
+	}
+	defer func() { _, _, _ = procCredFree.Call(uintptr(unsafe.Pointer(cred))) }()
+
+	if cred.CredentialBlobSize == 0 {
+		return "", nil
+	}
+	return string(unsafe.Slice(cred.CredentialBlob, cred.CredentialBlobSize)), nil
+}
+
+func setInWincred(service, account, value string) error {
+	target, err := syscall.UTF16PtrFromString(wincredTarget(service, account))
+	if err != nil {
+		return err
+	}
+	user, err := syscall.UTF16PtrFromString(account)
+	if err != nil {
+		return err
+	}
+
+	blob := []byte(value)
+	cred := credential{
+		Type:               credTypeGeneric,
+		TargetName:         target,
+		CredentialBlobSize: uint32(len(blob)),
+		Persist:            credPersistLocalMachine,
+		UserName:           user,
+	}
+	if len(blob) > 0 {
+		cred.CredentialBlob = &blob[0]
+	}
+
+	// CredWrite replaces an existing credential with the same target
+	r, _, err := procCredWriteW.Call(uintptr(unsafe.Pointer(&cred)), 0)
+	if r == 0 {
+		return err
+	}
+	return nil
+}
+
+func deleteFromWincred(service, account string) error {
+	target, err := syscall.UTF16PtrFromString(wincredTarget(service, account))
+	if err != nil {
+		return err
+	}
+
+	r, _, err := procCredDeleteW.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0)
+	if r == 0 {
+		return err
+	}
+	return nil
+}
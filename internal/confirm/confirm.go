@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 )
 
@@ -13,6 +14,21 @@ type Prompter struct {
 	Out io.Writer
 }
 
+// IsInteractive reports whether the prompter reads from a terminal.
+// Prompts in scripts and CI, where input is piped or redirected, would
+// otherwise block or read unintended input.
+func (p *Prompter) IsInteractive() bool {
+	f, ok := p.In.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
 // Confirm prompts the user with a yes/no question
 // Returns true if user confirms, false otherwise
 // Default (empty input) returns false for safety
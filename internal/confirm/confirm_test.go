@@ -2,10 +2,12 @@ package confirm
 
 import (
 	"io"
+	"os"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConfirm(t *testing.T) {
@@ -111,3 +113,15 @@ func TestConfirmDanger_OutputPrompt(t *testing.T) {
 	expected := "This will permanently delete all data.\nType 'delete' to confirm: "
 	assert.Equal(t, expected, output.String())
 }
+
+func TestIsInteractive(t *testing.T) {
+	p := &Prompter{In: strings.NewReader("y\n"), Out: io.Discard}
+	assert.False(t, p.IsInteractive())
+
+	f, err := os.CreateTemp(t.TempDir(), "stdin")
+	require.NoError(t, err)
+	defer f.Close()
+
+	p = &Prompter{In: f, Out: io.Discard}
+	assert.False(t, p.IsInteractive())
+}
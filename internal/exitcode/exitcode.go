@@ -24,6 +24,12 @@ const (
 
 	// ServerError indicates a server error (5xx)
 	ServerError = 6
+
+	// GraphQLError indicates NerdGraph rejected a query (errors in a 200 response)
+	GraphQLError = 7
+
+	// Interrupted indicates the command was cancelled with Ctrl+C (128 + SIGINT)
+	Interrupted = 130
 )
 
 // FromHTTPStatus maps HTTP status codes to exit codes
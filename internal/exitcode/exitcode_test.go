@@ -58,4 +58,5 @@ func TestExitCodeValues(t *testing.T) {
 	assert.Equal(t, 4, AuthError)
 	assert.Equal(t, 5, APIError)
 	assert.Equal(t, 6, ServerError)
+	assert.Equal(t, 7, GraphQLError)
 }
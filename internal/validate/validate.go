@@ -2,6 +2,8 @@ package validate
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/open-cli-collective/newrelic-cli/api"
@@ -28,3 +30,82 @@ func APIKey(key string) (warning string, err error) {
 	_, warning, err = api.NewAPIKey(key)
 	return warning, err
 }
+
+// maxNRQLLimit is the largest LIMIT NRQL accepts
+const maxNRQLLimit = 5000
+
+// nrqlLimit matches a numeric LIMIT clause
+var nrqlLimit = regexp.MustCompile(`(?i)\bLIMIT\s+(\d+)`)
+
+// NRQL checks a query for mistakes that can be caught before sending it:
+// an empty query, an unknown leading keyword, and unbalanced parentheses or
+// quotes. Returns a warning message (not error) for a LIMIT above what NRQL
+// returns.
+func NRQL(query string) (warning string, err error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return "", fmt.Errorf("NRQL query is empty")
+	}
+
+	switch strings.ToUpper(strings.Fields(query)[0]) {
+	case "SELECT", "FROM", "SHOW", "WITH":
+	default:
+		return "", fmt.Errorf("invalid NRQL query: must start with SELECT, FROM, SHOW, or WITH, not %q", strings.Fields(query)[0])
+	}
+
+	if err := checkNRQLBalance(query); err != nil {
+		return "", err
+	}
+
+	if m := nrqlLimit.FindStringSubmatch(query); m != nil {
+		if n, err := strconv.Atoi(m[1]); err == nil && n > maxNRQLLimit {
+			return fmt.Sprintf("LIMIT %d is above the NRQL maximum of %d; at most %d results will be returned", n, maxNRQLLimit, maxNRQLLimit), nil
+		}
+	}
+
+	return "", nil
+}
+
+// checkNRQLBalance reports unbalanced parentheses and unterminated string
+// literals or backtick-quoted names, ignoring parentheses inside them
+func checkNRQLBalance(query string) error {
+	depth := 0
+	var quote rune // ' or ` while inside a literal
+	quoteStart := 0
+
+	runes := []rune(query)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if quote != 0 {
+			switch {
+			case r == '\\' && quote == '\'':
+				i++ // skip the escaped character
+			case r == quote:
+				quote = 0
+			}
+			continue
+		}
+
+		switch r {
+		case '\'', '`':
+			quote = r
+			quoteStart = i
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return fmt.Errorf("invalid NRQL query: unexpected ')' at position %d", i+1)
+			}
+			depth--
+		}
+	}
+
+	if quote != 0 {
+		return fmt.Errorf("invalid NRQL query: unterminated %c at position %d", quote, quoteStart+1)
+	}
+	if depth > 0 {
+		return fmt.Errorf("invalid NRQL query: %d unclosed '('", depth)
+	}
+	return nil
+}
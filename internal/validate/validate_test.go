@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRegion(t *testing.T) {
@@ -124,3 +125,58 @@ func TestRegion_ErrorMessage(t *testing.T) {
 	assert.Contains(t, err.Error(), "XX")
 	assert.Contains(t, err.Error(), "US or EU")
 }
+
+func TestNRQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"select", "SELECT count(*) FROM Transaction SINCE 1 hour ago", ""},
+		{"lowercase", "select count(*) from Transaction", ""},
+		{"from first", "FROM Transaction SELECT average(duration) FACET appName", ""},
+		{"leading space", "  SELECT * FROM Log", ""},
+		{"nested parens", "SELECT percentage(count(*), WHERE (error IS true)) FROM Transaction", ""},
+		{"paren in string", "SELECT * FROM Log WHERE message LIKE '%(oops%'", ""},
+		{"escaped quote", `SELECT * FROM Log WHERE message = 'it\'s (fine'`, ""},
+		{"backtick name", "SELECT `my (attr)` FROM Transaction", ""},
+		{"empty", "", "empty"},
+		{"whitespace", "   ", "empty"},
+		{"show event types", "SHOW EVENT TYPES SINCE 1 day ago", ""},
+		{"with clause", "WITH aparse(url, 'https://*/*') AS path SELECT count(*) FROM PageView FACET path", ""},
+		{"bad keyword", "DELETE FROM Transaction", "must start with SELECT, FROM, SHOW, or WITH"},
+		{"unclosed paren", "SELECT count(* FROM Transaction", "1 unclosed '('"},
+		{"extra paren", "SELECT count(*)) FROM Transaction", "unexpected ')' at position 16"},
+		{"unclosed quote", "SELECT * FROM Log WHERE level = 'error", "unterminated ' at position 33"},
+		{"unclosed backtick", "SELECT `attr FROM Log", "unterminated ` at position 8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warning, err := NRQL(tt.input)
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Empty(t, warning, "should not return warning when error")
+			} else {
+				assert.NoError(t, err)
+				assert.Empty(t, warning)
+			}
+		})
+	}
+}
+
+func TestNRQL_LimitWarning(t *testing.T) {
+	warning, err := NRQL("SELECT * FROM Log LIMIT 10000")
+	assert.NoError(t, err)
+	assert.Contains(t, warning, "5000")
+
+	warning, err = NRQL("SELECT * FROM Log LIMIT 5000")
+	assert.NoError(t, err)
+	assert.Empty(t, warning)
+
+	warning, err = NRQL("SELECT * FROM Log LIMIT MAX")
+	assert.NoError(t, err)
+	assert.Empty(t, warning)
+}
@@ -0,0 +1,65 @@
+package view
+
+import (
+	"math"
+	"strings"
+)
+
+// sparkLevels are the bar heights of a sparkline, lowest first
+var sparkLevels = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders values as a single line of bars scaled between the
+// smallest and largest value. When there are more values than width, they
+// are averaged into width buckets; a width of 0 or less draws one bar per
+// value. NaN values are drawn as spaces.
+func Sparkline(values []float64, width int) string {
+	if width > 0 && len(values) > width {
+		values = downsample(values, width)
+	}
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		switch {
+		case math.IsNaN(v):
+			b.WriteRune(' ')
+		case hi == lo:
+			b.WriteRune(sparkLevels[0])
+		default:
+			level := int(math.Round((v - lo) / (hi - lo) * float64(len(sparkLevels)-1)))
+			b.WriteRune(sparkLevels[level])
+		}
+	}
+	return b.String()
+}
+
+// downsample averages values into n evenly sized buckets, skipping NaN
+func downsample(values []float64, n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		start := i * len(values) / n
+		end := (i + 1) * len(values) / n
+
+		sum, count := 0.0, 0
+		for _, v := range values[start:end] {
+			if !math.IsNaN(v) {
+				sum += v
+				count++
+			}
+		}
+		if count == 0 {
+			out[i] = math.NaN()
+		} else {
+			out[i] = sum / float64(count)
+		}
+	}
+	return out
+}
@@ -0,0 +1,45 @@
+package view
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		width  int
+		want   string
+	}{
+		{"empty", nil, 10, ""},
+		{"every level", []float64{0, 1, 2, 3, 4, 5, 6, 7}, 0, "▁▂▃▄▅▆▇█"},
+		{"scaled", []float64{10, 20, 30}, 0, "▁▅█"},
+		{"negative", []float64{-5, 0, 5}, 0, "▁▅█"},
+		{"flat", []float64{3, 3, 3}, 0, "▁▁▁"},
+		{"nan gap", []float64{0, math.NaN(), 7}, 0, "▁ █"},
+		{"fits width", []float64{0, 7}, 10, "▁█"},
+		{"downsampled", []float64{0, 0, 0, 0, 7, 7, 7, 7}, 2, "▁█"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Sparkline(tt.values, tt.width))
+		})
+	}
+}
+
+func TestSparkline_Width(t *testing.T) {
+	values := make([]float64, 100)
+	for i := range values {
+		values[i] = float64(i)
+	}
+
+	line := Sparkline(values, 20)
+
+	assert.Equal(t, 20, len([]rune(line)))
+	assert.Equal(t, '▁', []rune(line)[0])
+	assert.Equal(t, '█', []rune(line)[19])
+}
@@ -0,0 +1,115 @@
+package view
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// tablePadding is the number of spaces between table columns
+const tablePadding = 2
+
+// minFitWidth is the narrowest fitTable shrinks a column to
+const minFitWidth = 4
+
+// TerminalWidth returns the width of the terminal in columns: $COLUMNS if
+// set, otherwise the size of the terminal on stdout or stderr. It returns 0
+// when the width is unknown, e.g. when output is redirected.
+func TerminalWidth() int {
+	if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && n > 0 {
+		return n
+	}
+	for _, f := range []*os.File{os.Stdout, os.Stderr} {
+		if width, ok := terminalSize(f); ok {
+			return width
+		}
+	}
+	return 0
+}
+
+// fitTable truncates cells so that the table fits in width columns,
+// shrinking wide columns in proportion to their size. It returns the
+// headers and rows unchanged when they already fit.
+func fitTable(headers []string, rows [][]string, width int) ([]string, [][]string) {
+	widths := make([]int, len(headers))
+	for i, h := range headers {
+		widths[i] = len(h)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			if i < len(widths) {
+				widths[i] = max(widths[i], len(cell))
+			}
+		}
+	}
+
+	limits := fitWidths(widths, width-tablePadding*(len(widths)-1))
+	if limits == nil {
+		return headers, rows
+	}
+
+	fit := func(cells []string) []string {
+		out := make([]string, len(cells))
+		for i, cell := range cells {
+			// Truncating would cut through color escape codes
+			if i < len(limits) && !strings.Contains(cell, "\x1b") {
+				cell = Truncate(cell, limits[i])
+			}
+			out[i] = cell
+		}
+		return out
+	}
+
+	fitRows := make([][]string, len(rows))
+	for i, row := range rows {
+		fitRows[i] = fit(row)
+	}
+	return fit(headers), fitRows
+}
+
+// fitWidths returns the width each column may use so that they add up to
+// at most total, or nil if widths already do. Columns narrower than an even
+// share keep their width; the rest split what is left in proportion to
+// their widths, down to minFitWidth.
+func fitWidths(widths []int, total int) []int {
+	sum := 0
+	for _, w := range widths {
+		sum += w
+	}
+	if sum <= total {
+		return nil
+	}
+
+	limits := make([]int, len(widths))
+	pending := make([]int, len(widths))
+	for i := range widths {
+		pending[i] = i
+	}
+
+	remaining := total
+	for len(pending) > 0 {
+		share := remaining / len(pending)
+		var wide []int
+		for _, i := range pending {
+			if widths[i] <= share {
+				limits[i] = widths[i]
+				remaining -= widths[i]
+			} else {
+				wide = append(wide, i)
+			}
+		}
+		if len(wide) == len(pending) {
+			break
+		}
+		pending = wide
+	}
+
+	wideSum := 0
+	for _, i := range pending {
+		wideSum += widths[i]
+	}
+	for _, i := range pending {
+		limits[i] = max(max(remaining, 0)*widths[i]/wideSum, minFitWidth)
+	}
+	return limits
+}
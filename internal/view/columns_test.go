@@ -0,0 +1,100 @@
+package view
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTable_ColumnWidth(t *testing.T) {
+	var out bytes.Buffer
+	v := New(&out, &bytes.Buffer{})
+	v.NoColor = true
+	v.ColumnWidth = 12
+
+	err := v.Table([]string{"ID", "NAME", "STATUS"}, [][]string{
+		{"1", "api", "green"},
+		{"22", "checkout-service", "red"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, ""+
+		"ID          NAME              STATUS\n"+
+		"1           api               green\n"+
+		"22          checkout-service  red\n", out.String())
+}
+
+func TestTable_DefaultWidth(t *testing.T) {
+	var out bytes.Buffer
+	v := New(&out, &bytes.Buffer{})
+	v.NoColor = true
+
+	err := v.Table([]string{"ID", "NAME"}, [][]string{{"1", "api"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, "ID  NAME\n1   api\n", out.String())
+}
+
+func TestTable_TableWidth(t *testing.T) {
+	var out bytes.Buffer
+	v := New(&out, &bytes.Buffer{})
+	v.NoColor = true
+	v.TableWidth = 30
+
+	rows := [][]string{
+		{"1", "a-very-long-application-name-indeed", "APPLICATION"},
+		{"2", "short", "HOST"},
+	}
+	err := v.Table([]string{"ID", "NAME", "TYPE"}, rows)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	for _, line := range lines {
+		assert.LessOrEqual(t, len(line), 30, line)
+	}
+	assert.Equal(t, "1   a-very-lon...  APPLICATION", lines[1])
+
+	// The caller's rows are left alone
+	assert.Equal(t, "a-very-long-application-name-indeed", rows[0][1])
+}
+
+func TestTable_TableWidthAlreadyFits(t *testing.T) {
+	var out bytes.Buffer
+	v := New(&out, &bytes.Buffer{})
+	v.NoColor = true
+	v.TableWidth = 80
+
+	err := v.Table([]string{"ID", "NAME"}, [][]string{{"1", "checkout-service"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, "ID  NAME\n1   checkout-service\n", out.String())
+}
+
+func TestFitWidths(t *testing.T) {
+	tests := []struct {
+		name   string
+		widths []int
+		total  int
+		want   []int
+	}{
+		{"fits", []int{2, 10}, 12, nil},
+		{"narrow columns keep their width", []int{2, 40, 11}, 25, []int{2, 12, 11}},
+		{"wide columns shrink in proportion", []int{2, 40, 20}, 32, []int{2, 20, 10}},
+		{"never below the minimum", []int{30, 30}, 4, []int{minFitWidth, minFitWidth}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, fitWidths(tt.widths, tt.total))
+		})
+	}
+}
+
+func TestTerminalWidth_Columns(t *testing.T) {
+	t.Setenv("COLUMNS", "132")
+	assert.Equal(t, 132, TerminalWidth())
+}
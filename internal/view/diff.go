@@ -0,0 +1,214 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// diffContext is the number of unchanged lines shown around each change
+const diffContext = 3
+
+// maxDiffCells bounds the line-matching table. Larger changes are shown as
+// the whole changed region removed and re-added.
+const maxDiffCells = 4_000_000
+
+// diffLine is one line of a diff: ' ' unchanged, '-' removed or '+' added
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// Diff renders before and after as indented JSON and returns a unified diff
+// of the two, or "" when they are the same
+func Diff(before, after interface{}) (string, error) {
+	a, err := json.MarshalIndent(before, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode before: %w", err)
+	}
+	b, err := json.MarshalIndent(after, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode after: %w", err)
+	}
+
+	lines := diffLines(strings.Split(string(a), "\n"), strings.Split(string(b), "\n"))
+
+	var out strings.Builder
+	for _, h := range diffHunks(lines) {
+		if out.Len() == 0 {
+			out.WriteString("--- before\n+++ after\n")
+		}
+		out.WriteString(h)
+	}
+	return out.String(), nil
+}
+
+// PrintDiff writes a diff from Diff to stderr, coloring removed lines red
+// and added lines green
+func (v *View) PrintDiff(diff string) {
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		if line == "" {
+			continue
+		}
+		v.printDiffLine(line)
+	}
+}
+
+func (v *View) printDiffLine(line string) {
+	w := v.ErrOut
+	if v.NoColor {
+		fmt.Fprint(w, line)
+		return
+	}
+	switch {
+	case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"):
+		color.New(color.Bold).Fprint(w, line)
+	case strings.HasPrefix(line, "@@"):
+		color.New(color.FgCyan).Fprint(w, line)
+	case strings.HasPrefix(line, "-"):
+		color.New(color.FgRed).Fprint(w, line)
+	case strings.HasPrefix(line, "+"):
+		color.New(color.FgGreen).Fprint(w, line)
+	default:
+		fmt.Fprint(w, line)
+	}
+}
+
+// diffLines returns the edit script turning a into b, keeping the longest
+// common subsequence of lines unchanged
+func diffLines(a, b []string) []diffLine {
+	// Common prefix and suffix need no matching
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var lines []diffLine
+	for _, l := range a[:prefix] {
+		lines = append(lines, diffLine{' ', l})
+	}
+	lines = append(lines, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, l := range a[len(a)-suffix:] {
+		lines = append(lines, diffLine{' ', l})
+	}
+	return lines
+}
+
+// diffMiddle diffs the changed region between the common prefix and suffix
+func diffMiddle(a, b []string) []diffLine {
+	var lines []diffLine
+	if len(a)*len(b) > maxDiffCells {
+		for _, l := range a {
+			lines = append(lines, diffLine{'-', l})
+		}
+		for _, l := range b {
+			lines = append(lines, diffLine{'+', l})
+		}
+		return lines
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, diffLine{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, diffLine{'-', a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, diffLine{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, diffLine{'+', b[j]})
+	}
+	return lines
+}
+
+// diffHunks groups an edit script into unified diff hunks with diffContext
+// lines of context
+func diffHunks(lines []diffLine) []string {
+	var hunks []string
+
+	for start := 0; start < len(lines); {
+		// Find the next change
+		first := start
+		for first < len(lines) && lines[first].op == ' ' {
+			first++
+		}
+		if first == len(lines) {
+			break
+		}
+
+		// Extend the hunk while changes are within 2*diffContext lines
+		last := first
+		for k := first; k < len(lines); k++ {
+			if lines[k].op != ' ' {
+				last = k
+			} else if k-last > 2*diffContext {
+				break
+			}
+		}
+
+		from := max(first-diffContext, 0)
+		to := min(last+diffContext+1, len(lines))
+
+		// Line numbers of the hunk's first line in before and after
+		oldLine, newLine := 1, 1
+		for _, l := range lines[:from] {
+			if l.op != '+' {
+				oldLine++
+			}
+			if l.op != '-' {
+				newLine++
+			}
+		}
+
+		var body strings.Builder
+		oldCount, newCount := 0, 0
+		for _, l := range lines[from:to] {
+			body.WriteByte(l.op)
+			body.WriteString(l.text)
+			body.WriteByte('\n')
+			if l.op != '+' {
+				oldCount++
+			}
+			if l.op != '-' {
+				newCount++
+			}
+		}
+
+		hunks = append(hunks, fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", oldLine, oldCount, newLine, newCount)+body.String())
+		start = to
+	}
+
+	return hunks
+}
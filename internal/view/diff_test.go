@@ -0,0 +1,103 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type diffRule struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+	Grok        string `json:"grok,omitempty"`
+}
+
+func TestDiff_ChangedValue(t *testing.T) {
+	diff, err := Diff(
+		diffRule{ID: "1", Description: "old", Enabled: true},
+		diffRule{ID: "1", Description: "new", Enabled: true},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, `--- before
++++ after
+@@ -1,5 +1,5 @@
+ {
+   "id": "1",
+-  "description": "old",
++  "description": "new",
+   "enabled": true
+ }
+`, diff)
+}
+
+func TestDiff_AddedField(t *testing.T) {
+	diff, err := Diff(
+		diffRule{ID: "1", Description: "d", Enabled: true},
+		diffRule{ID: "1", Description: "d", Enabled: true, Grok: "%{IP:ip}"},
+	)
+
+	require.NoError(t, err)
+	assert.Contains(t, diff, "-  \"enabled\": true\n")
+	assert.Contains(t, diff, "+  \"enabled\": true,\n")
+	assert.Contains(t, diff, "+  \"grok\": \"%{IP:ip}\"\n")
+	assert.NotContains(t, diff, "-  \"id\"")
+}
+
+func TestDiff_RemovedField(t *testing.T) {
+	diff, err := Diff(
+		map[string]interface{}{"a": 1, "b": 2, "c": 3},
+		map[string]interface{}{"a": 1, "c": 3},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, `--- before
++++ after
+@@ -1,5 +1,4 @@
+ {
+   "a": 1,
+-  "b": 2,
+   "c": 3
+ }
+`, diff)
+}
+
+func TestDiff_Equal(t *testing.T) {
+	diff, err := Diff(diffRule{ID: "1"}, diffRule{ID: "1"})
+
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
+func TestDiff_SeparateHunks(t *testing.T) {
+	before := map[string]int{}
+	after := map[string]int{}
+	for i := 0; i < 20; i++ {
+		key := fmt.Sprintf("k%02d", i)
+		before[key] = i
+		after[key] = i
+	}
+	after["k01"] = 100
+	after["k18"] = 100
+
+	diff, err := Diff(before, after)
+
+	require.NoError(t, err)
+	assert.Contains(t, diff, "@@ -1,6 +1,6 @@\n")
+	assert.Contains(t, diff, "@@ -17,6 +17,6 @@\n")
+	assert.NotContains(t, diff, "k10")
+}
+
+func TestView_PrintDiff(t *testing.T) {
+	var errOut bytes.Buffer
+	v := New(&bytes.Buffer{}, &errOut)
+	v.NoColor = true
+
+	v.PrintDiff("--- before\n+++ after\n@@ -1,1 +1,1 @@\n-a\n+b\n")
+
+	assert.Equal(t, "--- before\n+++ after\n@@ -1,1 +1,1 @@\n-a\n+b\n", errOut.String())
+}
@@ -0,0 +1,88 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FilterRows returns the rows matching expr, which is either COLUMN=VALUE
+// (the cell equals VALUE) or COLUMN~VALUE (the cell contains VALUE). Columns
+// are matched as in SortRows and values are compared case-insensitively.
+// Rows are returned unchanged if expr is empty.
+func FilterRows(rows [][]string, headers []string, expr string) ([][]string, error) {
+	if expr == "" {
+		return rows, nil
+	}
+
+	order, err := filterOrder(rows, headers, expr, identityOrder(len(rows)))
+	if err != nil {
+		return nil, err
+	}
+	return pick(rows, order), nil
+}
+
+// FilterSort applies FilterRows and then SortRows to rows, where rows[i] was
+// built from items[i], and returns items filtered and ordered the same way.
+// This keeps JSON output, which renders items, in step with the table.
+func FilterSort[T any](items []T, rows [][]string, headers []string, filter, sortColumn string, descending bool) ([]T, [][]string, error) {
+	if len(items) != len(rows) {
+		return nil, nil, fmt.Errorf("internal error: %d items for %d rows", len(items), len(rows))
+	}
+
+	order := identityOrder(len(rows))
+	var err error
+	if filter != "" {
+		if order, err = filterOrder(rows, headers, filter, order); err != nil {
+			return nil, nil, err
+		}
+	}
+	if sortColumn != "" {
+		if order, err = sortOrder(rows, headers, sortColumn, descending, order); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return pick(items, order), pick(rows, order), nil
+}
+
+// filterOrder returns the indexes in order whose rows match expr
+func filterOrder(rows [][]string, headers []string, expr string, order []int) ([]int, error) {
+	i := strings.IndexAny(expr, "=~")
+	if i <= 0 {
+		return nil, fmt.Errorf("invalid filter %q: expected COLUMN=VALUE or COLUMN~VALUE", expr)
+	}
+	column, op, value := expr[:i], expr[i], strings.ToLower(strings.TrimSpace(expr[i+1:]))
+
+	index := columnIndex(headers, column)
+	if index < 0 {
+		return nil, fmt.Errorf("unknown filter column %q: valid columns are %s", column, validColumns(headers))
+	}
+
+	filtered := make([]int, 0, len(order))
+	for _, r := range order {
+		c := strings.ToLower(cell(rows[r], index))
+		if (op == '=' && c == value) || (op == '~' && strings.Contains(c, value)) {
+			filtered = append(filtered, r)
+		}
+	}
+
+	return filtered, nil
+}
+
+// identityOrder returns the indexes 0 to n-1
+func identityOrder(n int) []int {
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	return order
+}
+
+// pick returns the elements of s at the given indexes
+func pick[T any](s []T, order []int) []T {
+	picked := make([]T, len(order))
+	for i, r := range order {
+		picked[i] = s[r]
+	}
+	return picked
+}
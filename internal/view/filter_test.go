@@ -0,0 +1,91 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var filterHeaders = []string{"ID", "NAME", "HEALTH STATUS"}
+
+var filterRows = [][]string{
+	{"1", "prod-api", "green"},
+	{"2", "staging-api", "red"},
+	{"3", "prod-web", "Green"},
+}
+
+func TestFilterRows_Exact(t *testing.T) {
+	got, err := FilterRows(filterRows, filterHeaders, "HEALTH_STATUS=green")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "3"}, column(got, 0))
+}
+
+func TestFilterRows_ExactDoesNotMatchSubstring(t *testing.T) {
+	got, err := FilterRows(filterRows, filterHeaders, "name=prod")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestFilterRows_Substring(t *testing.T) {
+	got, err := FilterRows(filterRows, filterHeaders, "NAME~prod")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "3"}, column(got, 0))
+}
+
+func TestFilterRows_CaseInsensitive(t *testing.T) {
+	got, err := FilterRows(filterRows, filterHeaders, "name~API")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, column(got, 0))
+}
+
+func TestFilterRows_ValueMayContainOperators(t *testing.T) {
+	rows := [][]string{{"1", "a=b"}, {"2", "a"}}
+	got, err := FilterRows(rows, []string{"ID", "NAME"}, "name=a=b")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1"}, column(got, 0))
+}
+
+func TestFilterRows_Empty(t *testing.T) {
+	got, err := FilterRows(filterRows, filterHeaders, "")
+	require.NoError(t, err)
+	assert.Equal(t, filterRows, got)
+}
+
+func TestFilterRows_UnknownColumn(t *testing.T) {
+	_, err := FilterRows(filterRows, filterHeaders, "LANGUAGE=go")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown filter column "LANGUAGE"`)
+	assert.Contains(t, err.Error(), "id, name, health_status")
+}
+
+func TestFilterRows_InvalidExpression(t *testing.T) {
+	for _, expr := range []string{"name", "=prod", "~prod"} {
+		_, err := FilterRows(filterRows, filterHeaders, expr)
+		require.Error(t, err, expr)
+		assert.Contains(t, err.Error(), "expected COLUMN=VALUE or COLUMN~VALUE")
+	}
+}
+
+func TestFilterSort_KeepsItemsInStep(t *testing.T) {
+	items := []string{"one", "two", "three"}
+
+	gotItems, gotRows, err := FilterSort(items, filterRows, filterHeaders, "name~prod", "name", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "1"}, column(gotRows, 0))
+	assert.Equal(t, []string{"three", "one"}, gotItems)
+}
+
+func TestFilterSort_NoFilterOrSort(t *testing.T) {
+	items := []string{"one", "two", "three"}
+
+	gotItems, gotRows, err := FilterSort(items, filterRows, filterHeaders, "", "", false)
+	require.NoError(t, err)
+	assert.Equal(t, filterRows, gotRows)
+	assert.Equal(t, items, gotItems)
+}
+
+func TestFilterSort_InvalidFilter(t *testing.T) {
+	_, _, err := FilterSort([]string{"one", "two", "three"}, filterRows, filterHeaders, "owner=me", "", false)
+	assert.ErrorContains(t, err, "unknown filter column")
+}
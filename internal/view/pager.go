@@ -0,0 +1,105 @@
+package view
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// defaultPager is used when $PAGER is not set. -F exits if the output fits
+// on one screen, -R keeps colors, and -X leaves the output on screen.
+const defaultPager = "less -FRX"
+
+// pagerWriter sends output through a pager process. The pager is started on
+// the first write, so commands that print nothing never start one.
+type pagerWriter struct {
+	out     io.Writer
+	cmd     *exec.Cmd
+	stdin   io.WriteCloser
+	started bool
+	closed  bool
+}
+
+// PagerWriter returns a writer that pipes output through $PAGER (or
+// "less -FRX") into w. If the pager cannot be started, output is written to
+// w directly. Close must be called to flush the output and wait for the
+// pager to exit.
+func PagerWriter(w io.Writer) io.WriteCloser {
+	return &pagerWriter{out: w}
+}
+
+// Write implements io.Writer
+func (p *pagerWriter) Write(b []byte) (int, error) {
+	if !p.started {
+		p.start()
+	}
+	if p.stdin == nil {
+		return p.out.Write(b)
+	}
+	if p.closed {
+		// The pager was quit early; drop the remaining output
+		return len(b), nil
+	}
+	if _, err := p.stdin.Write(b); err != nil {
+		p.closed = true
+	}
+	return len(b), nil
+}
+
+// Close flushes the output and waits for the pager to exit
+func (p *pagerWriter) Close() error {
+	if p.stdin == nil {
+		return nil
+	}
+	p.closed = true
+	_ = p.stdin.Close()
+	// A non-zero exit (e.g., quitting less early) is not an error for the command
+	_ = p.cmd.Wait()
+	p.stdin = nil
+	return nil
+}
+
+// start launches the pager, leaving stdin nil if it cannot be started
+func (p *pagerWriter) start() {
+	p.started = true
+
+	pager := strings.TrimSpace(os.Getenv("PAGER"))
+	if pager == "" {
+		pager = defaultPager
+	}
+	args := strings.Fields(pager)
+
+	path, err := exec.LookPath(args[0])
+	if err != nil {
+		return
+	}
+
+	cmd := exec.Command(path, args[1:]...)
+	cmd.Stdout = p.out
+	cmd.Stderr = os.Stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return
+	}
+	if err := cmd.Start(); err != nil {
+		return
+	}
+
+	p.cmd = cmd
+	p.stdin = stdin
+}
+
+// IsTerminal reports whether w is a terminal
+func IsTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,52 @@
+package view
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPagerWriter(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires cat")
+	}
+	t.Setenv("PAGER", "cat")
+
+	var buf bytes.Buffer
+	w := PagerWriter(&buf)
+
+	_, err := w.Write([]byte("line 1\n"))
+	require.NoError(t, err)
+	_, err = w.Write([]byte("line 2\n"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, "line 1\nline 2\n", buf.String())
+}
+
+func TestPagerWriter_MissingPagerWritesDirectly(t *testing.T) {
+	t.Setenv("PAGER", "nrq-test-missing-pager -x")
+
+	var buf bytes.Buffer
+	w := PagerWriter(&buf)
+
+	_, err := w.Write([]byte("output\n"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, "output\n", buf.String())
+}
+
+func TestPagerWriter_NoOutput(t *testing.T) {
+	t.Setenv("PAGER", "nrq-test-missing-pager")
+
+	w := PagerWriter(&bytes.Buffer{})
+	assert.NoError(t, w.Close())
+}
+
+func TestIsTerminal(t *testing.T) {
+	assert.False(t, IsTerminal(&bytes.Buffer{}))
+}
@@ -0,0 +1,94 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// progressWidth is the number of cells between the brackets of a progress bar
+const progressWidth = 20
+
+// ProgressBar shows how far a bulk operation has got. On a terminal it
+// redraws a single line like "[====>    ] 4/10 (40%)"; otherwise it writes
+// one "4/10 completed" line per step so logs stay readable.
+type ProgressBar struct {
+	Total   int
+	Current int
+
+	out  io.Writer
+	tty  bool
+	mu   sync.Mutex
+	done bool
+}
+
+// NewProgressBar returns a progress bar for total steps that draws on w
+// (normally stderr)
+func NewProgressBar(w io.Writer, total int) *ProgressBar {
+	return &ProgressBar{Total: total, out: w, tty: IsTerminal(w)}
+}
+
+// Increment records one more completed step
+func (p *ProgressBar) Increment() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.set(p.Current + 1)
+}
+
+// Set records n completed steps
+func (p *ProgressBar) Set(n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.set(n)
+}
+
+// Done ends the bar's line so following output starts on a fresh line.
+// Later calls to Increment and Set draw nothing. It is safe to call more
+// than once.
+func (p *ProgressBar) Done() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.done {
+		return
+	}
+	p.done = true
+	if p.tty {
+		fmt.Fprintln(p.out)
+	}
+}
+
+func (p *ProgressBar) set(n int) {
+	if p.done {
+		return
+	}
+	if n < 0 {
+		n = 0
+	}
+	if p.Total > 0 && n > p.Total {
+		n = p.Total
+	}
+	p.Current = n
+
+	if p.tty {
+		fmt.Fprint(p.out, clearLine+p.String())
+	} else {
+		fmt.Fprintf(p.out, "%d/%d completed\n", p.Current, p.Total)
+	}
+}
+
+// String renders the bar, e.g. "[====>    ] 4/10 (40%)"
+func (p *ProgressBar) String() string {
+	percent := 100
+	if p.Total > 0 {
+		percent = p.Current * 100 / p.Total
+	}
+
+	filled := percent * progressWidth / 100
+	bar := strings.Repeat("=", filled)
+	if filled < progressWidth {
+		bar += ">" + strings.Repeat(" ", progressWidth-filled-1)
+	}
+
+	return fmt.Sprintf("[%s] %d/%d (%d%%)", bar, p.Current, p.Total, percent)
+}
@@ -0,0 +1,67 @@
+package view
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressBar_String(t *testing.T) {
+	p := &ProgressBar{Total: 10, Current: 4}
+
+	assert.Equal(t, "[========>           ] 4/10 (40%)", p.String())
+
+	p.Current = 10
+	assert.Equal(t, "[====================] 10/10 (100%)", p.String())
+}
+
+func TestProgressBar_Terminal(t *testing.T) {
+	var buf bytes.Buffer
+	p := &ProgressBar{Total: 4, out: &buf, tty: true}
+
+	p.Increment()
+	p.Set(3)
+
+	assert.Equal(t, clearLine+"[=====>              ] 1/4 (25%)"+clearLine+"[===============>    ] 3/4 (75%)", buf.String())
+}
+
+func TestProgressBar_DoneEndsLine(t *testing.T) {
+	var buf bytes.Buffer
+	p := &ProgressBar{Total: 2, out: &buf, tty: true}
+
+	p.Increment()
+	p.Done()
+
+	got := buf.String()
+	assert.True(t, strings.HasSuffix(got, "\n"), "Done must end the bar's line")
+
+	// The bar is finished: nothing more is drawn
+	p.Increment()
+	p.Done()
+	assert.Equal(t, got, buf.String())
+	assert.Equal(t, 1, p.Current)
+}
+
+func TestProgressBar_NotTerminal(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewProgressBar(&buf, 3)
+
+	p.Increment()
+	p.Increment()
+	p.Done()
+
+	assert.Equal(t, "1/3 completed\n2/3 completed\n", buf.String())
+}
+
+func TestProgressBar_SetClamps(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewProgressBar(&buf, 3)
+
+	p.Set(7)
+	assert.Equal(t, 3, p.Current)
+
+	p.Set(-1)
+	assert.Equal(t, 0, p.Current)
+}
@@ -0,0 +1,90 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SortRows returns rows sorted by the column whose header matches column.
+// Headers match case-insensitively, treating underscores, dashes, and spaces
+// alike ("last-used" matches "LAST USED"). Values that are all numbers sort
+// numerically; others sort as case-insensitive text. The sort is stable and
+// rows are returned unchanged if column is empty.
+func SortRows(rows [][]string, headers []string, column string, descending bool) ([][]string, error) {
+	if column == "" {
+		return rows, nil
+	}
+
+	order, err := sortOrder(rows, headers, column, descending, identityOrder(len(rows)))
+	if err != nil {
+		return nil, err
+	}
+	return pick(rows, order), nil
+}
+
+// sortOrder returns the indexes in order sorted by their rows' column
+func sortOrder(rows [][]string, headers []string, column string, descending bool, order []int) ([]int, error) {
+	index := columnIndex(headers, column)
+	if index < 0 {
+		return nil, fmt.Errorf("unknown sort column %q: valid columns are %s", column, validColumns(headers))
+	}
+
+	numeric := true
+	for _, r := range order {
+		if _, err := strconv.ParseFloat(cell(rows[r], index), 64); err != nil {
+			numeric = false
+			break
+		}
+	}
+
+	sorted := make([]int, len(order))
+	copy(sorted, order)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := cell(rows[sorted[i]], index), cell(rows[sorted[j]], index)
+		if descending {
+			a, b = b, a
+		}
+		if numeric {
+			x, _ := strconv.ParseFloat(a, 64)
+			y, _ := strconv.ParseFloat(b, 64)
+			return x < y
+		}
+		return strings.ToLower(a) < strings.ToLower(b)
+	})
+
+	return sorted, nil
+}
+
+// columnIndex returns the index of the header matching column, or -1
+func columnIndex(headers []string, column string) int {
+	for i, h := range headers {
+		if normalizeColumn(h) == normalizeColumn(column) {
+			return i
+		}
+	}
+	return -1
+}
+
+// validColumns lists headers in the form accepted by --sort and --filter
+func validColumns(headers []string) string {
+	valid := make([]string, len(headers))
+	for i, h := range headers {
+		valid[i] = strings.ToLower(strings.ReplaceAll(h, " ", "_"))
+	}
+	return strings.Join(valid, ", ")
+}
+
+// normalizeColumn folds a column name for comparison
+func normalizeColumn(s string) string {
+	return strings.NewReplacer("_", " ", "-", " ").Replace(strings.ToUpper(strings.TrimSpace(s)))
+}
+
+// cell returns the value at index, or "" for short rows
+func cell(row []string, index int) string {
+	if index < len(row) {
+		return row[index]
+	}
+	return ""
+}
@@ -0,0 +1,65 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var sortHeaders = []string{"ID", "NAME", "LAST USED"}
+
+var sortRows = [][]string{
+	{"10", "beta", "2025-01-02"},
+	{"9", "Alpha", "2025-01-03"},
+	{"100", "gamma", "2025-01-01"},
+}
+
+func TestSortRows_Text(t *testing.T) {
+	got, err := SortRows(sortRows, sortHeaders, "name", false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Alpha", "beta", "gamma"}, column(got, 1))
+
+	// The input is not modified
+	assert.Equal(t, "beta", sortRows[0][1])
+}
+
+func TestSortRows_Numeric(t *testing.T) {
+	got, err := SortRows(sortRows, sortHeaders, "ID", false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"9", "10", "100"}, column(got, 0))
+}
+
+func TestSortRows_Descending(t *testing.T) {
+	got, err := SortRows(sortRows, sortHeaders, "last_used", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2025-01-03", "2025-01-02", "2025-01-01"}, column(got, 2))
+}
+
+func TestSortRows_Stable(t *testing.T) {
+	rows := [][]string{{"1", "b"}, {"2", "a"}, {"3", "b"}, {"4", "a"}}
+	got, err := SortRows(rows, []string{"ID", "NAME"}, "name", false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2", "4", "1", "3"}, column(got, 0))
+}
+
+func TestSortRows_NoColumn(t *testing.T) {
+	got, err := SortRows(sortRows, sortHeaders, "", false)
+	require.NoError(t, err)
+	assert.Equal(t, sortRows, got)
+}
+
+func TestSortRows_UnknownColumn(t *testing.T) {
+	_, err := SortRows(sortRows, sortHeaders, "email", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown sort column "email"`)
+	assert.Contains(t, err.Error(), "id, name, last_used")
+}
+
+func column(rows [][]string, index int) []string {
+	values := make([]string, len(rows))
+	for i, row := range rows {
+		values[i] = row[index]
+	}
+	return values
+}
@@ -0,0 +1,98 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// spinnerFrames are drawn in order, one per spinnerInterval
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+const spinnerInterval = 100 * time.Millisecond
+
+// clearLine returns the cursor to the start of the line and erases it
+const clearLine = "\r\033[K"
+
+// Spinner draws a progress indicator on a terminal while a long operation
+// runs. It is a no-op when its writer is not a terminal, so output piped to
+// a file or another program is unaffected.
+type Spinner struct {
+	out      io.Writer
+	enabled  bool
+	interval time.Duration
+
+	mu      sync.Mutex
+	message string
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// NewSpinner returns a spinner that draws on w (normally stderr)
+func NewSpinner(w io.Writer) *Spinner {
+	return &Spinner{out: w, enabled: IsTerminal(w), interval: spinnerInterval}
+}
+
+// SetMessage sets the text shown after the spinner. It may be called while
+// the spinner is running.
+func (s *Spinner) SetMessage(msg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.message = msg
+}
+
+// Start begins drawing the spinner until Stop is called
+func (s *Spinner) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.enabled || s.stop != nil {
+		return
+	}
+
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.run(s.stop, s.done)
+}
+
+// Stop stops the spinner and clears its line. It is safe to call more than
+// once, or without Start.
+func (s *Spinner) Stop() {
+	s.mu.Lock()
+	stop, done := s.stop, s.done
+	s.stop, s.done = nil, nil
+	s.mu.Unlock()
+
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+	fmt.Fprint(s.out, clearLine)
+}
+
+func (s *Spinner) run(stop, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for i := 0; ; i++ {
+		s.mu.Lock()
+		msg := s.message
+		s.mu.Unlock()
+
+		frame := spinnerFrames[i%len(spinnerFrames)]
+		if msg != "" {
+			fmt.Fprintf(s.out, "%s%s %s", clearLine, frame, msg)
+		} else {
+			fmt.Fprintf(s.out, "%s%s", clearLine, frame)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package view
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// syncBuffer is a bytes.Buffer safe for the spinner goroutine to write to
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestSpinner_StopClearsLine(t *testing.T) {
+	var out syncBuffer
+	s := &Spinner{out: &out, enabled: true, interval: time.Millisecond}
+	s.SetMessage("Creating deployments")
+
+	s.Start()
+	time.Sleep(20 * time.Millisecond)
+	s.Stop()
+
+	got := out.String()
+	assert.Contains(t, got, "⠋ Creating deployments")
+	assert.Contains(t, got, "⠙")
+	assert.True(t, strings.HasSuffix(got, clearLine), "spinner line must be cleared on Stop")
+
+	// Nothing more is drawn after Stop
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, got, out.String())
+}
+
+func TestSpinner_NotTerminal(t *testing.T) {
+	var buf bytes.Buffer
+	s := NewSpinner(&buf)
+	s.SetMessage("Working")
+
+	s.Start()
+	s.Stop()
+
+	assert.Empty(t, buf.String())
+}
+
+func TestSpinner_StopWithoutStart(t *testing.T) {
+	var buf bytes.Buffer
+	s := &Spinner{out: &buf, enabled: true, interval: time.Millisecond}
+
+	s.Stop()
+	s.Stop()
+
+	assert.Empty(t, buf.String())
+}
@@ -0,0 +1,11 @@
+//go:build !linux && !darwin
+
+package view
+
+import "os"
+
+// terminalSize is not supported on this platform; TerminalWidth falls back
+// to $COLUMNS
+func terminalSize(f *os.File) (int, bool) {
+	return 0, false
+}
@@ -0,0 +1,21 @@
+//go:build linux || darwin
+
+package view
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// terminalSize returns the width of the terminal f is attached to
+func terminalSize(f *os.File) (int, bool) {
+	var ws struct {
+		Row, Col, Xpixel, Ypixel uint16
+	}
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&ws)))
+	if errno != 0 || ws.Col == 0 {
+		return 0, false
+	}
+	return int(ws.Col), true
+}
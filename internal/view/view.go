@@ -1,10 +1,12 @@
 package view
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"reflect"
 	"strings"
 	"text/tabwriter"
 
@@ -15,30 +17,36 @@ import (
 type Format string
 
 const (
-	FormatTable Format = "table"
-	FormatJSON  Format = "json"
-	FormatPlain Format = "plain"
+	FormatTable    Format = "table"
+	FormatJSON     Format = "json"
+	FormatPlain    Format = "plain"
+	FormatCSV      Format = "csv"
+	FormatMarkdown Format = "markdown"
 )
 
 // ValidFormats contains all valid output formats
-var ValidFormats = []Format{FormatTable, FormatJSON, FormatPlain}
+var ValidFormats = []Format{FormatTable, FormatJSON, FormatPlain, FormatCSV, FormatMarkdown}
 
 // ValidateFormat checks if a format string is valid
 func ValidateFormat(f string) error {
 	switch Format(f) {
-	case FormatTable, FormatJSON, FormatPlain:
+	case FormatTable, FormatJSON, FormatPlain, FormatCSV, FormatMarkdown:
 		return nil
 	default:
-		return fmt.Errorf("invalid output format %q: must be one of table, json, plain", f)
+		return fmt.Errorf("invalid output format %q: must be one of table, json, plain, csv, markdown", f)
 	}
 }
 
 // View handles output rendering
 type View struct {
-	Out     io.Writer
-	ErrOut  io.Writer
-	Format  Format
-	NoColor bool
+	Out         io.Writer
+	ErrOut      io.Writer
+	Format      Format
+	NoColor     bool
+	CompactJSON bool // Emit JSON without indentation
+	JSONArray   bool // Wrap non-array JSON values in a single-element array
+	ColumnWidth int  // Minimum table column width; 0 sizes columns to their content
+	TableWidth  int  // Truncate cells so tables fit this many columns; 0 means no limit
 }
 
 // New creates a new View with defaults
@@ -62,7 +70,11 @@ func (v *View) Table(headers []string, rows [][]string) error {
 		return nil
 	}
 
-	w := tabwriter.NewWriter(v.Out, 0, 0, 2, ' ', 0)
+	if v.TableWidth > 0 {
+		headers, rows = fitTable(headers, rows, v.TableWidth)
+	}
+
+	w := tabwriter.NewWriter(v.Out, v.ColumnWidth, 0, tablePadding, ' ', 0)
 
 	// Print headers
 	if v.NoColor {
@@ -86,11 +98,32 @@ func (v *View) Table(headers []string, rows [][]string) error {
 
 // JSON renders data as formatted JSON
 func (v *View) JSON(data interface{}) error {
+	if v.JSONArray && !isList(data) {
+		data = []interface{}{data}
+	}
+
 	enc := json.NewEncoder(v.Out)
-	enc.SetIndent("", "  ")
+	if v.CompactJSON {
+		enc.SetIndent("", "")
+	} else {
+		enc.SetIndent("", "  ")
+	}
 	return enc.Encode(data)
 }
 
+// isList reports whether data encodes as a JSON array
+func isList(data interface{}) bool {
+	if data == nil {
+		return false
+	}
+	switch reflect.TypeOf(data).Kind() {
+	case reflect.Slice, reflect.Array:
+		return true
+	default:
+		return false
+	}
+}
+
 // Plain renders rows as tab-separated values without headers
 func (v *View) Plain(rows [][]string) error {
 	for _, row := range rows {
@@ -99,6 +132,50 @@ func (v *View) Plain(rows [][]string) error {
 	return nil
 }
 
+// CSV renders data as RFC 4180 comma-separated values with a header row
+func (v *View) CSV(headers []string, rows [][]string) error {
+	w := csv.NewWriter(v.Out)
+	if err := w.Write(headers); err != nil {
+		return err
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return err
+	}
+	return w.Error()
+}
+
+// Markdown renders data as a GitHub-Flavored Markdown table. Pipes in
+// cells are escaped and line breaks become spaces so each row stays on one
+// line.
+func (v *View) Markdown(headers []string, rows [][]string) error {
+	separators := make([]string, len(headers))
+	for i := range headers {
+		separators[i] = "------"
+	}
+
+	writeRow := func(cells []string) {
+		escaped := make([]string, len(cells))
+		for i, c := range cells {
+			escaped[i] = markdownCell(c)
+		}
+		fmt.Fprintf(v.Out, "| %s |\n", strings.Join(escaped, " | "))
+	}
+
+	writeRow(headers)
+	fmt.Fprintf(v.Out, "|%s|\n", strings.Join(separators, "|"))
+	for _, row := range rows {
+		writeRow(row)
+	}
+	return nil
+}
+
+// markdownCell escapes a value for a Markdown table cell
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
+
 // Print writes a message to stdout
 func (v *View) Print(format string, args ...interface{}) {
 	fmt.Fprintf(v.Out, format, args...)
@@ -139,6 +216,39 @@ func (v *View) Warning(format string, args ...interface{}) {
 	}
 }
 
+// HealthStatus colors a New Relic health status (green, orange, red, gray),
+// workload status (OPERATIONAL, DEGRADED, DISRUPTED, UNKNOWN) or alert
+// severity (NOT_ALERTING, WARNING, CRITICAL, NOT_CONFIGURED) for table
+// output. Other formats and --no-color get the plain status.
+func (v *View) HealthStatus(status string) string {
+	if v.NoColor || (v.Format != "" && v.Format != FormatTable) {
+		return status
+	}
+
+	var attr color.Attribute
+	switch strings.ToLower(status) {
+	case "green", "operational", "not_alerting":
+		attr = color.FgGreen
+	case "orange", "yellow", "degraded", "warning":
+		attr = color.FgYellow
+	case "red", "disrupted", "critical":
+		attr = color.FgRed
+	default:
+		attr = color.FgHiBlack
+	}
+
+	return color.New(attr).Sprint(status)
+}
+
+// Dim renders secondary text in a faint color for table output. Other
+// formats and --no-color get the text unchanged.
+func (v *View) Dim(s string) string {
+	if v.NoColor || (v.Format != "" && v.Format != FormatTable) {
+		return s
+	}
+	return color.New(color.FgHiBlack).Sprint(s)
+}
+
 // Render automatically chooses output format based on View.Format
 func (v *View) Render(headers []string, rows [][]string, data interface{}) error {
 	switch v.Format {
@@ -146,6 +256,10 @@ func (v *View) Render(headers []string, rows [][]string, data interface{}) error
 		return v.JSON(data)
 	case FormatPlain:
 		return v.Plain(rows)
+	case FormatCSV:
+		return v.CSV(headers, rows)
+	case FormatMarkdown:
+		return v.Markdown(headers, rows)
 	default:
 		return v.Table(headers, rows)
 	}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/fatih/color"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -19,6 +20,8 @@ func TestValidateFormat(t *testing.T) {
 		{"valid table", "table", false},
 		{"valid json", "json", false},
 		{"valid plain", "plain", false},
+		{"valid csv", "csv", false},
+		{"valid markdown", "markdown", false},
 		{"invalid format", "xml", true},
 		{"empty format", "", true},
 	}
@@ -89,6 +92,40 @@ func TestView_JSON(t *testing.T) {
 	assert.Equal(t, true, result["active"])
 }
 
+func TestView_JSON_Compact(t *testing.T) {
+	data := map[string]interface{}{
+		"results": []map[string]interface{}{
+			{"count": 42, "name": "web"},
+			{"count": 7, "name": "worker"},
+		},
+	}
+
+	var pretty, compact bytes.Buffer
+	require.NoError(t, New(&pretty, &bytes.Buffer{}).JSON(data))
+
+	v := New(&compact, &bytes.Buffer{})
+	v.CompactJSON = true
+	require.NoError(t, v.JSON(data))
+
+	assert.Less(t, compact.Len(), pretty.Len())
+	assert.Equal(t, 1, strings.Count(compact.String(), "\n"))
+	assert.JSONEq(t, pretty.String(), compact.String())
+}
+
+func TestView_JSON_ArrayMode(t *testing.T) {
+	var buf bytes.Buffer
+	v := New(&buf, &bytes.Buffer{})
+	v.JSONArray = true
+
+	require.NoError(t, v.JSON(map[string]int{"count": 1}))
+	assert.JSONEq(t, `[{"count": 1}]`, buf.String())
+
+	// Arrays are not wrapped again
+	buf.Reset()
+	require.NoError(t, v.JSON([]int{1, 2}))
+	assert.JSONEq(t, `[1, 2]`, buf.String())
+}
+
 func TestView_Plain(t *testing.T) {
 	var buf bytes.Buffer
 	v := New(&buf, &bytes.Buffer{})
@@ -107,6 +144,89 @@ func TestView_Plain(t *testing.T) {
 	assert.Equal(t, "2\tApp Two\tcritical", lines[1])
 }
 
+func TestView_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	v := New(&buf, &bytes.Buffer{})
+
+	headers := []string{"ID", "NAME"}
+	rows := [][]string{
+		{"1", "Test"},
+		{"2", "Smith, John"},
+		{"3", "line one\nline two"},
+		{"4", `say "hi"`},
+	}
+
+	err := v.CSV(headers, rows)
+	require.NoError(t, err)
+
+	expected := "ID,NAME\n" +
+		"1,Test\n" +
+		"2,\"Smith, John\"\n" +
+		"3,\"line one\nline two\"\n" +
+		"4,\"say \"\"hi\"\"\"\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestView_CSV_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	v := New(&buf, &bytes.Buffer{})
+
+	err := v.CSV([]string{"ID", "NAME"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ID,NAME\n", buf.String())
+}
+
+func TestView_Render_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	v := New(&buf, &bytes.Buffer{})
+	v.Format = FormatCSV
+
+	err := v.Render([]string{"ID", "NAME"}, [][]string{{"1", "a,b"}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ID,NAME\n1,\"a,b\"\n", buf.String())
+}
+
+func TestView_Markdown(t *testing.T) {
+	var buf bytes.Buffer
+	v := New(&buf, &bytes.Buffer{})
+
+	headers := []string{"ID", "NAME"}
+	rows := [][]string{
+		{"1", "Test"},
+		{"2", "a | b"},
+		{"3", "line one\nline two"},
+	}
+
+	err := v.Markdown(headers, rows)
+	require.NoError(t, err)
+
+	expected := "| ID | NAME |\n" +
+		"|------|------|\n" +
+		"| 1 | Test |\n" +
+		"| 2 | a \\| b |\n" +
+		"| 3 | line one line two |\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestView_Markdown_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	v := New(&buf, &bytes.Buffer{})
+
+	err := v.Markdown([]string{"ID", "NAME"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "| ID | NAME |\n|------|------|\n", buf.String())
+}
+
+func TestView_Render_Markdown(t *testing.T) {
+	var buf bytes.Buffer
+	v := New(&buf, &bytes.Buffer{})
+	v.Format = FormatMarkdown
+
+	err := v.Render([]string{"ID", "PATH"}, [][]string{{"1", `C:\logs`}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "| ID | PATH |\n|------|------|\n| 1 | C:\\\\logs |\n", buf.String())
+}
+
 func TestView_Render_Table(t *testing.T) {
 	var buf bytes.Buffer
 	v := New(&buf, &bytes.Buffer{})
@@ -182,6 +302,30 @@ func TestView_Warning(t *testing.T) {
 	assert.Contains(t, stderr.String(), "Warning: caution")
 }
 
+func TestView_HealthStatus(t *testing.T) {
+	// Force color on regardless of whether the test runs in a terminal
+	saved := color.NoColor
+	color.NoColor = false
+	defer func() { color.NoColor = saved }()
+
+	v := New(&bytes.Buffer{}, &bytes.Buffer{})
+
+	assert.Equal(t, "\x1b[32mgreen\x1b[0m", v.HealthStatus("green"))
+	assert.Equal(t, "\x1b[33morange\x1b[0m", v.HealthStatus("orange"))
+	assert.Equal(t, "\x1b[31mred\x1b[0m", v.HealthStatus("red"))
+	assert.Equal(t, "\x1b[90mgray\x1b[0m", v.HealthStatus("gray"))
+	assert.Equal(t, "\x1b[33mDEGRADED\x1b[0m", v.HealthStatus("DEGRADED"))
+	assert.Equal(t, "\x1b[31mCRITICAL\x1b[0m", v.HealthStatus("CRITICAL"))
+	assert.Equal(t, "\x1b[32mNOT_ALERTING\x1b[0m", v.HealthStatus("NOT_ALERTING"))
+
+	v.NoColor = true
+	assert.Equal(t, "red", v.HealthStatus("red"))
+
+	v.NoColor = false
+	v.Format = FormatPlain
+	assert.Equal(t, "red", v.HealthStatus("red"))
+}
+
 func TestTruncate(t *testing.T) {
 	tests := []struct {
 		name     string